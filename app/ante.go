@@ -0,0 +1,62 @@
+package app
+
+import (
+	"github.com/celestiaorg/celestia-app/pkg/appconsts"
+	blobante "github.com/celestiaorg/celestia-app/x/blob/ante"
+	namespaceante "github.com/celestiaorg/celestia-app/x/namespace/ante"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+)
+
+// newAnteHandler returns the default cosmos-sdk AnteHandler followed by the
+// decorators of the app versions that enable them: the blob base fee
+// decorator, which burns the blob base fee portion of the fee deducted by the
+// default AnteHandler, the namespace ownership decorator, which rejects PFBs
+// in namespaces registered to other accounts, and the blob authorization
+// decorator, which rejects PFBs executed through an authz MsgExec without a
+// grant from their signer. Finally, the priority of transactions that pay for
+// blobs is set based on the fee they pay per share occupied by their blobs.
+func newAnteHandler(
+	options ante.HandlerOptions,
+	bankKeeper blobante.BankKeeper,
+	blobKeeper blobante.PriorityKeeper,
+	namespaceKeeper namespaceante.NamespaceKeeper,
+	authzKeeper blobante.AuthzKeeper,
+) (sdk.AnteHandler, error) {
+	anteHandler, err := ante.NewAnteHandler(options)
+	if err != nil {
+		return nil, err
+	}
+	versionedHandler := sdk.ChainAnteDecorators(
+		newVersionedDecorator(blobante.NewBlobBaseFeeDecorator(blobKeeper, bankKeeper, BondDenom), appconsts.BlobBaseFeeEnabled),
+		newVersionedDecorator(namespaceante.NewNamespaceOwnershipDecorator(namespaceKeeper), appconsts.NamespaceRegistryEnabled),
+		newVersionedDecorator(blobante.NewBlobAuthorizationDecorator(authzKeeper), appconsts.AuthzPayForBlobsEnabled),
+		blobante.NewBlobPriorityDecorator(blobKeeper, BondDenom),
+	)
+	return func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
+		ctx, err := anteHandler(ctx, tx, simulate)
+		if err != nil {
+			return ctx, err
+		}
+		return versionedHandler(ctx, tx, simulate)
+	}, nil
+}
+
+// versionedDecorator runs an ante decorator only for the blocks whose app
+// version enables it so that the transactions of earlier versions are checked
+// exactly as before the decorator was added.
+type versionedDecorator struct {
+	decorator sdk.AnteDecorator
+	enabled   func(appVersion uint64) bool
+}
+
+func newVersionedDecorator(decorator sdk.AnteDecorator, enabled func(appVersion uint64) bool) versionedDecorator {
+	return versionedDecorator{decorator: decorator, enabled: enabled}
+}
+
+func (d versionedDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	if !d.enabled(ctx.BlockHeader().Version.App) {
+		return next(ctx, tx, simulate)
+	}
+	return d.decorator.AnteHandle(ctx, tx, simulate, next)
+}
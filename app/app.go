@@ -5,6 +5,7 @@ import (
 	"os"
 	"path/filepath"
 
+	"github.com/celestiaorg/celestia-app/pkg/appconsts"
 	"github.com/celestiaorg/celestia-app/x/mint"
 	mintkeeper "github.com/celestiaorg/celestia-app/x/mint/keeper"
 	minttypes "github.com/celestiaorg/celestia-app/x/mint/types"
@@ -85,10 +86,14 @@ import (
 	dbm "github.com/tendermint/tm-db"
 
 	"github.com/celestiaorg/celestia-app/app/encoding"
+	"github.com/celestiaorg/celestia-app/pkg/blobindex"
 	"github.com/celestiaorg/celestia-app/pkg/proof"
 	blobmodule "github.com/celestiaorg/celestia-app/x/blob"
 	blobmodulekeeper "github.com/celestiaorg/celestia-app/x/blob/keeper"
 	blobmoduletypes "github.com/celestiaorg/celestia-app/x/blob/types"
+	namespacemodule "github.com/celestiaorg/celestia-app/x/namespace"
+	namespacemodulekeeper "github.com/celestiaorg/celestia-app/x/namespace/keeper"
+	namespacemoduletypes "github.com/celestiaorg/celestia-app/x/namespace/types"
 	"github.com/celestiaorg/celestia-app/x/paramfilter"
 	"github.com/celestiaorg/celestia-app/x/tokenfilter"
 	appupgrade "github.com/celestiaorg/celestia-app/x/upgrade"
@@ -103,7 +108,7 @@ const (
 	AccountAddressPrefix = "celestia"
 	Name                 = "celestia-app"
 	// BondDenom defines the native staking token denomination.
-	BondDenom = "utia"
+	BondDenom = appconsts.BondDenom
 	// BondDenomAlias defines an alias for BondDenom.
 	BondDenomAlias = "microtia"
 	// DisplayDenom defines the name, symbol, and display value of the Celestia token.
@@ -155,6 +160,7 @@ var (
 		vesting.AppModuleBasic{},
 		blobmodule.AppModuleBasic{},
 		qgbmodule.AppModuleBasic{},
+		namespacemodule.AppModuleBasic{},
 	)
 
 	// ModuleEncodingRegisters keeps track of all the module methods needed to
@@ -229,11 +235,24 @@ type App struct {
 	ScopedIBCKeeper      capabilitykeeper.ScopedKeeper
 	ScopedTransferKeeper capabilitykeeper.ScopedKeeper
 
-	BlobKeeper blobmodulekeeper.Keeper
-	QgbKeeper  qgbmodulekeeper.Keeper
+	BlobKeeper      blobmodulekeeper.Keeper
+	QgbKeeper       qgbmodulekeeper.Keeper
+	NamespaceKeeper namespacemodulekeeper.Keeper
 
 	// the module manager
 	mm *module.Manager
+
+	// dahCache holds the data availability headers of the squares built in
+	// PrepareProposal so that they can be reused in ProcessProposal.
+	dahCache *dahCache
+
+	// blobPlacements tracks where the blobs of the block that is being
+	// executed were placed in its data square.
+	blobPlacements *blobPlacements
+
+	// blobIndexer indexes the blobs of every committed block. It is nil if
+	// the blob index is disabled.
+	blobIndexer *blobindex.Indexer
 }
 
 // New returns a reference to an initialized celestia app.
@@ -258,7 +277,11 @@ func New(
 	bApp.SetVersion(version.Version)
 	bApp.SetInterfaceRegistry(interfaceRegistry)
 
-	keys := sdk.NewKVStoreKeys(
+	// the modules and stores that are loaded depend on the app version that
+	// the app is created with
+	appVersion := bApp.AppVersion()
+
+	storeKeys := []string{
 		authtypes.StoreKey, authzkeeper.StoreKey, banktypes.StoreKey, stakingtypes.StoreKey,
 		minttypes.StoreKey, distrtypes.StoreKey, slashingtypes.StoreKey,
 		govtypes.StoreKey, paramstypes.StoreKey, sdkupgradetypes.StoreKey, feegrant.StoreKey,
@@ -267,7 +290,11 @@ func New(
 		qgbmoduletypes.StoreKey,
 		ibctransfertypes.StoreKey,
 		ibchost.StoreKey,
-	)
+	}
+	if appconsts.NamespaceRegistryEnabled(appVersion) {
+		storeKeys = append(storeKeys, namespacemoduletypes.StoreKey)
+	}
+	keys := sdk.NewKVStoreKeys(storeKeys...)
 	tkeys := sdk.NewTransientStoreKeys(paramstypes.TStoreKey)
 	memKeys := sdk.NewMemoryStoreKeys(capabilitytypes.MemStoreKey)
 
@@ -280,9 +307,11 @@ func New(
 		keys:              keys,
 		tkeys:             tkeys,
 		memKeys:           memKeys,
+		dahCache:          newDAHCache(dahCacheSize),
+		blobPlacements:    newBlobPlacements(),
 	}
 
-	app.ParamsKeeper = initParamsKeeper(appCodec, cdc, keys[paramstypes.StoreKey], tkeys[paramstypes.TStoreKey])
+	app.ParamsKeeper = initParamsKeeper(appCodec, cdc, keys[paramstypes.StoreKey], tkeys[paramstypes.TStoreKey], appVersion)
 
 	// set the BaseApp's parameter store
 	bApp.SetParamStore(app.ParamsKeeper.Subspace(baseapp.Paramspace).WithKeyTable(paramstypes.ConsensusParamsKeyTable()))
@@ -296,7 +325,7 @@ func New(
 
 	// add keepers
 	app.AccountKeeper = authkeeper.NewAccountKeeper(
-		appCodec, keys[authtypes.StoreKey], app.GetSubspace(authtypes.ModuleName), authtypes.ProtoBaseAccount, maccPerms, sdk.GetConfig().GetBech32AccountAddrPrefix(),
+		appCodec, keys[authtypes.StoreKey], app.GetSubspace(authtypes.ModuleName), authtypes.ProtoBaseAccount, moduleAccountPerms(appVersion), sdk.GetConfig().GetBech32AccountAddrPrefix(),
 	)
 	app.BankKeeper = bankkeeper.NewBaseKeeper(
 		appCodec, keys[banktypes.StoreKey], app.AccountKeeper, app.GetSubspace(banktypes.ModuleName), app.ModuleAccountAddrs(),
@@ -396,6 +425,7 @@ func New(
 		keys[blobmoduletypes.StoreKey],
 		keys[blobmoduletypes.MemStoreKey],
 		app.GetSubspace(blobmoduletypes.ModuleName),
+		app.AccountKeeper,
 	)
 	blobmod := blobmodule.NewAppModule(appCodec, app.BlobKeeper)
 
@@ -413,7 +443,7 @@ func New(
 	// NOTE: Any module instantiated in the module manager that is later modified
 	// must be passed by reference here.
 
-	app.mm = module.NewManager(
+	modules := []module.AppModule{
 		genutil.NewAppModule(
 			app.AccountKeeper, app.StakingKeeper, app.BaseApp.DeliverTx,
 			encodingConfig.TxConfig,
@@ -436,13 +466,25 @@ func New(
 		transferModule,
 		blobmod,
 		qgbmod,
-	)
+	}
+	// the x/namespace module is only loaded from v2, so that the blocks of
+	// earlier versions are executed exactly as before it was added
+	if appconsts.NamespaceRegistryEnabled(appVersion) {
+		app.NamespaceKeeper = *namespacemodulekeeper.NewKeeper(
+			appCodec,
+			keys[namespacemoduletypes.StoreKey],
+			app.GetSubspace(namespacemoduletypes.ModuleName),
+			app.BankKeeper,
+		)
+		modules = append(modules, namespacemodule.NewAppModule(appCodec, app.NamespaceKeeper))
+	}
+	app.mm = module.NewManager(modules...)
 
 	// During begin block slashing happens after distr.BeginBlocker so that
 	// there is nothing left over in the validator fee pool, so as to keep the
 	// CanWithdrawInvariant invariant.
 	// NOTE: staking module is required if HistoricalEntries param > 0
-	app.mm.SetOrderBeginBlockers(
+	app.mm.SetOrderBeginBlockers(loadedModules(app.mm,
 		capabilitytypes.ModuleName,
 		minttypes.ModuleName,
 		distrtypes.ModuleName,
@@ -459,12 +501,13 @@ func New(
 		genutiltypes.ModuleName,
 		blobmoduletypes.ModuleName,
 		qgbmoduletypes.ModuleName,
+		namespacemoduletypes.ModuleName,
 		paramstypes.ModuleName,
 		authz.ModuleName,
 		vestingtypes.ModuleName,
-	)
+	)...)
 
-	app.mm.SetOrderEndBlockers(
+	app.mm.SetOrderEndBlockers(loadedModules(app.mm,
 		crisistypes.ModuleName,
 		govtypes.ModuleName,
 		stakingtypes.ModuleName,
@@ -481,17 +524,18 @@ func New(
 		genutiltypes.ModuleName,
 		blobmoduletypes.ModuleName,
 		qgbmoduletypes.ModuleName,
+		namespacemoduletypes.ModuleName,
 		paramstypes.ModuleName,
 		authz.ModuleName,
 		vestingtypes.ModuleName,
-	)
+	)...)
 
 	// NOTE: The genutils module must occur after staking so that pools are
 	// properly initialized with tokens from genesis accounts.
 	// NOTE: Capability module must occur first so that it can initialize any capabilities
 	// so that other modules that want to create or claim capabilities afterwards in InitChain
 	// can do so safely.
-	app.mm.SetOrderInitGenesis(
+	app.mm.SetOrderInitGenesis(loadedModules(app.mm,
 		capabilitytypes.ModuleName,
 		authtypes.ModuleName,
 		banktypes.ModuleName,
@@ -507,15 +551,17 @@ func New(
 		ibctransfertypes.ModuleName,
 		blobmoduletypes.ModuleName,
 		qgbmoduletypes.ModuleName,
+		namespacemoduletypes.ModuleName,
 		vestingtypes.ModuleName,
 		feegrant.ModuleName,
 		paramstypes.ModuleName,
 		authz.ModuleName,
 		sdkupgradetypes.ModuleName,
-	)
+	)...)
 
 	app.QueryRouter().AddRoute(proof.TxInclusionQueryPath, proof.QueryTxInclusionProof)
 	app.QueryRouter().AddRoute(proof.ShareInclusionQueryPath, proof.QueryShareInclusionProof)
+	app.QueryRouter().AddRoute(proof.BlobInclusionQueryPath, proof.QueryBlobInclusionProof)
 
 	app.mm.RegisterInvariants(&app.CrisisKeeper)
 	app.mm.RegisterRoutes(app.Router(), app.QueryRouter(), encodingConfig.Amino)
@@ -531,7 +577,7 @@ func New(
 	app.SetInitChainer(app.InitChainer)
 	app.SetBeginBlocker(app.BeginBlocker)
 	app.SetEndBlocker(app.EndBlocker)
-	anteHandler, err := ante.NewAnteHandler(
+	anteHandler, err := newAnteHandler(
 		ante.HandlerOptions{
 			AccountKeeper:   app.AccountKeeper,
 			BankKeeper:      app.BankKeeper,
@@ -539,6 +585,10 @@ func New(
 			FeegrantKeeper:  app.FeeGrantKeeper,
 			SigGasConsumer:  ante.DefaultSigVerificationGasConsumer,
 		},
+		app.BankKeeper,
+		app.BlobKeeper,
+		app.NamespaceKeeper,
+		app.AuthzKeeper,
 	)
 	if err != nil {
 		panic(err)
@@ -563,12 +613,19 @@ func (app *App) Name() string { return app.BaseApp.Name() }
 
 // BeginBlocker application updates every begin block
 func (app *App) BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock) abci.ResponseBeginBlock {
+	app.blobPlacements.reset()
 	return app.mm.BeginBlock(ctx, req)
 }
 
 // EndBlocker application updates every end block
 func (app *App) EndBlocker(ctx sdk.Context, req abci.RequestEndBlock) abci.ResponseEndBlock {
-	return app.mm.EndBlock(ctx, req)
+	res := app.mm.EndBlock(ctx, req)
+	events, err := app.blobPlacements.endBlock(app.AppVersion())
+	if err != nil {
+		app.Logger().Error("failed to compute the blob placements of the block", "height", req.Height, "err", err)
+	}
+	res.Events = append(res.Events, events...)
+	return res
 }
 
 // InitChainer application update at chain initialization
@@ -577,6 +634,12 @@ func (app *App) InitChainer(ctx sdk.Context, req abci.RequestInitChain) abci.Res
 	if err := tmjson.Unmarshal(req.AppStateBytes, &genesisState); err != nil {
 		panic(err)
 	}
+	// the modules read the app version of the chain from the block header
+	if req.ConsensusParams != nil && req.ConsensusParams.Version != nil {
+		header := ctx.BlockHeader()
+		header.Version.App = req.ConsensusParams.Version.AppVersion
+		ctx = ctx.WithBlockHeader(header)
+	}
 	app.UpgradeKeeper.SetModuleVersionMap(ctx, app.mm.GetVersionMap())
 	return app.mm.InitGenesis(ctx, app.appCodec, genesisState)
 }
@@ -589,7 +652,7 @@ func (app *App) LoadHeight(height int64) error {
 // ModuleAccountAddrs returns all the app's module account addresses.
 func (app *App) ModuleAccountAddrs() map[string]bool {
 	modAccAddrs := make(map[string]bool)
-	for acc := range maccPerms {
+	for acc := range moduleAccountPerms(app.AppVersion()) {
 		modAccAddrs[authtypes.NewModuleAddress(acc).String()] = true
 	}
 
@@ -679,6 +742,10 @@ func (app *App) RegisterAPIRoutes(apiSvr *api.Server, _ config.APIConfig) {
 	authtx.RegisterGRPCGatewayRoutes(clientCtx, apiSvr.GRPCGatewayRouter)
 	// Register new tendermint queries routes from grpc-gateway.
 	tmservice.RegisterGRPCGatewayRoutes(clientCtx, apiSvr.GRPCGatewayRouter)
+	// Register the proof queries routes from grpc-gateway.
+	proof.RegisterGRPCGatewayRoutes(clientCtx, apiSvr.GRPCGatewayRouter)
+	// Register the blob index queries routes from grpc-gateway.
+	blobindex.RegisterGRPCGatewayRoutes(clientCtx, apiSvr.GRPCGatewayRouter)
 
 	// Register the
 	ModuleBasics.RegisterGRPCGatewayRoutes(clientCtx, apiSvr.GRPCGatewayRouter)
@@ -692,6 +759,24 @@ func (app *App) RegisterTxService(clientCtx client.Context) {
 // RegisterTendermintService implements the Application.RegisterTendermintService method.
 func (app *App) RegisterTendermintService(clientCtx client.Context) {
 	tmservice.RegisterTendermintService(clientCtx, app.BaseApp.GRPCQueryRouter(), app.interfaceRegistry, nil)
+	proof.RegisterQueryService(clientCtx, app.BaseApp.GRPCQueryRouter())
+	blobindex.RegisterQueryService(app.blobIndexer, app.BaseApp.GRPCQueryRouter())
+}
+
+// SetBlobIndexer enables the blob index. The blobs of every committed block
+// are indexed by the indexer. It must be called before the gRPC services are
+// registered. The indexer is closed by Close.
+func (app *App) SetBlobIndexer(indexer *blobindex.Indexer) {
+	app.blobIndexer = indexer
+}
+
+// Close closes the blob index, if it is enabled. The app must not be used
+// afterwards.
+func (app *App) Close() error {
+	if app.blobIndexer == nil {
+		return nil
+	}
+	return app.blobIndexer.Close()
 }
 
 func (app *App) setPostHanders() {
@@ -729,8 +814,35 @@ func GetMaccPerms() map[string][]string {
 	return dupMaccPerms
 }
 
-// initParamsKeeper init params keeper and its subspaces
-func initParamsKeeper(appCodec codec.BinaryCodec, legacyAmino *codec.LegacyAmino, key, tkey storetypes.StoreKey) paramskeeper.Keeper {
+// moduleAccountPerms returns the permissions of the module accounts of the
+// modules loaded for appVersion, including the blob module account that burns
+// the blob base fee from the version that charges it.
+func moduleAccountPerms(appVersion uint64) map[string][]string {
+	perms := GetMaccPerms()
+	if appconsts.BlobBaseFeeEnabled(appVersion) {
+		perms[blobmoduletypes.ModuleName] = []string{authtypes.Burner}
+	}
+	if appconsts.NamespaceRegistryEnabled(appVersion) {
+		perms[namespacemoduletypes.ModuleName] = []string{authtypes.Burner}
+	}
+	return perms
+}
+
+// loadedModules returns the names of the modules loaded by mm, in order,
+// dropping the modules of other app versions.
+func loadedModules(mm *module.Manager, moduleNames ...string) []string {
+	loaded := make([]string, 0, len(moduleNames))
+	for _, moduleName := range moduleNames {
+		if _, ok := mm.Modules[moduleName]; ok {
+			loaded = append(loaded, moduleName)
+		}
+	}
+	return loaded
+}
+
+// initParamsKeeper init params keeper and the subspaces of the modules loaded
+// for appVersion
+func initParamsKeeper(appCodec codec.BinaryCodec, legacyAmino *codec.LegacyAmino, key, tkey storetypes.StoreKey, appVersion uint64) paramskeeper.Keeper {
 	paramsKeeper := paramskeeper.NewKeeper(appCodec, legacyAmino, key, tkey)
 
 	paramsKeeper.Subspace(authtypes.ModuleName)
@@ -745,6 +857,9 @@ func initParamsKeeper(appCodec codec.BinaryCodec, legacyAmino *codec.LegacyAmino
 	paramsKeeper.Subspace(ibchost.ModuleName)
 	paramsKeeper.Subspace(blobmoduletypes.ModuleName)
 	paramsKeeper.Subspace(qgbmoduletypes.ModuleName)
+	if appconsts.NamespaceRegistryEnabled(appVersion) {
+		paramsKeeper.Subspace(namespacemoduletypes.ModuleName)
+	}
 
 	return paramsKeeper
 }
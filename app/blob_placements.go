@@ -0,0 +1,169 @@
+package app
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/celestiaorg/celestia-app/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/pkg/square"
+	blobtypes "github.com/celestiaorg/celestia-app/x/blob/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	coretypes "github.com/tendermint/tendermint/types"
+)
+
+// blobPlacements tracks where the blobs of the block that is being executed
+// were placed in its data square.
+//
+// Tendermint only passes the PFB transaction of a blob tx to DeliverTx, so the
+// share indexes of its blobs, which are part of the IndexWrapper written to
+// the square, are unknown while the block is executed. The layout of the
+// square however only depends on the transactions of the block and on the
+// sizes, namespaces and share versions of the blobs, which are part of the
+// PFBs. Once all transactions of the block were delivered, the square is
+// therefore rebuilt with placeholder blobs. This only uses data that every
+// node executing the block has, so the placements are known for every block.
+//
+// The placement events are therefore part of the events of the end of the
+// block rather than of the result of the PFB transaction: when a PFB is
+// delivered, where its blobs are placed still depends on the transactions of
+// the block that are delivered after it.
+type blobPlacements struct {
+	// txs are the transactions delivered for the current block. The PFB
+	// transactions are wrapped in blob txs with placeholder blobs.
+	txs [][]byte
+	// pfbs are the PFBs of the blob txs in txs, in the same order.
+	pfbs []*blobtypes.MsgPayForBlobs
+	// succeeded is true for each PFB in pfbs that was executed successfully.
+	succeeded []bool
+	// err is the first error encountered while recording the transactions.
+	err error
+	// built is true once the square of the block was rebuilt.
+	built bool
+	// wrappedPFBs are the PFB transactions of the block wrapped with the
+	// share indexes of their blobs. They are set at the end of the block.
+	wrappedPFBs [][]byte
+}
+
+func newBlobPlacements() *blobPlacements {
+	return &blobPlacements{}
+}
+
+// reset prepares the placements for the execution of a new block.
+func (p *blobPlacements) reset() {
+	p.txs = nil
+	p.pfbs = nil
+	p.succeeded = nil
+	p.err = nil
+	p.built = false
+	p.wrappedPFBs = nil
+}
+
+// add records a transaction delivered for the current block and whether it
+// was executed successfully.
+func (p *blobPlacements) add(tx []byte, ok bool) {
+	pfb, err := blobtypes.PFBFromTx(tx)
+	if err != nil {
+		// the transaction wasn't part of a blob tx
+		p.txs = append(p.txs, tx)
+		return
+	}
+	blobTx, err := placeholderBlobTx(tx, pfb)
+	if err != nil && p.err == nil {
+		p.err = err
+	}
+	p.txs = append(p.txs, blobTx)
+	p.pfbs = append(p.pfbs, pfb)
+	p.succeeded = append(p.succeeded, ok)
+}
+
+// endBlock rebuilds the square of the current block and returns the events
+// describing the placements of the blobs of the successful PFBs.
+func (p *blobPlacements) endBlock(appVersion uint64) ([]abci.Event, error) {
+	if p.err != nil {
+		return nil, p.err
+	}
+	if len(p.pfbs) == 0 {
+		// there are no blobs to place, so the square isn't rebuilt
+		p.built = true
+		return nil, nil
+	}
+	if appVersion == 0 {
+		return nil, errors.New("app version is not set")
+	}
+	// the square size upper bound is used since the max square size set by
+	// governance when the block was proposed may have changed since then
+	dataSquare, err := square.Construct(p.txs, appVersion, appconsts.SquareSizeUpperBound(appVersion))
+	if err != nil {
+		return nil, err
+	}
+	wpfbs, err := dataSquare.WrappedPFBs()
+	if err != nil {
+		return nil, err
+	}
+	if len(wpfbs) != len(p.pfbs) {
+		return nil, fmt.Errorf("square contains %d PFBs, expected %d", len(wpfbs), len(p.pfbs))
+	}
+	p.wrappedPFBs = wpfbs.ToSliceOfBytes()
+	p.built = true
+
+	var events []abci.Event
+	for i, wpfb := range p.wrappedPFBs {
+		if !p.succeeded[i] {
+			continue
+		}
+		indexWrapper, isIndexWrapper := coretypes.UnmarshalIndexWrapper(wpfb)
+		if !isIndexWrapper {
+			return nil, fmt.Errorf("PFB %d isn't wrapped", i)
+		}
+		txHash := fmt.Sprintf("%X", coretypes.Tx(indexWrapper.Tx).Hash())
+		for _, placement := range blobtypes.NewBlobPlacementEvents(txHash, p.pfbs[i], indexWrapper.ShareIndexes) {
+			event, err := sdk.TypedEventToEvent(placement)
+			if err != nil {
+				return nil, err
+			}
+			events = append(events, abci.Event(event))
+		}
+	}
+	return events, nil
+}
+
+// block returns the wrapped PFBs of the executed block and false if its square
+// couldn't be rebuilt.
+func (p *blobPlacements) block() ([][]byte, bool) {
+	return p.wrappedPFBs, p.built
+}
+
+// placeholderBlobTx wraps the PFB transaction tx in a blob tx whose blobs have
+// the sizes, namespaces and share versions of the blobs paid for by pfb but
+// contain zeros. They occupy the same shares as the original blobs.
+func placeholderBlobTx(tx []byte, pfb *blobtypes.MsgPayForBlobs) ([]byte, error) {
+	if len(pfb.Namespaces) != len(pfb.BlobSizes) || len(pfb.ShareVersions) != len(pfb.BlobSizes) {
+		return nil, fmt.Errorf("PFB has %d blob sizes, %d namespaces and %d share versions", len(pfb.BlobSizes), len(pfb.Namespaces), len(pfb.ShareVersions))
+	}
+	blobs := make([]*tmproto.Blob, len(pfb.BlobSizes))
+	for i, size := range pfb.BlobSizes {
+		if len(pfb.Namespaces[i]) != appconsts.NamespaceSize {
+			return nil, fmt.Errorf("namespace %d has size %d, expected %d", i, len(pfb.Namespaces[i]), appconsts.NamespaceSize)
+		}
+		blobs[i] = &tmproto.Blob{
+			NamespaceVersion: uint32(pfb.Namespaces[i][0]),
+			NamespaceId:      pfb.Namespaces[i][1:],
+			Data:             make([]byte, size),
+			ShareVersion:     pfb.ShareVersions[i],
+		}
+	}
+	return coretypes.MarshalBlobTx(tx, blobs...)
+}
+
+// DeliverTx implements the ABCI interface and executes a tx in DeliverTx mode.
+// This method wraps the default Baseapp's method so that it can record the tx
+// to compute the placements of the blobs of the block at its end. The
+// placement events can't be added to the result of a PFB here, see
+// blobPlacements.
+func (app *App) DeliverTx(req abci.RequestDeliverTx) abci.ResponseDeliverTx {
+	res := app.BaseApp.DeliverTx(req)
+	app.blobPlacements.add(req.Tx, res.IsOK())
+	return res
+}
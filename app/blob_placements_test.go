@@ -0,0 +1,21 @@
+package app
+
+import (
+	"testing"
+
+	blobtypes "github.com/celestiaorg/celestia-app/x/blob/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_blobPlacementsWithoutAppVersion(t *testing.T) {
+	placements := newBlobPlacements()
+	placements.pfbs = []*blobtypes.MsgPayForBlobs{{}}
+	placements.succeeded = []bool{true}
+
+	events, err := placements.endBlock(0)
+	require.Error(t, err)
+	assert.Empty(t, events)
+	_, built := placements.block()
+	assert.False(t, built)
+}
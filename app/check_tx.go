@@ -23,10 +23,7 @@ func (app *App) CheckTx(req abci.RequestCheckTx) abci.ResponseCheckTx {
 			return sdkerrors.ResponseCheckTxWithEvents(err, 0, 0, []abci.Event{}, false)
 		}
 		// reject transactions that have a MsgPFB but no blobs attached to the tx
-		for _, msg := range sdkTx.GetMsgs() {
-			if _, ok := msg.(*blobtypes.MsgPayForBlobs); !ok {
-				continue
-			}
+		if blobtypes.ContainsPFB(sdkTx.GetMsgs(), app.AppVersion()) {
 			return sdkerrors.ResponseCheckTxWithEvents(blobtypes.ErrNoBlobs, 0, 0, []abci.Event{}, false)
 		}
 		// don't do anything special if we have a normal transaction
@@ -36,7 +33,7 @@ func (app *App) CheckTx(req abci.RequestCheckTx) abci.ResponseCheckTx {
 	switch req.Type {
 	// new transactions must be checked in their entirety
 	case abci.CheckTxType_New:
-		err := blobtypes.ValidateBlobTx(app.txConfig, btx)
+		err := blobtypes.ValidateBlobTx(app.txConfig, btx, app.AppVersion())
 		if err != nil {
 			return sdkerrors.ResponseCheckTxWithEvents(err, 0, 0, []abci.Event{}, false)
 		}
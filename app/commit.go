@@ -0,0 +1,33 @@
+package app
+
+import (
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+// Commit implements the ABCI interface by committing the state of the current
+// block. If the blob index is enabled, the blobs of the block are indexed. The
+// extended data squares cached for the block's height are dropped as they
+// can't be reused for proposals of later heights, and so are the blob
+// placements of the block.
+func (app *App) Commit() abci.ResponseCommit {
+	res := app.BaseApp.Commit()
+	if app.blobIndexer != nil {
+		app.indexBlobs(app.LastBlockHeight())
+	}
+	app.dahCache.clear()
+	app.blobPlacements.reset()
+	return res
+}
+
+// indexBlobs indexes the blobs of the committed block at height. Failing to
+// index a block doesn't halt the node as the index isn't part of the state.
+func (app *App) indexBlobs(height int64) {
+	wrappedPFBs, built := app.blobPlacements.block()
+	if !built {
+		app.Logger().Error("skipping the blob index of a block whose square couldn't be rebuilt, it can be indexed with the blob-index backfill command", "height", height)
+		return
+	}
+	if err := app.blobIndexer.IndexBlock(height, wrappedPFBs); err != nil {
+		app.Logger().Error("failed to index the blobs of the block", "height", height, "err", err)
+	}
+}
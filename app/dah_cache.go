@@ -0,0 +1,88 @@
+package app
+
+import (
+	"bytes"
+	"sync"
+
+	"github.com/celestiaorg/celestia-app/pkg/da"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	"github.com/tendermint/tendermint/crypto/merkle"
+)
+
+// dahCacheSize is the maximum number of data availability headers kept in the
+// cache. A proposer only prepares a handful of proposals per height, one for
+// each round that it is the proposer of.
+const dahCacheSize = 4
+
+// dahCacheEntry is the data availability header of an extended data square
+// built by PrepareProposal along with the transactions it was built from.
+type dahCacheEntry struct {
+	dataHash   []byte
+	txsHash    []byte
+	squareSize uint64
+	dah        da.DataAvailabilityHeader
+}
+
+// dahCache stores the data availability headers computed in PrepareProposal
+// keyed by their data hash so that ProcessProposal doesn't have to erasure
+// code and hash the same square a second time when it is asked to process a
+// proposal that this node prepared. Only the header is kept as the extended
+// data square itself isn't needed by ProcessProposal. The cache only holds
+// headers of the current height and is cleared on Commit.
+type dahCache struct {
+	mtx sync.Mutex
+	// entries is ordered from the least to the most recently added entry.
+	entries []dahCacheEntry
+	size    int
+}
+
+func newDAHCache(size int) *dahCache {
+	return &dahCache{size: size}
+}
+
+// add caches the data availability header of the square built from txs,
+// evicting the oldest entry if the cache is full.
+func (c *dahCache) add(txs [][]byte, squareSize uint64, dah da.DataAvailabilityHeader) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	if len(c.entries) == c.size {
+		c.entries = c.entries[1:]
+	}
+	c.entries = append(c.entries, dahCacheEntry{
+		dataHash:   dah.Hash(),
+		txsHash:    merkle.HashFromByteSlices(txs),
+		squareSize: squareSize,
+		dah:        dah,
+	})
+}
+
+// get returns the cached data availability header with the provided data hash
+// if it was built from exactly the provided transactions and has the provided
+// square size. Hits and misses are recorded so that the hit rate can be
+// monitored.
+func (c *dahCache) get(dataHash []byte, txs [][]byte, squareSize uint64) (da.DataAvailabilityHeader, bool) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	var txsHash []byte
+	for _, entry := range c.entries {
+		if entry.squareSize != squareSize || !bytes.Equal(entry.dataHash, dataHash) {
+			continue
+		}
+		if txsHash == nil {
+			txsHash = merkle.HashFromByteSlices(txs)
+		}
+		if bytes.Equal(entry.txsHash, txsHash) {
+			telemetry.IncrCounter(1, "dah_cache", "hit")
+			return entry.dah, true
+		}
+	}
+	telemetry.IncrCounter(1, "dah_cache", "miss")
+	return da.DataAvailabilityHeader{}, false
+}
+
+// clear removes all entries from the cache.
+func (c *dahCache) clear() {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	c.entries = nil
+}
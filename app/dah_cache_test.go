@@ -0,0 +1,67 @@
+package app
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/celestiaorg/celestia-app/pkg/da"
+	"github.com/celestiaorg/celestia-app/pkg/shares"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_dahCache(t *testing.T) {
+	newDAH := func(squareSize int) da.DataAvailabilityHeader {
+		eds, err := da.ExtendShares(shares.ToBytes(shares.TailPaddingShares(squareSize * squareSize)))
+		require.NoError(t, err)
+		return da.NewDataAvailabilityHeader(eds)
+	}
+	txs := [][]byte{[]byte("tx1"), []byte("tx2")}
+	dah := newDAH(2)
+
+	cache := newDAHCache(2)
+	cache.add(txs, 2, dah)
+
+	gotDAH, ok := cache.get(dah.Hash(), txs, 2)
+	require.True(t, ok)
+	assert.Equal(t, dah.Hash(), gotDAH.Hash())
+
+	type test struct {
+		name       string
+		dataHash   []byte
+		txs        [][]byte
+		squareSize uint64
+	}
+	tests := []test{
+		{"different data hash", []byte("hash"), txs, 2},
+		{"different txs", dah.Hash(), [][]byte{[]byte("tx1")}, 2},
+		{"reordered txs", dah.Hash(), [][]byte{txs[1], txs[0]}, 2},
+		{"different square size", dah.Hash(), txs, 4},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, ok := cache.get(tt.dataHash, tt.txs, tt.squareSize)
+			assert.False(t, ok)
+		})
+	}
+
+	t.Run("oldest entry is evicted", func(t *testing.T) {
+		hashes := make([][]byte, 3)
+		for i := range hashes {
+			squareSize := 1 << (i + 1)
+			dah := newDAH(squareSize)
+			cache.add([][]byte{[]byte(fmt.Sprint(i))}, uint64(squareSize), dah)
+			hashes[i] = dah.Hash()
+		}
+		_, ok := cache.get(hashes[0], [][]byte{[]byte("0")}, 2)
+		assert.False(t, ok)
+		_, ok = cache.get(hashes[2], [][]byte{[]byte("2")}, 8)
+		assert.True(t, ok)
+	})
+
+	t.Run("clear", func(t *testing.T) {
+		cache.clear()
+		_, ok := cache.get(dah.Hash(), txs, 2)
+		assert.False(t, ok)
+	})
+}
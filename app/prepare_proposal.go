@@ -1,6 +1,8 @@
 package app
 
 import (
+	"runtime"
+
 	"github.com/celestiaorg/celestia-app/pkg/da"
 	"github.com/celestiaorg/celestia-app/pkg/shares"
 	"github.com/celestiaorg/celestia-app/pkg/square"
@@ -19,20 +21,43 @@ func (app *App) PrepareProposal(req abci.RequestPrepareProposal) abci.ResponsePr
 		panic(err)
 	}
 
-	// verify the signatures of the PFBs in the block data. Only the valid PFBs are returned
-	txs := filterForValidPFBSignature(sdkCtx, &app.AccountKeeper, app.txConfig, req.BlockData.Txs)
+	// verify the signatures of the PFBs in the block data, that their
+	// signers may use their namespaces, that PFBs executed through an authz
+	// MsgExec are authorized, that their fees cover the blob base fee and
+	// that the fee allowances of their fee granters accept them. Only the
+	// valid PFBs are returned
+	txs := filterForValidPFBSignature(sdkCtx, app.AppVersion(), &app.AccountKeeper, app.BlobKeeper, app.NamespaceKeeper, app.AuthzKeeper, app.FeeGrantKeeper, app.txConfig, req.BlockData.Txs)
 
-	// build the square from the set of valid and prioritised transactions.
-	// The txs returned are the ones used in the square and block
-	dataSquare, txs, err := square.Build(txs, app.GetBaseApp().AppVersion(), app.GovSquareSizeUpperBound(sdkCtx))
+	// build the square from the set of valid transactions, ordering them by
+	// gas price so that the highest paying transactions are included first
+	// when the square is full. The txs returned are the ones used in the
+	// square and block
+	dataSquare, txs, err := square.BuildPrioritized(txs, app.txConfig.TxDecoder(), app.GetBaseApp().AppVersion(), app.GovSquareSizeUpperBound(sdkCtx))
 	if err != nil {
 		panic(err)
 	}
 
+	// the txs are checked again in the order of the square, which is the
+	// order that ProcessProposal checks them in, as the fee allowances and
+	// grants that they use depend on it. If ordering the txs invalidated any
+	// of them, the square is rebuilt without them.
+	checkCtx, err := app.NewProcessProposalQueryContext()
+	if err != nil {
+		panic(err)
+	}
+	if validTxs := filterForValidPFBSignature(checkCtx, app.AppVersion(), &app.AccountKeeper, app.BlobKeeper, app.NamespaceKeeper, app.AuthzKeeper, app.FeeGrantKeeper, app.txConfig, txs); len(validTxs) != len(txs) {
+		txs = validTxs
+		dataSquare, err = square.Construct(txs, app.GetBaseApp().AppVersion(), app.GovSquareSizeUpperBound(sdkCtx))
+		if err != nil {
+			panic(err)
+		}
+	}
+
 	// erasure the data square which we use to create the data root.
 	// Note: uses the nmt wrapper to construct the tree.
-	// checkout pkg/wrapper/nmt_wrapper.go for more information.
-	eds, err := da.ExtendShares(shares.ToBytes(dataSquare))
+	// checkout pkg/wrapper/nmt_wrapper.go for more information. The roots are
+	// computed by one worker per CPU.
+	eds, err := da.ExtendShares(shares.ToBytes(dataSquare), da.WithWorkers(runtime.NumCPU()))
 	if err != nil {
 		app.Logger().Error(
 			"failure to erasure the data square while creating a proposal block",
@@ -46,6 +71,11 @@ func (app *App) PrepareProposal(req abci.RequestPrepareProposal) abci.ResponsePr
 	// roots of each row and col of the erasure data).
 	dah := da.NewDataAvailabilityHeader(eds)
 
+	// cache the data availability header so that the extended data square
+	// doesn't need to be computed again when this node processes its own
+	// proposal.
+	app.dahCache.add(txs, uint64(dataSquare.Size()), dah)
+
 	// tendermint doesn't need to use any of the erasure data, as only the
 	// protobuf encoded version of the block data is gossiped.
 	return abci.ResponsePrepareProposal{
@@ -3,12 +3,12 @@ package app
 import (
 	"bytes"
 	"fmt"
+	"runtime"
 
 	"github.com/celestiaorg/celestia-app/pkg/da"
 	"github.com/celestiaorg/celestia-app/pkg/shares"
 	"github.com/celestiaorg/celestia-app/pkg/square"
 	blobtypes "github.com/celestiaorg/celestia-app/x/blob/types"
-	sdk "github.com/cosmos/cosmos-sdk/types"
 	abci "github.com/tendermint/tendermint/abci/types"
 	"github.com/tendermint/tendermint/libs/log"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
@@ -21,9 +21,9 @@ func (app *App) ProcessProposal(req abci.RequestProcessProposal) abci.ResponsePr
 	// create the anteHanders that are used to check the validity of
 	// transactions. We verify the signatures of PFB containing txs using the
 	// sigVerifyAnterHandler, and simply increase the nonce of all other
-	// transactions.
-	svHander := sigVerifyAnteHandler(&app.AccountKeeper, app.txConfig)
-	seqHandler := incrementSequenceAnteHandler(&app.AccountKeeper)
+	// transactions and use their fee allowances.
+	svHander := sigVerifyAnteHandler(app.AppVersion(), &app.AccountKeeper, app.BlobKeeper, app.NamespaceKeeper, app.AuthzKeeper, app.FeeGrantKeeper, app.txConfig)
+	seqHandler := incrementSequenceAnteHandler(app.AppVersion(), &app.AccountKeeper, app.FeeGrantKeeper)
 	sdkCtx, err := app.NewProcessProposalQueryContext()
 	if err != nil {
 		logInvalidPropBlockError(app.Logger(), req.Header, "failure to load query context", err)
@@ -49,8 +49,7 @@ func (app *App) ProcessProposal(req abci.RequestProcessProposal) abci.ResponsePr
 
 		// handle non-blob transactions first
 		if !isBlobTx {
-			_, has := hasPFB(sdkTx.GetMsgs())
-			if has {
+			if blobtypes.ContainsPFB(sdkTx.GetMsgs(), app.AppVersion()) {
 				// A non blob tx has a PFB, which is invalid
 				logInvalidPropBlock(app.Logger(), req.Header, fmt.Sprintf("tx %d has PFB but is not a blob tx", idx))
 				return reject()
@@ -76,40 +75,49 @@ func (app *App) ProcessProposal(req abci.RequestProcessProposal) abci.ResponsePr
 		// - that the sizes match
 		// - that the namespaces match between blob and PFB
 		// - that the share commitment is correct
-		if err := blobtypes.ValidateBlobTx(app.txConfig, blobTx); err != nil {
+		if err := blobtypes.ValidateBlobTx(app.txConfig, blobTx, app.AppVersion()); err != nil {
 			logInvalidPropBlockError(app.Logger(), req.Header, fmt.Sprintf("invalid blob tx %d", idx), err)
 			return reject()
 		}
 
-		// validated the PFB signature
+		// validate the PFB signature, that the signer may use the namespaces
+		// of the PFB, that the PFB is authorized if it is executed through
+		// an authz MsgExec and that its fee covers the blob base fee
 		sdkCtx, err = svHander(sdkCtx, sdkTx, true)
 		if err != nil {
-			logInvalidPropBlockError(app.Logger(), req.Header, "invalid PFB signature", err)
+			logInvalidPropBlockError(app.Logger(), req.Header, "invalid PFB signature or namespace", err)
 			return reject()
 		}
 
 	}
 
-	// Construct the data square from the block's transactions
-	dataSquare, err := square.Construct(req.BlockData.Txs, app.GetBaseApp().AppVersion(), app.GovSquareSizeUpperBound(sdkCtx))
-	if err != nil {
-		logInvalidPropBlockError(app.Logger(), req.Header, "failure to compute data square from transactions:", err)
-		return reject()
-	}
+	// If this node prepared the proposal, the data availability header of the
+	// square built from the exact same transactions is reused instead of
+	// being computed again.
+	dah, cached := app.dahCache.get(req.Header.DataHash, req.BlockData.Txs, req.BlockData.SquareSize)
+	if !cached {
+		// Construct the data square from the block's transactions
+		dataSquare, err := square.Construct(req.BlockData.Txs, app.GetBaseApp().AppVersion(), app.GovSquareSizeUpperBound(sdkCtx))
+		if err != nil {
+			logInvalidPropBlockError(app.Logger(), req.Header, "failure to compute data square from transactions:", err)
+			return reject()
+		}
 
-	// Assert that the square size stated by the proposer is correct
-	if uint64(dataSquare.Size()) != req.BlockData.SquareSize {
-		logInvalidPropBlock(app.Logger(), req.Header, "proposed square size differs from calculated square size")
-		return reject()
-	}
+		// Assert that the square size stated by the proposer is correct
+		if uint64(dataSquare.Size()) != req.BlockData.SquareSize {
+			logInvalidPropBlock(app.Logger(), req.Header, "proposed square size differs from calculated square size")
+			return reject()
+		}
 
-	eds, err := da.ExtendShares(shares.ToBytes(dataSquare))
-	if err != nil {
-		logInvalidPropBlockError(app.Logger(), req.Header, "failure to erasure the data square", err)
-		return reject()
+		eds, err := da.ExtendShares(shares.ToBytes(dataSquare), da.WithWorkers(runtime.NumCPU()))
+		if err != nil {
+			logInvalidPropBlockError(app.Logger(), req.Header, "failure to erasure the data square", err)
+			return reject()
+		}
+
+		dah = da.NewDataAvailabilityHeader(eds)
 	}
 
-	dah := da.NewDataAvailabilityHeader(eds)
 	// by comparing the hashes we know the computed IndexWrappers (with the share indexes of the PFB's blobs)
 	// are identical and that square layout is consistent. This also means that the share commitment rules
 	// have been followed and thus each blobs share commitment should be valid
@@ -121,15 +129,6 @@ func (app *App) ProcessProposal(req abci.RequestProcessProposal) abci.ResponsePr
 	return accept()
 }
 
-func hasPFB(msgs []sdk.Msg) (*blobtypes.MsgPayForBlobs, bool) {
-	for _, msg := range msgs {
-		if pfb, ok := msg.(*blobtypes.MsgPayForBlobs); ok {
-			return pfb, true
-		}
-	}
-	return nil, false
-}
-
 func logInvalidPropBlock(l log.Logger, h tmproto.Header, reason string) {
 	l.Error(
 		rejectedPropBlockLog,
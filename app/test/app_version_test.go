@@ -0,0 +1,65 @@
+package app_test
+
+import (
+	"testing"
+
+	"github.com/celestiaorg/celestia-app/app"
+	"github.com/celestiaorg/celestia-app/app/encoding"
+	v1 "github.com/celestiaorg/celestia-app/pkg/appconsts/v1"
+	v2 "github.com/celestiaorg/celestia-app/pkg/appconsts/v2"
+	appns "github.com/celestiaorg/celestia-app/pkg/namespace"
+	testutil "github.com/celestiaorg/celestia-app/test/util"
+	blobtypes "github.com/celestiaorg/celestia-app/x/blob/types"
+	namespacetypes "github.com/celestiaorg/celestia-app/x/namespace/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+)
+
+// TestAppVersions tests that the x/namespace module and the blob base fee are
+// only loaded and used by the app version that enables them.
+func TestAppVersions(t *testing.T) {
+	encCfg := encoding.MakeConfig(app.ModuleEncodingRegisters...)
+	accounts := []string{"owner"}
+
+	type test struct {
+		name       string
+		appVersion uint64
+		enabled    bool
+	}
+	tests := []test{
+		{"v1", v1.Version, false},
+		{"v2", v2.Version, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			testApp, kr := testutil.SetupTestAppWithAppVersion(app.DefaultConsensusParams(), tt.appVersion, accounts...)
+			testApp.EndBlock(abci.RequestEndBlock{Height: testApp.LastBlockHeight() + 1})
+			testApp.Commit()
+			require.EqualValues(t, tt.appVersion, testApp.AppVersion())
+			assert.Equal(t, tt.enabled, testApp.GetKey(namespacetypes.StoreKey) != nil)
+
+			owner := accountAddress(t, kr, accounts[0]).String()
+			namespace := appns.RandomBlobNamespace()
+			registerTx := signTx(t, testApp, encCfg, kr, accounts[0], nil, namespacetypes.NewMsgRegisterNamespace(owner, namespace))
+			results := deliverBlock(testApp, registerTx)
+			if !tt.enabled {
+				assert.NotEqual(t, abci.CodeTypeOK, results[0].Code)
+				// the blob base fee is neither stored nor adjusted
+				ctx := testApp.NewContext(true, tmproto.Header{})
+				assert.False(t, ctx.KVStore(testApp.GetKey(blobtypes.StoreKey)).Has(blobtypes.BlobBaseFeeKey))
+				return
+			}
+			require.Equal(t, abci.CodeTypeOK, results[0].Code, results[0].Log)
+
+			ctx := testApp.NewContext(true, tmproto.Header{})
+			registration, registered := testApp.NamespaceKeeper.GetRegistration(ctx, namespace.Bytes())
+			require.True(t, registered)
+			assert.Equal(t, owner, registration.Owner)
+			// the blob base fee is adjusted at the end of each v2 block
+			assert.True(t, ctx.KVStore(testApp.GetKey(blobtypes.StoreKey)).Has(blobtypes.BlobBaseFeeKey))
+			assert.Equal(t, blobtypes.MinBlobBaseFee, testApp.BlobKeeper.GetBlobBaseFee(ctx))
+		})
+	}
+}
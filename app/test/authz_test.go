@@ -0,0 +1,490 @@
+package app_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/celestiaorg/celestia-app/app"
+	"github.com/celestiaorg/celestia-app/app/encoding"
+	"github.com/celestiaorg/celestia-app/pkg/appconsts"
+	v2 "github.com/celestiaorg/celestia-app/pkg/appconsts/v2"
+	"github.com/celestiaorg/celestia-app/pkg/da"
+	appns "github.com/celestiaorg/celestia-app/pkg/namespace"
+	"github.com/celestiaorg/celestia-app/pkg/shares"
+	"github.com/celestiaorg/celestia-app/pkg/square"
+	testutil "github.com/celestiaorg/celestia-app/test/util"
+	blobtypes "github.com/celestiaorg/celestia-app/x/blob/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmrand "github.com/tendermint/tendermint/libs/rand"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	tmversion "github.com/tendermint/tendermint/proto/tendermint/version"
+	coretypes "github.com/tendermint/tendermint/types"
+)
+
+// TestPayForBlobsWithMsgExec tests that a grantee can pay for blobs on behalf
+// of a granter, who pays the fees, by wrapping the MsgPayForBlobs of the
+// granter in an authz MsgExec.
+func TestPayForBlobsWithMsgExec(t *testing.T) {
+	encCfg := encoding.MakeConfig(app.ModuleEncodingRegisters...)
+	accounts := []string{"granter", "grantee", "stranger"}
+	testApp, kr := testutil.SetupTestAppWithAppVersion(app.DefaultConsensusParams(), v2.Version, accounts...)
+	testApp.EndBlock(abci.RequestEndBlock{Height: testApp.LastBlockHeight() + 1})
+	testApp.Commit()
+
+	granter, grantee := accountAddress(t, kr, accounts[0]), accountAddress(t, kr, accounts[1])
+	authorized := appns.MustNewV0(bytes.Repeat([]byte{1}, appns.NamespaceVersionZeroIDSize))
+	unauthorized := appns.MustNewV0(bytes.Repeat([]byte{2}, appns.NamespaceVersionZeroIDSize))
+
+	grant, err := authz.NewMsgGrant(granter, grantee, blobtypes.NewBlobAuthorization([]appns.Namespace{authorized}, 1500, 0, nil), nil)
+	require.NoError(t, err)
+	allowance, err := feegrant.NewMsgGrantAllowance(&feegrant.BasicAllowance{}, granter, grantee)
+	require.NoError(t, err)
+	for _, res := range deliverBlock(testApp, signTx(t, testApp, encCfg, kr, accounts[0], nil, grant, allowance)) {
+		require.Equal(t, abci.CodeTypeOK, res.Code, res.Log)
+	}
+
+	granteeBalance := balance(testApp, grantee)
+	granterBalance := balance(testApp, granter)
+
+	// execBlobTx returns a blob tx in which the signer executes a
+	// MsgPayForBlobs of the granter through a MsgExec and the fee granter
+	// pays the fees
+	execBlobTx := func(signer string, feeGranter sdk.AccAddress, ns appns.Namespace) []byte {
+		blob, err := blobtypes.NewBlob(ns, tmrand.Bytes(1000), 0)
+		require.NoError(t, err)
+		pfb, err := blobtypes.NewMsgPayForBlobs(granter.String(), blob)
+		require.NoError(t, err)
+		exec := authz.NewMsgExec(accountAddress(t, kr, signer), []sdk.Msg{pfb})
+		tx := signTx(t, testApp, encCfg, kr, signer, feeGranter, &exec)
+		blobTx, err := coretypes.MarshalBlobTx(tx, blob)
+		require.NoError(t, err)
+		return blobTx
+	}
+	validTx := execBlobTx(accounts[1], granter, authorized)
+
+	type test struct {
+		name         string
+		tx           []byte
+		expectedCode uint32
+	}
+	// the rejected txs are checked first as the authorization is checked
+	// after the sequence of the signer is incremented
+	tests := []test{
+		{"unauthorized namespace", execBlobTx(accounts[1], granter, unauthorized), blobtypes.ErrNamespaceNotAuthorized.ABCICode()},
+		{"no grant", execBlobTx(accounts[2], nil, authorized), authz.ErrNoAuthorizationFound.ABCICode()},
+		{"authorized namespace", validTx, abci.CodeTypeOK},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res := testApp.CheckTx(abci.RequestCheckTx{Type: abci.CheckTxType_New, Tx: tt.tx})
+			assert.Equal(t, tt.expectedCode, res.Code, res.Log)
+		})
+	}
+
+	// only the authorized PFB is included in a block
+	resp := testApp.PrepareProposal(abci.RequestPrepareProposal{BlockData: &tmproto.Data{
+		Txs: [][]byte{validTx, tests[0].tx, tests[1].tx},
+	}})
+	require.Equal(t, [][]byte{validTx}, resp.BlockData.Txs)
+	processRes := testApp.ProcessProposal(abci.RequestProcessProposal{
+		BlockData: resp.BlockData,
+		Header:    tmproto.Header{DataHash: resp.BlockData.Hash},
+	})
+	require.Equal(t, abci.ResponseProcessProposal_ACCEPT, processRes.Result)
+
+	blobTx, _ := coretypes.UnmarshalBlobTx(validTx)
+	results := deliverBlock(testApp, blobTx.Tx)
+	require.Equal(t, abci.CodeTypeOK, results[0].Code, results[0].Log)
+	assert.Equal(t, granteeBalance, balance(testApp, grantee))
+	assert.True(t, balance(testApp, granter).LT(granterBalance))
+
+	// the blob bytes are deducted from the byte budget of the grant
+	ctx := testApp.NewContext(true, tmproto.Header{})
+	updated, _ := testApp.AuthzKeeper.GetAuthorization(ctx, grantee, granter, blobtypes.URLMsgPayForBlobs)
+	require.NotNil(t, updated)
+	assert.EqualValues(t, 1000, updated.(*blobtypes.BlobAuthorization).BytesUsed)
+	res := testApp.CheckTx(abci.RequestCheckTx{Type: abci.CheckTxType_New, Tx: execBlobTx(accounts[1], granter, authorized)})
+	assert.Equal(t, blobtypes.ErrBlobBytesLimitExceeded.ABCICode(), res.Code, res.Log)
+}
+
+// TestPayForBlobsWithMsgExecBeforeV2 tests that a PFB executed through an
+// authz MsgExec isn't accepted in a blob tx before app version 2.
+func TestPayForBlobsWithMsgExecBeforeV2(t *testing.T) {
+	encCfg := encoding.MakeConfig(app.ModuleEncodingRegisters...)
+	accounts := []string{"granter", "grantee"}
+	testApp, kr := testutil.SetupTestAppWithGenesisValSet(app.DefaultConsensusParams(), accounts...)
+	testApp.EndBlock(abci.RequestEndBlock{Height: testApp.LastBlockHeight() + 1})
+	testApp.Commit()
+	deliverBlock(testApp)
+
+	granter, grantee := accountAddress(t, kr, accounts[0]), accountAddress(t, kr, accounts[1])
+	blob, err := blobtypes.NewBlob(appns.RandomBlobNamespace(), tmrand.Bytes(1000), 0)
+	require.NoError(t, err)
+	pfb, err := blobtypes.NewMsgPayForBlobs(granter.String(), blob)
+	require.NoError(t, err)
+	exec := authz.NewMsgExec(grantee, []sdk.Msg{pfb})
+	blobTx, err := coretypes.MarshalBlobTx(signTx(t, testApp, encCfg, kr, accounts[1], nil, &exec), blob)
+	require.NoError(t, err)
+
+	res := testApp.CheckTx(abci.RequestCheckTx{Type: abci.CheckTxType_New, Tx: blobTx})
+	assert.Equal(t, blobtypes.ErrNoPFB.ABCICode(), res.Code, res.Log)
+	resp := testApp.PrepareProposal(abci.RequestPrepareProposal{BlockData: &tmproto.Data{Txs: [][]byte{blobTx}}})
+	assert.Empty(t, resp.BlockData.Txs)
+}
+
+// TestPayForBlobsWithMsgExecByteBudget tests that the blob bytes spent by a
+// PFB executed through an authz MsgExec are deducted from the byte budget of
+// the grant before the next PFB of the same block or mempool is checked.
+func TestPayForBlobsWithMsgExecByteBudget(t *testing.T) {
+	encCfg := encoding.MakeConfig(app.ModuleEncodingRegisters...)
+	accounts := []string{"granter", "grantee"}
+	testApp, kr := testutil.SetupTestAppWithAppVersion(app.DefaultConsensusParams(), v2.Version, accounts...)
+	testApp.EndBlock(abci.RequestEndBlock{Height: testApp.LastBlockHeight() + 1})
+	testApp.Commit()
+
+	granter, grantee := accountAddress(t, kr, accounts[0]), accountAddress(t, kr, accounts[1])
+	ns := appns.MustNewV0(bytes.Repeat([]byte{1}, appns.NamespaceVersionZeroIDSize))
+	grant, err := authz.NewMsgGrant(granter, grantee, blobtypes.NewBlobAuthorization([]appns.Namespace{ns}, 1500, 0, nil), nil)
+	require.NoError(t, err)
+	for _, res := range deliverBlock(testApp, signTx(t, testApp, encCfg, kr, accounts[0], nil, grant)) {
+		require.Equal(t, abci.CodeTypeOK, res.Code, res.Log)
+	}
+
+	// each PFB fits in the budget but both together don't
+	sequence := testutil.DirectQueryAccount(testApp, grantee).GetSequence()
+	txs := make([][]byte, 2)
+	for i := range txs {
+		blob, err := blobtypes.NewBlob(ns, tmrand.Bytes(1000), 0)
+		require.NoError(t, err)
+		pfb, err := blobtypes.NewMsgPayForBlobs(granter.String(), blob)
+		require.NoError(t, err)
+		exec := authz.NewMsgExec(grantee, []sdk.Msg{pfb})
+		tx := signTxWithSequence(t, testApp, encCfg, kr, accounts[1], sequence+uint64(i), nil, &exec)
+		txs[i], err = coretypes.MarshalBlobTx(tx, blob)
+		require.NoError(t, err)
+	}
+
+	res := testApp.CheckTx(abci.RequestCheckTx{Type: abci.CheckTxType_New, Tx: txs[0]})
+	require.Equal(t, abci.CodeTypeOK, res.Code, res.Log)
+	res = testApp.CheckTx(abci.RequestCheckTx{Type: abci.CheckTxType_New, Tx: txs[1]})
+	assert.Equal(t, blobtypes.ErrBlobBytesLimitExceeded.ABCICode(), res.Code, res.Log)
+
+	resp := testApp.PrepareProposal(abci.RequestPrepareProposal{BlockData: &tmproto.Data{Txs: txs}})
+	assert.Equal(t, txs[:1], resp.BlockData.Txs)
+
+	dataSquare, err := square.Construct(txs, v2.Version, appconsts.DefaultSquareSizeUpperBound)
+	require.NoError(t, err)
+	eds, err := da.ExtendShares(shares.ToBytes(dataSquare))
+	require.NoError(t, err)
+	dah := da.NewDataAvailabilityHeader(eds)
+	processRes := testApp.ProcessProposal(abci.RequestProcessProposal{
+		BlockData: &tmproto.Data{Txs: txs, SquareSize: uint64(dataSquare.Size()), Hash: dah.Hash()},
+		Header:    tmproto.Header{DataHash: dah.Hash()},
+	})
+	assert.Equal(t, abci.ResponseProcessProposal_REJECT, processRes.Result)
+}
+
+// TestPayForBlobsWithBlobAllowance tests that the blob bytes paid for with a
+// BlobAllowance are deducted from it before the next PFB of the same block or
+// mempool is checked.
+func TestPayForBlobsWithBlobAllowance(t *testing.T) {
+	encCfg := encoding.MakeConfig(app.ModuleEncodingRegisters...)
+	accounts := []string{"granter", "grantee"}
+	testApp, kr := testutil.SetupTestAppWithAppVersion(app.DefaultConsensusParams(), v2.Version, accounts...)
+	testApp.EndBlock(abci.RequestEndBlock{Height: testApp.LastBlockHeight() + 1})
+	testApp.Commit()
+
+	granter, grantee := accountAddress(t, kr, accounts[0]), accountAddress(t, kr, accounts[1])
+	allowance, err := blobtypes.NewBlobAllowance(&feegrant.BasicAllowance{}, 1500)
+	require.NoError(t, err)
+	grant, err := feegrant.NewMsgGrantAllowance(allowance, granter, grantee)
+	require.NoError(t, err)
+	for _, res := range deliverBlock(testApp, signTx(t, testApp, encCfg, kr, accounts[0], nil, grant)) {
+		require.Equal(t, abci.CodeTypeOK, res.Code, res.Log)
+	}
+
+	// each PFB fits in the allowance but both together don't
+	ns := appns.MustNewV0(bytes.Repeat([]byte{1}, appns.NamespaceVersionZeroIDSize))
+	sequence := testutil.DirectQueryAccount(testApp, grantee).GetSequence()
+	txs := make([][]byte, 2)
+	for i := range txs {
+		blob, err := blobtypes.NewBlob(ns, tmrand.Bytes(1000), 0)
+		require.NoError(t, err)
+		pfb, err := blobtypes.NewMsgPayForBlobs(grantee.String(), blob)
+		require.NoError(t, err)
+		tx := signTxWithSequence(t, testApp, encCfg, kr, accounts[1], sequence+uint64(i), granter, pfb)
+		txs[i], err = coretypes.MarshalBlobTx(tx, blob)
+		require.NoError(t, err)
+	}
+
+	res := testApp.CheckTx(abci.RequestCheckTx{Type: abci.CheckTxType_New, Tx: txs[0]})
+	require.Equal(t, abci.CodeTypeOK, res.Code, res.Log)
+	res = testApp.CheckTx(abci.RequestCheckTx{Type: abci.CheckTxType_New, Tx: txs[1]})
+	assert.Equal(t, blobtypes.ErrBlobBytesLimitExceeded.ABCICode(), res.Code, res.Log)
+
+	resp := testApp.PrepareProposal(abci.RequestPrepareProposal{BlockData: &tmproto.Data{Txs: txs}})
+	assert.Equal(t, txs[:1], resp.BlockData.Txs)
+
+	dataSquare, err := square.Construct(txs, v2.Version, appconsts.DefaultSquareSizeUpperBound)
+	require.NoError(t, err)
+	eds, err := da.ExtendShares(shares.ToBytes(dataSquare))
+	require.NoError(t, err)
+	dah := da.NewDataAvailabilityHeader(eds)
+	processRes := testApp.ProcessProposal(abci.RequestProcessProposal{
+		BlockData: &tmproto.Data{Txs: txs, SquareSize: uint64(dataSquare.Size()), Hash: dah.Hash()},
+		Header:    tmproto.Header{DataHash: dah.Hash()},
+	})
+	assert.Equal(t, abci.ResponseProcessProposal_REJECT, processRes.Result)
+}
+
+// TestFeeAllowanceUsedByNormalTxs tests that the fee allowance used by the
+// normal txs of a proposal is no longer available to the PFBs that follow them.
+func TestFeeAllowanceUsedByNormalTxs(t *testing.T) {
+	encCfg := encoding.MakeConfig(app.ModuleEncodingRegisters...)
+	accounts := []string{"granter", "grantee"}
+	testApp, kr := testutil.SetupTestAppWithAppVersion(app.DefaultConsensusParams(), v2.Version, accounts...)
+	testApp.EndBlock(abci.RequestEndBlock{Height: testApp.LastBlockHeight() + 1})
+	testApp.Commit()
+
+	// the spend limit covers the fee of one tx but not of two
+	granter, grantee := accountAddress(t, kr, accounts[0]), accountAddress(t, kr, accounts[1])
+	spendLimit := sdk.NewCoins(sdk.NewInt64Coin(app.BondDenom, 150_000))
+	grant, err := feegrant.NewMsgGrantAllowance(&feegrant.BasicAllowance{SpendLimit: spendLimit}, granter, grantee)
+	require.NoError(t, err)
+	for _, res := range deliverBlock(testApp, signTx(t, testApp, encCfg, kr, accounts[0], nil, grant)) {
+		require.Equal(t, abci.CodeTypeOK, res.Code, res.Log)
+	}
+
+	sequence := testutil.DirectQueryAccount(testApp, grantee).GetSequence()
+	send := banktypes.NewMsgSend(grantee, granter, sdk.NewCoins(sdk.NewInt64Coin(app.BondDenom, 1)))
+	sendTx := signTxWithSequence(t, testApp, encCfg, kr, accounts[1], sequence, granter, send)
+	ns := appns.MustNewV0(bytes.Repeat([]byte{1}, appns.NamespaceVersionZeroIDSize))
+	blob, err := blobtypes.NewBlob(ns, tmrand.Bytes(1000), 0)
+	require.NoError(t, err)
+	pfb, err := blobtypes.NewMsgPayForBlobs(grantee.String(), blob)
+	require.NoError(t, err)
+	blobTx, err := coretypes.MarshalBlobTx(signTxWithSequence(t, testApp, encCfg, kr, accounts[1], sequence+1, granter, pfb), blob)
+	require.NoError(t, err)
+	txs := [][]byte{sendTx, blobTx}
+
+	resp := testApp.PrepareProposal(abci.RequestPrepareProposal{BlockData: &tmproto.Data{Txs: txs}})
+	assert.Equal(t, txs[:1], resp.BlockData.Txs)
+
+	dataSquare, err := square.Construct(txs, v2.Version, appconsts.DefaultSquareSizeUpperBound)
+	require.NoError(t, err)
+	eds, err := da.ExtendShares(shares.ToBytes(dataSquare))
+	require.NoError(t, err)
+	dah := da.NewDataAvailabilityHeader(eds)
+	processRes := testApp.ProcessProposal(abci.RequestProcessProposal{
+		BlockData: &tmproto.Data{Txs: txs, SquareSize: uint64(dataSquare.Size()), Hash: dah.Hash()},
+		Header:    tmproto.Header{DataHash: dah.Hash()},
+	})
+	assert.Equal(t, abci.ResponseProcessProposal_REJECT, processRes.Result)
+}
+
+// TestFeeAllowanceInSquareOrder tests that the PFBs of two signers whose fees
+// are paid by the same grantee from its fee allowance are checked in the order
+// of the square, in which the PFB that pays the higher gas price comes first,
+// rather than in the order of the mempool, so that the proposal is accepted by
+// ProcessProposal. The sequence and the allowance of the grantee are used in
+// that order too.
+func TestFeeAllowanceInSquareOrder(t *testing.T) {
+	encCfg := encoding.MakeConfig(app.ModuleEncodingRegisters...)
+	accounts := []string{"granter", "grantee", "cheap", "expensive"}
+	testApp, kr := testutil.SetupTestAppWithAppVersion(app.DefaultConsensusParams(), v2.Version, accounts...)
+	testApp.EndBlock(abci.RequestEndBlock{Height: testApp.LastBlockHeight() + 1})
+	testApp.Commit()
+
+	granter, grantee := accountAddress(t, kr, accounts[0]), accountAddress(t, kr, accounts[1])
+	allowance, err := blobtypes.NewBlobAllowance(&feegrant.BasicAllowance{}, 10_000)
+	require.NoError(t, err)
+	grant, err := feegrant.NewMsgGrantAllowance(allowance, granter, grantee)
+	require.NoError(t, err)
+	for _, res := range deliverBlock(testApp, signTx(t, testApp, encCfg, kr, accounts[0], nil, grant)) {
+		require.Equal(t, abci.CodeTypeOK, res.Code, res.Log)
+	}
+
+	// the grantee signs both PFBs as their fee payer, in mempool order
+	sequence := testutil.DirectQueryAccount(testApp, grantee).GetSequence()
+	pfbTx := func(account string, granteeSequence, gasLimit uint64, fee int64) []byte {
+		signer := accountAddress(t, kr, account)
+		blob, err := blobtypes.NewBlob(appns.RandomBlobNamespace(), tmrand.Bytes(1000), 0)
+		require.NoError(t, err)
+		pfb, err := blobtypes.NewMsgPayForBlobs(signer.String(), blob)
+		require.NoError(t, err)
+		builder := encCfg.TxConfig.NewTxBuilder()
+		require.NoError(t, builder.SetMsgs(pfb))
+		builder.SetGasLimit(gasLimit)
+		builder.SetFeeAmount(sdk.NewCoins(sdk.NewInt64Coin(app.BondDenom, fee)))
+		builder.SetFeePayer(grantee)
+		builder.SetFeeGranter(granter)
+		tx := signWithSigners(t, testApp, encCfg, kr, builder, []string{account, accounts[1]}, []uint64{testutil.DirectQueryAccount(testApp, signer).GetSequence(), granteeSequence})
+		blobTx, err := coretypes.MarshalBlobTx(tx, blob)
+		require.NoError(t, err)
+		return blobTx
+	}
+	cheapTx := pfbTx(accounts[2], sequence, 1_000_000, 50_000)
+	expensiveTx := pfbTx(accounts[3], sequence+1, 200_000, 100_000)
+
+	resp := testApp.PrepareProposal(abci.RequestPrepareProposal{BlockData: &tmproto.Data{Txs: [][]byte{cheapTx, expensiveTx}}})
+	assert.Equal(t, [][]byte{cheapTx}, resp.BlockData.Txs)
+	processRes := testApp.ProcessProposal(abci.RequestProcessProposal{
+		BlockData: resp.BlockData,
+		Header:    tmproto.Header{DataHash: resp.BlockData.Hash},
+	})
+	assert.Equal(t, abci.ResponseProcessProposal_ACCEPT, processRes.Result)
+}
+
+// TestFeeAllowancesInProposalsBeforeV2 tests that the fee allowances used by
+// the txs of a proposal aren't checked before app version 2, so that
+// proposals are built and checked as before.
+func TestFeeAllowancesInProposalsBeforeV2(t *testing.T) {
+	encCfg := encoding.MakeConfig(app.ModuleEncodingRegisters...)
+	accounts := []string{"granter", "grantee"}
+	testApp, kr := testutil.SetupTestAppWithGenesisValSet(app.DefaultConsensusParams(), accounts...)
+	testApp.EndBlock(abci.RequestEndBlock{Height: testApp.LastBlockHeight() + 1})
+	testApp.Commit()
+
+	// the spend limit covers the fee of one tx but not of two
+	granter, grantee := accountAddress(t, kr, accounts[0]), accountAddress(t, kr, accounts[1])
+	spendLimit := sdk.NewCoins(sdk.NewInt64Coin(app.BondDenom, 150_000))
+	grant, err := feegrant.NewMsgGrantAllowance(&feegrant.BasicAllowance{SpendLimit: spendLimit}, granter, grantee)
+	require.NoError(t, err)
+	for _, res := range deliverBlock(testApp, signTx(t, testApp, encCfg, kr, accounts[0], nil, grant)) {
+		require.Equal(t, abci.CodeTypeOK, res.Code, res.Log)
+	}
+
+	sequence := testutil.DirectQueryAccount(testApp, grantee).GetSequence()
+	send := banktypes.NewMsgSend(grantee, granter, sdk.NewCoins(sdk.NewInt64Coin(app.BondDenom, 1)))
+	sendTx := signTxWithSequence(t, testApp, encCfg, kr, accounts[1], sequence, granter, send)
+	blob, err := blobtypes.NewBlob(appns.RandomBlobNamespace(), tmrand.Bytes(1000), 0)
+	require.NoError(t, err)
+	pfb, err := blobtypes.NewMsgPayForBlobs(grantee.String(), blob)
+	require.NoError(t, err)
+	blobTx, err := coretypes.MarshalBlobTx(signTxWithSequence(t, testApp, encCfg, kr, accounts[1], sequence+1, granter, pfb), blob)
+	require.NoError(t, err)
+	txs := [][]byte{sendTx, blobTx}
+
+	resp := testApp.PrepareProposal(abci.RequestPrepareProposal{BlockData: &tmproto.Data{Txs: txs}})
+	assert.Equal(t, txs, resp.BlockData.Txs)
+	processRes := testApp.ProcessProposal(abci.RequestProcessProposal{
+		BlockData: resp.BlockData,
+		Header:    tmproto.Header{DataHash: resp.BlockData.Hash},
+	})
+	assert.Equal(t, abci.ResponseProcessProposal_ACCEPT, processRes.Result)
+}
+
+// signTx signs msgs with the account and, if feeGranter is set, lets the fee
+// granter pay the fees.
+func signTx(t *testing.T, testApp *app.App, encCfg encoding.Config, kr keyring.Keyring, account string, feeGranter sdk.AccAddress, msgs ...sdk.Msg) []byte {
+	acc := testutil.DirectQueryAccount(testApp, accountAddress(t, kr, account))
+	return signTxWithSequence(t, testApp, encCfg, kr, account, acc.GetSequence(), feeGranter, msgs...)
+}
+
+// signTxWithSequence is like signTx but signs msgs with the provided sequence
+// instead of the sequence of the account.
+func signTxWithSequence(t *testing.T, testApp *app.App, encCfg encoding.Config, kr keyring.Keyring, account string, sequence uint64, feeGranter sdk.AccAddress, msgs ...sdk.Msg) []byte {
+	opts := []blobtypes.TxBuilderOption{
+		blobtypes.SetGasLimit(1_000_000),
+		blobtypes.SetFeeAmount(sdk.NewCoins(sdk.NewInt64Coin(app.BondDenom, 100_000))),
+	}
+	if feeGranter != nil {
+		opts = append(opts, blobtypes.SetFeeGranter(feeGranter))
+	}
+	return signTxWithOptions(t, testApp, encCfg, kr, account, sequence, opts, msgs...)
+}
+
+// signTxWithFee is like signTx but signs msgs with the provided gas limit and
+// fee in utia.
+func signTxWithFee(t *testing.T, testApp *app.App, encCfg encoding.Config, kr keyring.Keyring, account string, gasLimit uint64, fee int64, msgs ...sdk.Msg) []byte {
+	acc := testutil.DirectQueryAccount(testApp, accountAddress(t, kr, account))
+	opts := []blobtypes.TxBuilderOption{
+		blobtypes.SetGasLimit(gasLimit),
+		blobtypes.SetFeeAmount(sdk.NewCoins(sdk.NewInt64Coin(app.BondDenom, fee))),
+	}
+	return signTxWithOptions(t, testApp, encCfg, kr, account, acc.GetSequence(), opts, msgs...)
+}
+
+func signTxWithOptions(t *testing.T, testApp *app.App, encCfg encoding.Config, kr keyring.Keyring, account string, sequence uint64, opts []blobtypes.TxBuilderOption, msgs ...sdk.Msg) []byte {
+	acc := testutil.DirectQueryAccount(testApp, accountAddress(t, kr, account))
+	signer := blobtypes.NewKeyringSigner(kr, account, testutil.ChainID)
+	signer.SetEncodingConfig(encCfg)
+	signer.SetAccountNumber(acc.GetAccountNumber())
+	signer.SetSequence(sequence)
+	stx, err := signer.BuildSignedTx(signer.NewTxBuilder(opts...), msgs...)
+	require.NoError(t, err)
+	tx, err := signer.EncodeTx(stx)
+	require.NoError(t, err)
+	return tx
+}
+
+// signWithSigners signs the tx of builder with each of the accounts, using the
+// provided sequences.
+func signWithSigners(t *testing.T, testApp *app.App, encCfg encoding.Config, kr keyring.Keyring, builder client.TxBuilder, accounts []string, sequences []uint64) []byte {
+	signModeHandler := encCfg.TxConfig.SignModeHandler()
+	signMode := signModeHandler.DefaultMode()
+	sigs := make([]signing.SignatureV2, len(accounts))
+	signerData := make([]authsigning.SignerData, len(accounts))
+	for i, account := range accounts {
+		rec, err := kr.Key(account)
+		require.NoError(t, err)
+		pubKey, err := rec.GetPubKey()
+		require.NoError(t, err)
+		acc := testutil.DirectQueryAccount(testApp, accountAddress(t, kr, account))
+		sigs[i] = signing.SignatureV2{PubKey: pubKey, Data: &signing.SingleSignatureData{SignMode: signMode}, Sequence: sequences[i]}
+		signerData[i] = authsigning.SignerData{ChainID: testutil.ChainID, AccountNumber: acc.GetAccountNumber(), Sequence: sequences[i], PubKey: pubKey}
+	}
+	// the signer infos of all the signers are part of the signed bytes
+	require.NoError(t, builder.SetSignatures(sigs...))
+	for i, account := range accounts {
+		signBytes, err := signModeHandler.GetSignBytes(signMode, signerData[i], builder.GetTx())
+		require.NoError(t, err)
+		sig, _, err := kr.Sign(account, signBytes)
+		require.NoError(t, err)
+		sigs[i].Data = &signing.SingleSignatureData{SignMode: signMode, Signature: sig}
+	}
+	require.NoError(t, builder.SetSignatures(sigs...))
+	tx, err := encCfg.TxConfig.TxEncoder()(builder.GetTx())
+	require.NoError(t, err)
+	return tx
+}
+
+// deliverBlock executes a block containing txs and commits it.
+func deliverBlock(testApp *app.App, txs ...[]byte) []abci.ResponseDeliverTx {
+	height := testApp.LastBlockHeight() + 1
+	testApp.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{
+		Version: tmversion.Consensus{App: testApp.AppVersion()},
+		Height:  height,
+		ChainID: testutil.ChainID,
+	}})
+	results := make([]abci.ResponseDeliverTx, len(txs))
+	for i, tx := range txs {
+		results[i] = testApp.DeliverTx(abci.RequestDeliverTx{Tx: tx})
+	}
+	testApp.EndBlock(abci.RequestEndBlock{Height: height})
+	testApp.Commit()
+	return results
+}
+
+func accountAddress(t *testing.T, kr keyring.Keyring, account string) sdk.AccAddress {
+	rec, err := kr.Key(account)
+	require.NoError(t, err)
+	addr, err := rec.GetAddress()
+	require.NoError(t, err)
+	return addr
+}
+
+func balance(testApp *app.App, addr sdk.AccAddress) sdk.Int {
+	ctx := testApp.NewContext(true, tmproto.Header{})
+	return testApp.BankKeeper.GetBalance(ctx, addr, app.BondDenom).Amount
+}
@@ -0,0 +1,135 @@
+package app_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/celestiaorg/celestia-app/app"
+	"github.com/celestiaorg/celestia-app/app/encoding"
+	"github.com/celestiaorg/celestia-app/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/pkg/blobindex"
+	appns "github.com/celestiaorg/celestia-app/pkg/namespace"
+	"github.com/celestiaorg/celestia-app/pkg/square"
+	blobindexproto "github.com/celestiaorg/celestia-app/proto/celestia/blobindex"
+	testutil "github.com/celestiaorg/celestia-app/test/util"
+	blobtypes "github.com/celestiaorg/celestia-app/x/blob/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gogo/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+	tmrand "github.com/tendermint/tendermint/libs/rand"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	coretypes "github.com/tendermint/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+)
+
+// TestBlobPlacementEvents tests that the placement of the blobs of a PFB in
+// the data square is emitted at the end of the block, even if the node didn't
+// process the proposal of the block.
+func TestBlobPlacementEvents(t *testing.T) {
+	encCfg := encoding.MakeConfig(app.ModuleEncodingRegisters...)
+	accounts := []string{"alice"}
+	testApp, kr := testutil.SetupTestAppWithGenesisValSet(app.DefaultConsensusParams(), accounts...)
+	testApp.EndBlock(abci.RequestEndBlock{Height: testApp.LastBlockHeight() + 1})
+	testApp.Commit()
+	// deliver an empty block so that the chain ID is set in the check state
+	deliverBlock(testApp)
+
+	blobs := make([]*blobtypes.Blob, 2)
+	for i, size := range []int{100, 2000} {
+		blob, err := blobtypes.NewBlob(appns.RandomBlobNamespace(), tmrand.Bytes(size), appconsts.ShareVersionZero)
+		require.NoError(t, err)
+		blobs[i] = blob
+	}
+	pfb, err := blobtypes.NewMsgPayForBlobs(accountAddress(t, kr, accounts[0]).String(), blobs...)
+	require.NoError(t, err)
+	tx := signTx(t, testApp, encCfg, kr, accounts[0], nil, pfb)
+	blobTx, err := coretypes.MarshalBlobTx(tx, blobs...)
+	require.NoError(t, err)
+	blockData, results, endBlock := deliverPreparedBlock(t, testApp, blobTx)
+	require.Equal(t, abci.CodeTypeOK, results[0].Code, results[0].Log)
+
+	var events []*blobtypes.EventBlobPlacement
+	for _, event := range endBlock.Events {
+		if event.Type != proto.MessageName(&blobtypes.EventBlobPlacement{}) {
+			continue
+		}
+		typedEvent, err := sdk.ParseTypedEvent(event)
+		require.NoError(t, err)
+		events = append(events, typedEvent.(*blobtypes.EventBlobPlacement))
+	}
+	require.Len(t, events, len(blobs))
+	for i, event := range events {
+		blobRange, err := square.BlobShareRange(blockData.Txs, 0, i, appconsts.LatestVersion)
+		require.NoError(t, err)
+		assert.Equal(t, fmt.Sprintf("%X", tmhash.Sum(tx)), event.TxHash)
+		assert.Equal(t, pfb.Signer, event.Signer)
+		assert.Equal(t, pfb.Namespaces[i], event.Namespace)
+		assert.EqualValues(t, i, event.BlobIndex)
+		assert.Equal(t, pfb.BlobSizes[i], event.BlobSize)
+		assert.Equal(t, pfb.ShareCommitments[i], event.ShareCommitment)
+		assert.EqualValues(t, blobRange.Start, event.StartShareIndex)
+		assert.EqualValues(t, blobRange.End-blobRange.Start, event.ShareLength)
+	}
+}
+
+// TestBlobIndex tests that the blobs of the executed blocks are indexed on
+// commit if the blob index is enabled.
+func TestBlobIndex(t *testing.T) {
+	encCfg := encoding.MakeConfig(app.ModuleEncodingRegisters...)
+	accounts := []string{"alice"}
+	testApp, kr := testutil.SetupTestAppWithGenesisValSet(app.DefaultConsensusParams(), accounts...)
+	indexer := blobindex.NewIndexer(dbm.NewMemDB())
+	testApp.SetBlobIndexer(indexer)
+	testApp.EndBlock(abci.RequestEndBlock{Height: testApp.LastBlockHeight() + 1})
+	testApp.Commit()
+	deliverBlock(testApp)
+
+	ns := appns.RandomBlobNamespace()
+	blob, err := blobtypes.NewBlob(ns, tmrand.Bytes(1000), appconsts.ShareVersionZero)
+	require.NoError(t, err)
+	pfb, err := blobtypes.NewMsgPayForBlobs(accountAddress(t, kr, accounts[0]).String(), blob)
+	require.NoError(t, err)
+	tx := signTx(t, testApp, encCfg, kr, accounts[0], nil, pfb)
+	blobTx, err := coretypes.MarshalBlobTx(tx, blob)
+	require.NoError(t, err)
+
+	blockData, results, _ := deliverPreparedBlock(t, testApp, blobTx)
+	require.Equal(t, abci.CodeTypeOK, results[0].Code, results[0].Log)
+
+	resp, err := blobindex.NewQueryServer(indexer).BlobsByNamespace(context.Background(), &blobindexproto.QueryBlobsByNamespaceRequest{Namespace: ns.Bytes()})
+	require.NoError(t, err)
+	blobRange, err := square.BlobShareRange(blockData.Txs, 0, 0, appconsts.LatestVersion)
+	require.NoError(t, err)
+	expected := []blobindexproto.IndexedBlob{{
+		Height: testApp.LastBlockHeight(),
+		TxHash: tmhash.Sum(tx),
+		Start:  uint32(blobRange.Start),
+		End:    uint32(blobRange.End),
+	}}
+	assert.Equal(t, expected, resp.Blobs)
+}
+
+// deliverPreparedBlock prepares a proposal of the txs, then executes and
+// commits the block without processing the proposal, like a node that is
+// catching up with block sync.
+func deliverPreparedBlock(t *testing.T, testApp *app.App, txs ...[]byte) (*tmproto.Data, []abci.ResponseDeliverTx, abci.ResponseEndBlock) {
+	resp := testApp.PrepareProposal(abci.RequestPrepareProposal{BlockData: &tmproto.Data{Txs: txs}})
+	require.Len(t, resp.BlockData.Txs, len(txs))
+
+	height := testApp.LastBlockHeight() + 1
+	testApp.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: height, ChainID: testutil.ChainID, DataHash: resp.BlockData.Hash}})
+	results := make([]abci.ResponseDeliverTx, len(resp.BlockData.Txs))
+	for i, tx := range resp.BlockData.Txs {
+		if blobTx, isBlobTx := coretypes.UnmarshalBlobTx(tx); isBlobTx {
+			tx = blobTx.Tx
+		}
+		results[i] = testApp.DeliverTx(abci.RequestDeliverTx{Tx: tx})
+	}
+	endBlock := testApp.EndBlock(abci.RequestEndBlock{Height: height})
+	testApp.Commit()
+	return resp.BlockData, results, endBlock
+}
@@ -6,13 +6,19 @@ import (
 
 	"github.com/celestiaorg/celestia-app/app"
 	"github.com/celestiaorg/celestia-app/app/encoding"
+	"github.com/celestiaorg/celestia-app/pkg/appconsts"
+	v2 "github.com/celestiaorg/celestia-app/pkg/appconsts/v2"
 	appns "github.com/celestiaorg/celestia-app/pkg/namespace"
 	testutil "github.com/celestiaorg/celestia-app/test/util"
 	"github.com/celestiaorg/celestia-app/test/util/blobfactory"
 	blobtypes "github.com/celestiaorg/celestia-app/x/blob/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	abci "github.com/tendermint/tendermint/abci/types"
+	tmrand "github.com/tendermint/tendermint/libs/rand"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 	coretypes "github.com/tendermint/tendermint/types"
 )
 
@@ -114,3 +120,121 @@ func TestCheckTx(t *testing.T) {
 		})
 	}
 }
+
+// TestCheckTxPriority tests that the priority of a blob tx is based on the fee
+// it pays per share and that it is recalculated on recheck.
+func TestCheckTxPriority(t *testing.T) {
+	encCfg := encoding.MakeConfig(app.ModuleEncodingRegisters...)
+	accounts := []string{"alice", "bob"}
+	testApp, kr := testutil.SetupTestAppWithGenesisValSet(app.DefaultConsensusParams(), accounts...)
+	testApp.EndBlock(abci.RequestEndBlock{Height: testApp.LastBlockHeight() + 1})
+	testApp.Commit()
+	deliverBlock(testApp)
+
+	// both txs pay the same fee and have the same gas limit, so the SDK would
+	// give them the same priority
+	blobTxs := make([][]byte, len(accounts))
+	for i, size := range []int{1000, 10000} {
+		blob, err := blobtypes.NewBlob(appns.RandomBlobNamespace(), tmrand.Bytes(size), appconsts.ShareVersionZero)
+		require.NoError(t, err)
+		pfb, err := blobtypes.NewMsgPayForBlobs(accountAddress(t, kr, accounts[i]).String(), blob)
+		require.NoError(t, err)
+		blobTxs[i], err = coretypes.MarshalBlobTx(signTx(t, testApp, encCfg, kr, accounts[i], nil, pfb), blob)
+		require.NoError(t, err)
+	}
+	// expectedPriority returns the fee of 100_000 signTx sets divided by the
+	// gas that the PFB consumes for the shares of its blob.
+	expectedPriority := func(blobTx []byte, gasPerBlobByte uint32) int64 {
+		btx, isBlobTx := coretypes.UnmarshalBlobTx(blobTx)
+		require.True(t, isBlobTx)
+		sharesGas := blobtypes.BlobTxSharesUsed(btx) * appconsts.ShareSize * int(gasPerBlobByte)
+		return 100_000 / int64(sharesGas)
+	}
+
+	priorities := make([]int64, len(blobTxs))
+	for i, blobTx := range blobTxs {
+		resp := testApp.CheckTx(abci.RequestCheckTx{Type: abci.CheckTxType_New, Tx: blobTx})
+		require.Equal(t, abci.CodeTypeOK, resp.Code, resp.Log)
+		assert.Equal(t, expectedPriority(blobTx, blobtypes.DefaultGasPerBlobByte), resp.Priority)
+		priorities[i] = resp.Priority
+	}
+	// the tx with the smaller blob pays more per share
+	assert.Greater(t, priorities[0], priorities[1])
+
+	// doubling the gas per blob byte halves the priority on recheck. The
+	// txs are rechecked after a block was committed, which resets the check
+	// state and thus the sequence of the signers.
+	deliverBlock(testApp)
+	ctx := testApp.NewContext(true, tmproto.Header{})
+	params := testApp.BlobKeeper.GetParams(ctx)
+	params.GasPerBlobByte *= 2
+	testApp.BlobKeeper.SetParams(ctx, params)
+	resp := testApp.CheckTx(abci.RequestCheckTx{Type: abci.CheckTxType_Recheck, Tx: blobTxs[0]})
+	require.Equal(t, abci.CodeTypeOK, resp.Code, resp.Log)
+	assert.Equal(t, expectedPriority(blobTxs[0], params.GasPerBlobByte), resp.Priority)
+}
+
+// TestCheckTxPriorityOfBlobAndNormalTxs tests that a blob tx that pays the
+// same price for the gas of its blob shares as a normal tx pays for its gas
+// gets the same priority.
+func TestCheckTxPriorityOfBlobAndNormalTxs(t *testing.T) {
+	encCfg := encoding.MakeConfig(app.ModuleEncodingRegisters...)
+	accounts := []string{"alice", "bob"}
+	testApp, kr := testutil.SetupTestAppWithGenesisValSet(app.DefaultConsensusParams(), accounts...)
+	testApp.EndBlock(abci.RequestEndBlock{Height: testApp.LastBlockHeight() + 1})
+	testApp.Commit()
+	deliverBlock(testApp)
+
+	blob, err := blobtypes.NewBlob(appns.RandomBlobNamespace(), tmrand.Bytes(2000), appconsts.ShareVersionZero)
+	require.NoError(t, err)
+	pfb, err := blobtypes.NewMsgPayForBlobs(accountAddress(t, kr, accounts[0]).String(), blob)
+	require.NoError(t, err)
+	// both txs pay 10utia per unit of gas
+	const gasPrice = 10
+	gasLimit := blobtypes.DefaultEstimateGas(pfb.BlobSizes, pfb.ShareVersions)
+	sharesGas := int64(pfb.SharesUsed() * appconsts.ShareSize * int(blobtypes.DefaultGasPerBlobByte))
+	blobTx, err := coretypes.MarshalBlobTx(signTxWithFee(t, testApp, encCfg, kr, accounts[0], gasLimit, gasPrice*sharesGas, pfb), blob)
+	require.NoError(t, err)
+
+	send := banktypes.NewMsgSend(accountAddress(t, kr, accounts[1]), accountAddress(t, kr, accounts[0]), sdk.NewCoins(sdk.NewInt64Coin(app.BondDenom, 1)))
+	normalTx := signTxWithFee(t, testApp, encCfg, kr, accounts[1], gasLimit, gasPrice*int64(gasLimit), send)
+
+	blobResp := testApp.CheckTx(abci.RequestCheckTx{Type: abci.CheckTxType_New, Tx: blobTx})
+	require.Equal(t, abci.CodeTypeOK, blobResp.Code, blobResp.Log)
+	normalResp := testApp.CheckTx(abci.RequestCheckTx{Type: abci.CheckTxType_New, Tx: normalTx})
+	require.Equal(t, abci.CodeTypeOK, normalResp.Code, normalResp.Log)
+
+	assert.Equal(t, int64(gasPrice), normalResp.Priority)
+	assert.Equal(t, normalResp.Priority, blobResp.Priority)
+}
+
+// TestCheckTxPriorityExcludesBlobBaseFee tests that the part of the fee of a
+// blob tx that is burned as the blob base fee doesn't count towards its
+// priority.
+func TestCheckTxPriorityExcludesBlobBaseFee(t *testing.T) {
+	encCfg := encoding.MakeConfig(app.ModuleEncodingRegisters...)
+	accounts := []string{"alice"}
+	// the chain runs with v2, which charges the blob base fee
+	testApp, kr := testutil.SetupTestAppWithAppVersion(app.DefaultConsensusParams(), v2.Version, accounts...)
+	testApp.EndBlock(abci.RequestEndBlock{Height: testApp.LastBlockHeight() + 1})
+	testApp.Commit()
+	deliverBlock(testApp)
+
+	ctx := testApp.NewContext(true, tmproto.Header{})
+	baseFee := sdk.NewDec(1000)
+	testApp.BlobKeeper.SetBlobBaseFee(ctx, baseFee)
+
+	blob, err := blobtypes.NewBlob(appns.RandomBlobNamespace(), tmrand.Bytes(1000), appconsts.ShareVersionZero)
+	require.NoError(t, err)
+	pfb, err := blobtypes.NewMsgPayForBlobs(accountAddress(t, kr, accounts[0]).String(), blob)
+	require.NoError(t, err)
+	blobTx, err := coretypes.MarshalBlobTx(signTx(t, testApp, encCfg, kr, accounts[0], nil, pfb), blob)
+	require.NoError(t, err)
+
+	resp := testApp.CheckTx(abci.RequestCheckTx{Type: abci.CheckTxType_New, Tx: blobTx})
+	require.Equal(t, abci.CodeTypeOK, resp.Code, resp.Log)
+	// signTx sets a fee of 100_000 of which the blob base fee is burned
+	burn := blobtypes.BlobBaseFeeAmount(baseFee, uint64(pfb.SharesUsed())).Int64()
+	sharesGas := int64(pfb.SharesUsed() * appconsts.ShareSize * int(blobtypes.DefaultGasPerBlobByte))
+	assert.Equal(t, (100_000-burn)/sharesGas, resp.Priority)
+}
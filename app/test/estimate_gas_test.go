@@ -0,0 +1,147 @@
+package app_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/celestiaorg/celestia-app/app"
+	"github.com/celestiaorg/celestia-app/app/encoding"
+	"github.com/celestiaorg/celestia-app/pkg/appconsts"
+	v2 "github.com/celestiaorg/celestia-app/pkg/appconsts/v2"
+	appns "github.com/celestiaorg/celestia-app/pkg/namespace"
+	"github.com/celestiaorg/celestia-app/pkg/square"
+	testutil "github.com/celestiaorg/celestia-app/test/util"
+	blobtypes "github.com/celestiaorg/celestia-app/x/blob/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmrand "github.com/tendermint/tendermint/libs/rand"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	coretypes "github.com/tendermint/tendermint/types"
+)
+
+// TestDefaultEstimateGas tests that PFBs whose gas limit is the default gas
+// estimate of their blobs can be delivered, for several blob counts, sizes
+// and share versions.
+func TestDefaultEstimateGas(t *testing.T) {
+	encCfg := encoding.MakeConfig(app.ModuleEncodingRegisters...)
+	accounts := []string{"signer"}
+	testApp, kr := testutil.SetupTestAppWithAppVersion(app.DefaultConsensusParams(), v2.Version, accounts...)
+	testApp.EndBlock(abci.RequestEndBlock{Height: testApp.LastBlockHeight() + 1})
+	testApp.Commit()
+	deliverBlock(testApp)
+	signer := accountAddress(t, kr, accounts[0])
+
+	for _, shareVersion := range []uint8{appconsts.ShareVersionZero, appconsts.ShareVersionOne} {
+		for _, blobCount := range []int{1, 4, 8, 16, 32} {
+			for _, blobSize := range []int{1, 1000, 50_000} {
+				name := fmt.Sprintf("share version %d, %d blobs of %d bytes", shareVersion, blobCount, blobSize)
+				t.Run(name, func(t *testing.T) {
+					blobs := make([]*blobtypes.Blob, blobCount)
+					for i := range blobs {
+						var err error
+						blobs[i], err = blobtypes.NewBlob(appns.RandomBlobNamespace(), tmrand.Bytes(blobSize), shareVersion)
+						require.NoError(t, err)
+					}
+					pfb, err := blobtypes.NewMsgPayForBlobs(signer.String(), blobs...)
+					require.NoError(t, err)
+					gasLimit := blobtypes.DefaultEstimateGas(pfb.BlobSizes, pfb.ShareVersions)
+					opts := []blobtypes.TxBuilderOption{
+						blobtypes.SetGasLimit(gasLimit),
+						blobtypes.SetFeeAmount(sdk.NewCoins(sdk.NewInt64Coin(app.BondDenom, int64(gasLimit)))),
+					}
+					sequence := testutil.DirectQueryAccount(testApp, signer).GetSequence()
+					blobTx, err := coretypes.MarshalBlobTx(signTxWithOptions(t, testApp, encCfg, kr, accounts[0], sequence, opts, pfb), blobs...)
+					require.NoError(t, err)
+
+					resp := testApp.PrepareProposal(abci.RequestPrepareProposal{BlockData: &tmproto.Data{Txs: [][]byte{blobTx}}})
+					require.Equal(t, [][]byte{blobTx}, resp.BlockData.Txs)
+					dataSquare, err := square.Construct(resp.BlockData.Txs, v2.Version, appconsts.DefaultSquareSizeUpperBound)
+					require.NoError(t, err)
+					// the PFB is delivered with the share indexes of its
+					// blobs, like in a block
+					wrappedPFBs, err := dataSquare.WrappedPFBs()
+					require.NoError(t, err)
+					require.Len(t, wrappedPFBs, 1)
+
+					results := deliverBlock(testApp, wrappedPFBs[0])
+					require.Equal(t, abci.CodeTypeOK, results[0].Code, results[0].Log)
+					assert.LessOrEqual(t, results[0].GasUsed, int64(gasLimit))
+				})
+			}
+		}
+	}
+}
+
+// TestEstimateGasConstants pins the constants of the PFB gas estimate to the
+// gas consumed and the bytes taken by delivered PFBs. The estimate must cover
+// them, without exceeding the gas consumed by a wide margin.
+func TestEstimateGasConstants(t *testing.T) {
+	encCfg := encoding.MakeConfig(app.ModuleEncodingRegisters...)
+	accounts := []string{"signer"}
+	testApp, kr := testutil.SetupTestAppWithAppVersion(app.DefaultConsensusParams(), v2.Version, accounts...)
+	testApp.EndBlock(abci.RequestEndBlock{Height: testApp.LastBlockHeight() + 1})
+	testApp.Commit()
+	deliverBlock(testApp)
+	signer := accountAddress(t, kr, accounts[0])
+
+	ctx := testApp.NewContext(true, tmproto.Header{})
+	gasPerBlobByte := testApp.BlobKeeper.GasPerBlobByte(ctx)
+	authParams := testApp.AccountKeeper.GetParams(ctx)
+
+	// deliverPFB delivers a PFB paying for blobCount single share blobs and
+	// returns the size of the delivered tx and the gas that it consumed
+	// besides the blob gas, the tx size gas and the signature verification
+	deliverPFB := func(blobCount int) (txSize, overhead int) {
+		blobs := make([]*blobtypes.Blob, blobCount)
+		for i := range blobs {
+			var err error
+			blobs[i], err = blobtypes.NewBlob(appns.RandomBlobNamespace(), tmrand.Bytes(100), appconsts.ShareVersionZero)
+			require.NoError(t, err)
+		}
+		pfb, err := blobtypes.NewMsgPayForBlobs(signer.String(), blobs...)
+		require.NoError(t, err)
+		gasLimit := blobtypes.DefaultEstimateGas(pfb.BlobSizes, pfb.ShareVersions)
+		opts := []blobtypes.TxBuilderOption{
+			blobtypes.SetGasLimit(gasLimit),
+			blobtypes.SetFeeAmount(sdk.NewCoins(sdk.NewInt64Coin(app.BondDenom, int64(gasLimit)))),
+		}
+		sequence := testutil.DirectQueryAccount(testApp, signer).GetSequence()
+		blobTx, err := coretypes.MarshalBlobTx(signTxWithOptions(t, testApp, encCfg, kr, accounts[0], sequence, opts, pfb), blobs...)
+		require.NoError(t, err)
+
+		resp := testApp.PrepareProposal(abci.RequestPrepareProposal{BlockData: &tmproto.Data{Txs: [][]byte{blobTx}}})
+		require.Equal(t, [][]byte{blobTx}, resp.BlockData.Txs)
+		dataSquare, err := square.Construct(resp.BlockData.Txs, v2.Version, appconsts.DefaultSquareSizeUpperBound)
+		require.NoError(t, err)
+		wrappedPFBs, err := dataSquare.WrappedPFBs()
+		require.NoError(t, err)
+		require.Len(t, wrappedPFBs, 1)
+
+		results := deliverBlock(testApp, wrappedPFBs[0])
+		require.Equal(t, abci.CodeTypeOK, results[0].Code, results[0].Log)
+		blobGas := blobCount * appconsts.ShareSize * int(gasPerBlobByte)
+		txSize = len(wrappedPFBs[0])
+		overhead = int(results[0].GasUsed) - blobGas - txSize*int(authParams.TxSizeCostPerByte) - int(authParams.SigVerifyCostSecp256k1)
+		return txSize, overhead
+	}
+
+	// the first tx of an account also stores its public key
+	firstSize, firstOverhead := deliverPFB(1)
+	oneBlobSize, oneBlobOverhead := deliverPFB(1)
+	manyBlobsSize, manyBlobsOverhead := deliverPFB(64)
+
+	gasPerBlob := (manyBlobsOverhead - oneBlobOverhead) / 63
+	assert.LessOrEqual(t, gasPerBlob, blobtypes.PFBGasPerBlob)
+	assert.LessOrEqual(t, blobtypes.PFBGasPerBlob, gasPerBlob*3/2)
+
+	fixedGas := firstOverhead - gasPerBlob
+	assert.LessOrEqual(t, fixedGas, blobtypes.PFBGasFixedCost)
+	assert.LessOrEqual(t, blobtypes.PFBGasFixedCost, fixedGas*5/4)
+
+	assert.LessOrEqual(t, manyBlobsSize-oneBlobSize, 63*blobtypes.BytesPerBlobInfo)
+	for _, size := range []int{firstSize, oneBlobSize} {
+		assert.LessOrEqual(t, size, blobtypes.PFBTxFixedSize+blobtypes.BytesPerBlobInfo+blobtypes.BytesPerSignature)
+	}
+}
@@ -8,16 +8,21 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	abci "github.com/tendermint/tendermint/abci/types"
+	tmrand "github.com/tendermint/tendermint/libs/rand"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 	coretypes "github.com/tendermint/tendermint/types"
 
 	"github.com/celestiaorg/celestia-app/app"
 	"github.com/celestiaorg/celestia-app/app/encoding"
 	"github.com/celestiaorg/celestia-app/pkg/appconsts"
+	v2 "github.com/celestiaorg/celestia-app/pkg/appconsts/v2"
 	appns "github.com/celestiaorg/celestia-app/pkg/namespace"
+	"github.com/celestiaorg/celestia-app/pkg/shares"
+	"github.com/celestiaorg/celestia-app/pkg/square"
 	testutil "github.com/celestiaorg/celestia-app/test/util"
 	"github.com/celestiaorg/celestia-app/test/util/blobfactory"
 	"github.com/celestiaorg/celestia-app/test/util/testfactory"
+	blobtypes "github.com/celestiaorg/celestia-app/x/blob/types"
 )
 
 func TestPrepareProposalPutsPFBsAtEnd(t *testing.T) {
@@ -193,6 +198,53 @@ func TestPrepareProposalFiltering(t *testing.T) {
 	}
 }
 
+// TestPrepareProposalShareVersionOne tests that a v2 proposer includes the
+// blobs of share version one with the signer of their PFB embedded in their
+// first share and that the proposal is accepted by ProcessProposal.
+func TestPrepareProposalShareVersionOne(t *testing.T) {
+	encCfg := encoding.MakeConfig(app.ModuleEncodingRegisters...)
+	accounts := []string{"signer"}
+	testApp, kr := testutil.SetupTestAppWithAppVersion(app.DefaultConsensusParams(), v2.Version, accounts...)
+	testApp.EndBlock(abci.RequestEndBlock{Height: testApp.LastBlockHeight() + 1})
+	testApp.Commit()
+	deliverBlock(testApp)
+
+	signer := accountAddress(t, kr, accounts[0])
+	ns := appns.RandomBlobNamespace()
+	blob, err := blobtypes.NewBlob(ns, tmrand.Bytes(1000), appconsts.ShareVersionOne)
+	require.NoError(t, err)
+	pfb, err := blobtypes.NewMsgPayForBlobs(signer.String(), blob)
+	require.NoError(t, err)
+	blobTx, err := coretypes.MarshalBlobTx(signTx(t, testApp, encCfg, kr, accounts[0], nil, pfb), blob)
+	require.NoError(t, err)
+
+	resp := testApp.PrepareProposal(abci.RequestPrepareProposal{BlockData: &tmproto.Data{Txs: [][]byte{blobTx}}})
+	require.Equal(t, [][]byte{blobTx}, resp.BlockData.Txs)
+	processRes := testApp.ProcessProposal(abci.RequestProcessProposal{
+		BlockData: resp.BlockData,
+		Header:    tmproto.Header{DataHash: resp.BlockData.Hash},
+	})
+	assert.Equal(t, abci.ResponseProcessProposal_ACCEPT, processRes.Result)
+
+	dataSquare, err := square.Construct(resp.BlockData.Txs, v2.Version, appconsts.DefaultSquareSizeUpperBound)
+	require.NoError(t, err)
+	sequences, err := shares.ParseShares(dataSquare, true)
+	require.NoError(t, err)
+	var blobSequences []shares.ShareSequence
+	for _, sequence := range sequences {
+		if sequence.Namespace.Equals(ns) {
+			blobSequences = append(blobSequences, sequence)
+		}
+	}
+	require.Len(t, blobSequences, 1)
+	embeddedSigner, err := blobSequences[0].Signer()
+	require.NoError(t, err)
+	assert.Equal(t, signer.Bytes(), embeddedSigner)
+	data, err := blobSequences[0].RawData()
+	require.NoError(t, err)
+	assert.Equal(t, blob.Data, data)
+}
+
 func queryAccountInfo(capp *app.App, accs []string, kr keyring.Keyring) []blobfactory.AccountInfo {
 	infos := make([]blobfactory.AccountInfo, len(accs))
 	for i, acc := range accs {
@@ -5,6 +5,10 @@ import (
 	"runtime/debug"
 
 	"cosmossdk.io/errors"
+	"github.com/celestiaorg/celestia-app/pkg/appconsts"
+	blobante "github.com/celestiaorg/celestia-app/x/blob/ante"
+	blobtypes "github.com/celestiaorg/celestia-app/x/blob/types"
+	namespaceante "github.com/celestiaorg/celestia-app/x/namespace/ante"
 	"github.com/cosmos/cosmos-sdk/client"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -32,21 +36,24 @@ func separateTxs(_ client.TxConfig, rawTxs [][]byte) ([][]byte, []core.BlobTx) {
 	return normalTxs, blobTxs
 }
 
-// filterForValidPFBSignature verifies the signatures of the provided PFB transactions. If it is invalid, it
-// drops the transaction.
-func filterForValidPFBSignature(ctx sdk.Context, accountKeeper *keeper.AccountKeeper, txConfig client.TxConfig, txs [][]byte) [][]byte {
+// filterForValidPFBSignature verifies the signatures of the provided PFB transactions. If it is invalid,
+// if the signer isn't allowed to pay for blobs in one of the namespaces, if the PFB is executed through
+// an authz MsgExec without a grant from its signer, if its fee doesn't cover the blob base fee, or if the
+// fee allowance of its fee granter doesn't accept it, it drops the transaction. The checks that depend on
+// the app version are those of appVersion.
+func filterForValidPFBSignature(ctx sdk.Context, appVersion uint64, accountKeeper *keeper.AccountKeeper, blobKeeper blobante.BlobKeeper, namespaceKeeper namespaceante.NamespaceKeeper, authzKeeper blobante.AuthzKeeper, feegrantKeeper ante.FeegrantKeeper, txConfig client.TxConfig, txs [][]byte) [][]byte {
 	normalTxs, blobTxs := separateTxs(txConfig, txs)
 
-	// increment the sequences of the standard cosmos-sdk transactions. Panics
-	// from the anteHandler are caught and logged.
-	seqHandler := incrementSequenceAnteHandler(accountKeeper)
+	// increment the sequences of the standard cosmos-sdk transactions and use
+	// their fee allowances. Panics from the anteHandler are caught and logged.
+	seqHandler := incrementSequenceAnteHandler(appVersion, accountKeeper, feegrantKeeper)
 
 	normalTxs, ctx = filterStdTxs(ctx.Logger(), txConfig.TxDecoder(), ctx, seqHandler, normalTxs)
 
 	// check the signatures and increment the sequences of the blob txs,
 	// and filter out any that fail. Panics from the anteHandler are caught and
 	// logged.
-	svHandler := sigVerifyAnteHandler(accountKeeper, txConfig)
+	svHandler := sigVerifyAnteHandler(appVersion, accountKeeper, blobKeeper, namespaceKeeper, authzKeeper, feegrantKeeper, txConfig)
 	blobTxs, _ = filterBlobTxs(ctx.Logger(), txConfig.TxDecoder(), ctx, svHandler, blobTxs)
 
 	return append(normalTxs, encodeBlobTxs(blobTxs)...)
@@ -129,21 +136,70 @@ func encodeBlobTxs(blobTxs []tmproto.BlobTx) [][]byte {
 
 // sigVerifyAnteHandler creates an AnteHandler with the SetupContext, SetPubKey,
 // SigVerification, and IncremementSequence ante decorators to check that
-// sequences have be incremented.
-func sigVerifyAnteHandler(accKeeper *authkeeper.AccountKeeper, txConfig client.TxConfig) sdk.AnteHandler {
+// sequences have be incremented. It also checks that the signer of each PFB is
+// allowed to pay for blobs in its namespaces, for PFBs executed through an
+// authz MsgExec, that the grantee is authorized by the signer, that the fee
+// covers the blob base fee and, for transactions with a fee granter, that its
+// fee allowance accepts them. The blob base fee, namespace, authz and fee
+// allowance checks only run if appVersion enables them, and blob txs whose PFB
+// is executed through an authz MsgExec are dropped if it doesn't.
+func sigVerifyAnteHandler(appVersion uint64, accKeeper *authkeeper.AccountKeeper, blobKeeper blobante.BlobKeeper, namespaceKeeper namespaceante.NamespaceKeeper, authzKeeper blobante.AuthzKeeper, feegrantKeeper ante.FeegrantKeeper, txConfig client.TxConfig) sdk.AnteHandler {
 	setupd := ante.NewSetUpContextDecorator()
 	setPubKd := ante.NewSetPubKeyDecorator(accKeeper)
 	svd := ante.NewSigVerificationDecorator(accKeeper, txConfig.SignModeHandler())
 	isd := ante.NewIncrementSequenceDecorator(accKeeper)
-	return sdk.ChainAnteDecorators(setupd, setPubKd, svd, isd)
+	decorators := []sdk.AnteDecorator{setupd, newBlobTxPFBDecorator(appVersion)}
+	if appconsts.BlobBaseFeeEnabled(appVersion) {
+		decorators = append(decorators, blobante.NewProposalBlobBaseFeeDecorator(blobKeeper, BondDenom))
+	}
+	if appconsts.NamespaceRegistryEnabled(appVersion) {
+		decorators = append(decorators, namespaceante.NewNamespaceOwnershipDecorator(namespaceKeeper))
+	}
+	decorators = append(decorators, setPubKd, svd)
+	if appconsts.AuthzPayForBlobsEnabled(appVersion) {
+		// the grants are only updated after the signature is verified so
+		// that invalid transactions can't spend them
+		decorators = append(decorators, blobante.NewProposalBlobAuthorizationDecorator(authzKeeper))
+	}
+	if appconsts.ProposalFeeAllowancesEnabled(appVersion) {
+		decorators = append(decorators, blobante.NewFeeGrantDecorator(feegrantKeeper))
+	}
+	decorators = append(decorators, isd)
+	return sdk.ChainAnteDecorators(decorators...)
+}
+
+// blobTxPFBDecorator rejects blob txs that don't contain a MsgPayForBlobs at
+// appVersion, i.e. those whose PFB is executed through an authz MsgExec before
+// the app version that allows it, as ProcessProposal would reject a block
+// that includes them.
+type blobTxPFBDecorator struct {
+	appVersion uint64
+}
+
+func newBlobTxPFBDecorator(appVersion uint64) blobTxPFBDecorator {
+	return blobTxPFBDecorator{appVersion: appVersion}
+}
+
+func (d blobTxPFBDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	if !blobtypes.ContainsPFB(tx.GetMsgs(), d.appVersion) {
+		return ctx, blobtypes.ErrNoPFB
+	}
+	return next(ctx, tx, simulate)
 }
 
 // incrementSequenceAnteHandler creates an AnteHandler that only incrememts the
-// sequence.
-func incrementSequenceAnteHandler(accKeeper *authkeeper.AccountKeeper) sdk.AnteHandler {
+// sequence and, for transactions with a fee granter, uses the fee allowance
+// if it accepts them so that the blob transactions that follow are checked
+// against what is left of it. The fee allowances are only used if appVersion
+// checks them.
+func incrementSequenceAnteHandler(appVersion uint64, accKeeper *authkeeper.AccountKeeper, feegrantKeeper ante.FeegrantKeeper) sdk.AnteHandler {
 	setupd := ante.NewSetUpContextDecorator()
 	isd := ante.NewIncrementSequenceDecorator(accKeeper)
-	return sdk.ChainAnteDecorators(setupd, isd)
+	if !appconsts.ProposalFeeAllowancesEnabled(appVersion) {
+		return sdk.ChainAnteDecorators(setupd, isd)
+	}
+	fgd := blobante.NewNormalTxFeeGrantDecorator(feegrantKeeper)
+	return sdk.ChainAnteDecorators(setupd, fgd, isd)
 }
 
 // recoverHandler will simply wrap the caught panic in an error containing the
@@ -0,0 +1,52 @@
+package cmd
+
+import (
+	"io"
+	"sync"
+
+	"github.com/cosmos/cosmos-sdk/server"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/spf13/cobra"
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+)
+
+// appCloser closes the apps created by an app creator once the command that
+// runs them returns, as the server of the SDK doesn't close the apps it
+// creates.
+type appCloser struct {
+	mtx  sync.Mutex
+	apps []io.Closer
+}
+
+// wrap returns an app creator that keeps track of the apps created by
+// appCreator that can be closed.
+func (c *appCloser) wrap(appCreator servertypes.AppCreator) servertypes.AppCreator {
+	return func(logger log.Logger, db dbm.DB, traceStore io.Writer, appOpts servertypes.AppOptions) servertypes.Application {
+		app := appCreator(logger, db, traceStore, appOpts)
+		if closer, ok := app.(io.Closer); ok {
+			c.mtx.Lock()
+			c.apps = append(c.apps, closer)
+			c.mtx.Unlock()
+		}
+		return app
+	}
+}
+
+// closeAfter closes the tracked apps once cmd returns. The node is stopped by
+// then, so the apps are no longer used.
+func (c *appCloser) closeAfter(cmd *cobra.Command) {
+	runE := cmd.RunE
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		err := runE(cmd, args)
+		c.mtx.Lock()
+		defer c.mtx.Unlock()
+		for _, app := range c.apps {
+			if closeErr := app.Close(); closeErr != nil {
+				server.GetServerContextFromCmd(cmd).Logger.Error("failed to close the app", "err", closeErr)
+			}
+		}
+		c.apps = nil
+		return err
+	}
+}
@@ -0,0 +1,94 @@
+package cmd
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/celestiaorg/celestia-app/pkg/blobindex"
+	cmtdb "github.com/cometbft/cometbft-db"
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/spf13/cobra"
+	"github.com/tendermint/tendermint/store"
+)
+
+const (
+	// FlagFromHeight is the first height that is indexed by the backfill.
+	FlagFromHeight = "from-height"
+	// FlagToHeight is the last height that is indexed by the backfill.
+	FlagToHeight = "to-height"
+)
+
+// blobIndexCmd returns the command of the blob index.
+func blobIndexCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "blob-index",
+		Short: "Manage the blob index of the node",
+	}
+	cmd.AddCommand(blobIndexBackfillCmd())
+	return cmd
+}
+
+// blobIndexBackfillCmd returns a command that indexes the blobs of the blocks
+// in the block store of the node.
+func blobIndexBackfillCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "backfill",
+		Short: "Index the blobs of the blocks in the block store of the node",
+		Long: `Index the blobs of the blocks in the block store of the node, e.g. of the blocks that were committed
+before the blob index was enabled, as returned in the unindexed heights of Query/BlobsByNamespace. The data square of each block is constructed from
+its transactions and its data root is checked against the one in the block header. The node must be
+stopped as its block store and blob index are opened by this command.`,
+		Example: fmt.Sprintf("celestia-appd blob-index backfill --%s 1 --%s 1000", FlagFromHeight, FlagToHeight),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			serverCtx := server.GetServerContextFromCmd(cmd)
+			cfg := serverCtx.Config
+
+			// the block store of tendermint uses a fork of tm-db
+			blockStoreDB, err := cmtdb.NewDB("blockstore", cmtdb.BackendType(cfg.DBBackend), cfg.DBDir())
+			if err != nil {
+				return err
+			}
+			defer blockStoreDB.Close()
+			blockStore := store.NewBlockStore(blockStoreDB)
+
+			indexDB, err := blobindex.OpenDB(filepath.Join(cfg.RootDir, "data"), server.GetAppDBBackend(serverCtx.Viper))
+			if err != nil {
+				return err
+			}
+			defer indexDB.Close()
+			indexer := blobindex.NewIndexer(indexDB)
+
+			fromHeight, err := cmd.Flags().GetInt64(FlagFromHeight)
+			if err != nil {
+				return err
+			}
+			toHeight, err := cmd.Flags().GetInt64(FlagToHeight)
+			if err != nil {
+				return err
+			}
+			if fromHeight < blockStore.Base() {
+				fromHeight = blockStore.Base()
+			}
+			if toHeight == 0 || toHeight > blockStore.Height() {
+				toHeight = blockStore.Height()
+			}
+
+			for height := fromHeight; height <= toHeight; height++ {
+				block := blockStore.LoadBlock(height)
+				if block == nil {
+					return fmt.Errorf("block %d not found in the block store", height)
+				}
+				err := indexer.IndexBlockData(height, block.Data.Txs.ToSliceOfBytes(), block.Header.Version.App, block.Header.DataHash)
+				if err != nil {
+					return err
+				}
+			}
+			cmd.Printf("indexed the blobs of heights %d to %d\n", fromHeight, toHeight)
+			return nil
+		},
+	}
+	cmd.Flags().Int64(FlagFromHeight, 0, "The first height to index, defaults to the lowest height in the block store")
+	cmd.Flags().Int64(FlagToHeight, 0, "The last height to index, defaults to the highest height in the block store")
+	return cmd
+}
@@ -0,0 +1,406 @@
+package cmd
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"html"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/celestiaorg/celestia-app/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/pkg/square"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+	tmbytes "github.com/tendermint/tendermint/libs/bytes"
+	tmjson "github.com/tendermint/tendermint/libs/json"
+	coretypes "github.com/tendermint/tendermint/rpc/core/types"
+	"github.com/tendermint/tendermint/types"
+)
+
+const (
+	// FlagFormat is the format that the layout of a square is printed in.
+	FlagFormat = "format"
+
+	formatTable = "table"
+	formatGrid  = "grid"
+	formatJSON  = "json"
+	formatSVG   = "svg"
+)
+
+// The kinds of shares that are distinguished when printing the layout of a
+// square.
+const (
+	kindTx               = "tx"
+	kindPFB              = "pfb"
+	kindBlob             = "blob"
+	kindNamespacePadding = "namespace padding"
+	kindReservedPadding  = "reserved padding"
+	kindTailPadding      = "tail padding"
+)
+
+// shareKinds lists the kinds of shares in the order that they appear in a
+// square, along with the symbol used in the grid and the color used in the
+// SVG. Sequence starts are printed with an upper case symbol.
+var shareKinds = []struct {
+	kind   string
+	symbol byte
+	color  string
+}{
+	{kindTx, 't', "#4e79a7"},
+	{kindPFB, 'p', "#f28e2b"},
+	{kindReservedPadding, '#', "#bab0ac"},
+	{kindBlob, 'b', "#59a14f"},
+	{kindNamespacePadding, '~', "#edc948"},
+	{kindTailPadding, '.', "#ffffff"},
+}
+
+// SquareLayout is the layout of a data square as printed by the debug square
+// command.
+type SquareLayout struct {
+	Height     int64       `json:"height,omitempty"`
+	AppVersion uint64      `json:"app_version"`
+	SquareSize int         `json:"square_size"`
+	Shares     []ShareInfo `json:"shares"`
+	Stats      SquareStats `json:"stats"`
+}
+
+// ShareInfo describes a single share of a data square.
+type ShareInfo struct {
+	Index         int              `json:"index"`
+	Row           int              `json:"row"`
+	Col           int              `json:"col"`
+	Kind          string           `json:"kind"`
+	Namespace     tmbytes.HexBytes `json:"namespace"`
+	SequenceStart bool             `json:"sequence_start"`
+	Compact       bool             `json:"compact"`
+	Padding       string           `json:"padding,omitempty"`
+	TxIndexes     []int            `json:"tx_indexes,omitempty"`
+	// BlobIndex is only set for blob shares.
+	BlobIndex *int `json:"blob_index,omitempty"`
+}
+
+// SquareStats summarizes how the shares of a data square are used.
+type SquareStats struct {
+	TotalShares   int            `json:"total_shares"`
+	DataShares    int            `json:"data_shares"`
+	PaddingShares int            `json:"padding_shares"`
+	SharesByKind  map[string]int `json:"shares_by_kind"`
+	// Utilisation is the fraction of the shares of the square that contain
+	// data.
+	Utilisation float64 `json:"utilisation"`
+}
+
+// debugSquareCmd returns a command that prints the layout of the data square
+// of a block.
+func debugSquareCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "square [height|block-file]",
+		Short: "Print the layout of the data square of a block",
+		Long: `Construct the data square of a block and print what each share contains: its namespace,
+whether it starts a sequence, whether it is a compact or a sparse share, the kind of padding and the
+index of the transaction or blob that it belongs to, along with padding and utilisation stats.
+The block is either fetched from the node at the given height or read from a file containing a JSON
+encoded block, as returned by the Tendermint RPC. If the file is "-", the block is read from stdin.
+The layout is printed as a table, an ASCII grid, JSON or SVG, depending on --format.`,
+		Example: fmt.Sprintf(`celestia-appd debug square 1234 --%s tcp://localhost:26657
+celestia-appd debug square block.json --%s %s > square.svg`, flags.FlagNode, FlagFormat, formatSVG),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			format, err := cmd.Flags().GetString(FlagFormat)
+			if err != nil {
+				return err
+			}
+			switch format {
+			case formatTable, formatGrid, formatJSON, formatSVG:
+			default:
+				return fmt.Errorf("unsupported format %q, expected one of %s, %s, %s or %s", format, formatTable, formatGrid, formatJSON, formatSVG)
+			}
+
+			block, err := getBlock(cmd, args[0])
+			if err != nil {
+				return err
+			}
+			layout, err := NewSquareLayout(block)
+			if err != nil {
+				return err
+			}
+			if block.Data.SquareSize != 0 && block.Data.SquareSize != uint64(layout.SquareSize) {
+				cmd.PrintErrf("warning: the block has a square size of %d but the constructed square has a size of %d\n",
+					block.Data.SquareSize, layout.SquareSize)
+			}
+
+			out := cmd.OutOrStdout()
+			switch format {
+			case formatGrid:
+				return printGrid(out, layout)
+			case formatJSON:
+				bz, err := json.MarshalIndent(layout, "", "  ")
+				if err != nil {
+					return err
+				}
+				_, err = fmt.Fprintln(out, string(bz))
+				return err
+			case formatSVG:
+				return printSVG(out, layout)
+			default:
+				return printTable(out, layout)
+			}
+		},
+	}
+
+	cmd.Flags().String(flags.FlagNode, "tcp://localhost:26657", "<host>:<port> to Tendermint RPC interface for this chain")
+	cmd.Flags().String(FlagFormat, formatTable, fmt.Sprintf("Output format (%s|%s|%s|%s)", formatTable, formatGrid, formatJSON, formatSVG))
+
+	return cmd
+}
+
+// getBlock fetches the block at the height in arg from the node or, if arg
+// isn't a height, reads it from the file at arg.
+func getBlock(cmd *cobra.Command, arg string) (*types.Block, error) {
+	height, err := strconv.ParseInt(arg, 10, 64)
+	if err != nil || fileExists(arg) {
+		return readBlock(cmd, arg)
+	}
+	if height <= 0 {
+		return nil, fmt.Errorf("height %d must be strictly positive", height)
+	}
+
+	clientCtx, err := client.GetClientQueryContext(cmd)
+	if err != nil {
+		return nil, err
+	}
+	node, err := clientCtx.GetNode()
+	if err != nil {
+		return nil, err
+	}
+	res, err := node.Block(cmd.Context(), &height)
+	if err != nil {
+		return nil, err
+	}
+	return res.Block, nil
+}
+
+// readBlock reads a JSON encoded block from the file at path. Both the block
+// itself and the result of the block RPC endpoint are supported.
+func readBlock(cmd *cobra.Command, path string) (*types.Block, error) {
+	bz, err := readInput(cmd, path)
+	if err != nil {
+		return nil, err
+	}
+	var res coretypes.ResultBlock
+	if err := tmjson.Unmarshal(bz, &res); err == nil && res.Block != nil {
+		return res.Block, nil
+	}
+	var block types.Block
+	if err := tmjson.Unmarshal(bz, &block); err != nil {
+		return nil, fmt.Errorf("decoding block: %w", err)
+	}
+	return &block, nil
+}
+
+func fileExists(path string) bool {
+	_, err := os.Stat(path)
+	return err == nil
+}
+
+// NewSquareLayout constructs the data square of block and describes each of
+// its shares. If the block doesn't specify an app version, the latest one is
+// used.
+func NewSquareLayout(block *types.Block) (SquareLayout, error) {
+	if block == nil {
+		return SquareLayout{}, errors.New("nil block")
+	}
+	appVersion := block.Header.Version.App
+	if appVersion == 0 {
+		appVersion = appconsts.LatestVersion
+	}
+	dataSquare, shareLayouts, err := square.Layout(block.Data.Txs.ToSliceOfBytes(), appVersion, appconsts.SquareSizeUpperBound(appVersion))
+	if err != nil {
+		return SquareLayout{}, fmt.Errorf("constructing data square: %w", err)
+	}
+
+	squareSize := dataSquare.Size()
+	layout := SquareLayout{
+		Height:     block.Header.Height,
+		AppVersion: appVersion,
+		SquareSize: squareSize,
+		Shares:     make([]ShareInfo, len(shareLayouts)),
+		Stats: SquareStats{
+			TotalShares:  len(shareLayouts),
+			SharesByKind: make(map[string]int),
+		},
+	}
+	for i, share := range shareLayouts {
+		info := ShareInfo{
+			Index:         share.Index,
+			Row:           share.Index / squareSize,
+			Col:           share.Index % squareSize,
+			Kind:          shareKind(share),
+			Namespace:     share.Namespace.Bytes(),
+			SequenceStart: share.SequenceStart,
+			Compact:       share.Compact,
+			Padding:       string(share.Padding),
+			TxIndexes:     share.TxIndexes,
+		}
+		if share.BlobIndex >= 0 {
+			blobIndex := share.BlobIndex
+			info.BlobIndex = &blobIndex
+		}
+		layout.Shares[i] = info
+
+		layout.Stats.SharesByKind[info.Kind]++
+		if share.Padding == square.NoPadding {
+			layout.Stats.DataShares++
+		} else {
+			layout.Stats.PaddingShares++
+		}
+	}
+	layout.Stats.Utilisation = float64(layout.Stats.DataShares) / float64(layout.Stats.TotalShares)
+	return layout, nil
+}
+
+func shareKind(share square.ShareLayout) string {
+	switch {
+	case share.Padding == square.NamespacePadding:
+		return kindNamespacePadding
+	case share.Padding == square.ReservedPadding:
+		return kindReservedPadding
+	case share.Padding == square.TailPadding:
+		return kindTailPadding
+	case share.Namespace.IsTx():
+		return kindTx
+	case share.Namespace.IsPayForBlob():
+		return kindPFB
+	default:
+		return kindBlob
+	}
+}
+
+// owner returns a human readable description of the transaction or blob that
+// a share belongs to.
+func (s ShareInfo) owner() string {
+	if len(s.TxIndexes) == 0 {
+		return "-"
+	}
+	indexes := make([]string, len(s.TxIndexes))
+	for i, index := range s.TxIndexes {
+		indexes[i] = strconv.Itoa(index)
+	}
+	owner := "tx " + strings.Join(indexes, ",")
+	if s.BlobIndex != nil {
+		owner += fmt.Sprintf(" blob %d", *s.BlobIndex)
+	}
+	return owner
+}
+
+func (s ShareInfo) symbol() byte {
+	for _, k := range shareKinds {
+		if k.kind != s.Kind {
+			continue
+		}
+		if s.SequenceStart && s.Padding == "" {
+			return k.symbol - 'a' + 'A'
+		}
+		return k.symbol
+	}
+	return '?'
+}
+
+func (s ShareInfo) color() string {
+	for _, k := range shareKinds {
+		if k.kind == s.Kind {
+			return k.color
+		}
+	}
+	return "#000000"
+}
+
+func printTable(w io.Writer, layout SquareLayout) error {
+	fmt.Fprintf(w, "%-6s %-9s %-58s %-5s %-7s %-9s %s\n", "INDEX", "ROW,COL", "NAMESPACE", "START", "TYPE", "PADDING", "OWNER")
+	for _, share := range layout.Shares {
+		shareType := "sparse"
+		if share.Compact {
+			shareType = "compact"
+		}
+		padding := share.Padding
+		if padding == "" {
+			padding = "-"
+		}
+		fmt.Fprintf(w, "%-6d %-9s %-58s %-5t %-7s %-9s %s\n",
+			share.Index, fmt.Sprintf("%d,%d", share.Row, share.Col), share.Namespace, share.SequenceStart,
+			shareType, padding, share.owner())
+	}
+	fmt.Fprintln(w)
+	return printStats(w, layout)
+}
+
+func printGrid(w io.Writer, layout SquareLayout) error {
+	for row := 0; row < layout.SquareSize; row++ {
+		line := make([]byte, layout.SquareSize)
+		for col := range line {
+			line[col] = layout.Shares[row*layout.SquareSize+col].symbol()
+		}
+		fmt.Fprintln(w, string(line))
+	}
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "legend (upper case symbols start a sequence):")
+	for _, k := range shareKinds {
+		fmt.Fprintf(w, "  %c  %s\n", k.symbol, k.kind)
+	}
+	fmt.Fprintln(w)
+	return printStats(w, layout)
+}
+
+func printStats(w io.Writer, layout SquareLayout) error {
+	stats := layout.Stats
+	fmt.Fprintf(w, "app version:   %d\n", layout.AppVersion)
+	fmt.Fprintf(w, "square size:   %dx%d\n", layout.SquareSize, layout.SquareSize)
+	fmt.Fprintf(w, "data shares:   %d/%d\n", stats.DataShares, stats.TotalShares)
+	fmt.Fprintf(w, "padding:       %d/%d\n", stats.PaddingShares, stats.TotalShares)
+	fmt.Fprintf(w, "utilisation:   %.2f%%\n", stats.Utilisation*100)
+	kinds := make([]string, 0, len(stats.SharesByKind))
+	for kind := range stats.SharesByKind {
+		kinds = append(kinds, kind)
+	}
+	sort.Strings(kinds)
+	for _, kind := range kinds {
+		if _, err := fmt.Fprintf(w, "  %-26s %d\n", kind+":", stats.SharesByKind[kind]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func printSVG(w io.Writer, layout SquareLayout) error {
+	const (
+		cellSize = 12
+		// minWidth leaves enough room for the stats below small squares.
+		minWidth = 480
+	)
+	gridSize := layout.SquareSize * cellSize
+	width := gridSize
+	if width < minWidth {
+		width = minWidth
+	}
+	fmt.Fprintf(w, `<svg xmlns="http://www.w3.org/2000/svg" width="%d" height="%d" font-family="monospace" font-size="%d">`+"\n",
+		width, gridSize+2*cellSize, cellSize)
+	for _, share := range layout.Shares {
+		strokeWidth := 0.5
+		if share.SequenceStart && share.Padding == "" {
+			strokeWidth = 2
+		}
+		fmt.Fprintf(w, `<rect x="%d" y="%d" width="%d" height="%d" fill="%s" stroke="#333333" stroke-width="%.1f"><title>%s</title></rect>`+"\n",
+			share.Col*cellSize, share.Row*cellSize, cellSize, cellSize, share.color(), strokeWidth,
+			html.EscapeString(fmt.Sprintf("share %d (%s): namespace %s, %s", share.Index, share.Kind, share.Namespace, share.owner())))
+	}
+	fmt.Fprintf(w, `<text x="0" y="%d">square %dx%d, %d/%d data shares, utilisation %.2f%%</text>`+"\n",
+		gridSize+cellSize+cellSize/2, layout.SquareSize, layout.SquareSize, layout.Stats.DataShares, layout.Stats.TotalShares,
+		layout.Stats.Utilisation*100)
+	_, err := fmt.Fprintln(w, "</svg>")
+	return err
+}
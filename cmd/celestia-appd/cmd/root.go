@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/celestiaorg/celestia-app/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/pkg/blobindex"
 	qgbcmd "github.com/celestiaorg/celestia-app/x/qgb/client"
 
 	"github.com/celestiaorg/celestia-app/app"
@@ -131,6 +132,8 @@ func NewRootCmd() *cobra.Command {
 func initAppConfig() (string, interface{}) {
 	type CustomAppConfig struct {
 		serverconfig.Config
+
+		BlobIndex blobindex.Config `mapstructure:"blob-index"`
 	}
 
 	// Optionally allow the chain developer to overwrite the SDK's default
@@ -146,9 +149,9 @@ func initAppConfig() (string, interface{}) {
 	srvCfg.StateSync.SnapshotKeepRecent = 2
 	srvCfg.MinGasPrices = fmt.Sprintf("%v%s", appconsts.DefaultMinGasPrice, app.BondDenom)
 
-	CelestiaAppCfg := CustomAppConfig{Config: *srvCfg}
+	CelestiaAppCfg := CustomAppConfig{Config: *srvCfg, BlobIndex: blobindex.DefaultConfig()}
 
-	CelestiaAppTemplate := serverconfig.DefaultConfigTemplate
+	CelestiaAppTemplate := serverconfig.DefaultConfigTemplate + blobindex.ConfigTemplate
 
 	return CelestiaAppTemplate, CelestiaAppCfg
 }
@@ -158,6 +161,7 @@ func initRootCmd(rootCmd *cobra.Command, encodingConfig encoding.Config) {
 	cfg.Seal()
 
 	debugCmd := debug.Cmd()
+	debugCmd.AddCommand(debugSquareCmd())
 
 	rootCmd.AddCommand(
 		genutilcli.InitCmd(app.ModuleBasics, app.DefaultNodeHome),
@@ -171,7 +175,14 @@ func initRootCmd(rootCmd *cobra.Command, encodingConfig encoding.Config) {
 		config.Cmd(),
 	)
 
-	server.AddCommands(rootCmd, app.DefaultNodeHome, NewAppServer, createAppAndExport, addModuleInitFlags)
+	// the apps created by the start command are closed once the node stopped
+	closer := &appCloser{}
+	server.AddCommands(rootCmd, app.DefaultNodeHome, closer.wrap(NewAppServer), createAppAndExport, addModuleInitFlags)
+	startCmd, _, err := rootCmd.Find([]string{"start"})
+	if err != nil {
+		panic(err)
+	}
+	closer.closeAfter(startCmd)
 
 	// add status, query, tx, and keys subcommands
 	rootCmd.AddCommand(
@@ -180,6 +191,8 @@ func initRootCmd(rootCmd *cobra.Command, encodingConfig encoding.Config) {
 		txCommand(),
 		keys.Commands(app.DefaultNodeHome),
 		qgbcmd.VerifyCmd(),
+		verifyProofCmd(),
+		blobIndexCmd(),
 	)
 }
 
@@ -266,7 +279,7 @@ func NewAppServer(logger log.Logger, db dbm.DB, traceStore io.Writer, appOpts se
 		panic(err)
 	}
 
-	return app.New(
+	celestiaApp := app.New(
 		logger, db, traceStore, true, skipUpgradeHeights,
 		cast.ToString(appOpts.Get(flags.FlagHome)),
 		cast.ToUint(appOpts.Get(server.FlagInvCheckPeriod)),
@@ -286,6 +299,16 @@ func NewAppServer(logger log.Logger, db dbm.DB, traceStore io.Writer, appOpts se
 			b.SetProtocolVersion(appconsts.LatestVersion)
 		},
 	)
+
+	if cast.ToBool(appOpts.Get(blobindex.FlagEnable)) {
+		blobIndexDB, err := blobindex.OpenDB(filepath.Join(cast.ToString(appOpts.Get(flags.FlagHome)), "data"), server.GetAppDBBackend(appOpts))
+		if err != nil {
+			panic(err)
+		}
+		celestiaApp.SetBlobIndexer(blobindex.NewIndexer(blobIndexDB))
+	}
+
+	return celestiaApp
 }
 
 func createAppAndExport(
@@ -294,15 +317,19 @@ func createAppAndExport(
 ) (servertypes.ExportedApp, error) {
 	encCfg := encoding.MakeConfig(app.ModuleEncodingRegisters...) // Ideally, we would reuse the one created by NewRootCmd.
 	encCfg.Codec = codec.NewProtoCodec(encCfg.InterfaceRegistry)
+	// the exported app loads the modules of the version that the node runs
+	setProtocolVersion := func(b *baseapp.BaseApp) {
+		b.SetProtocolVersion(appconsts.LatestVersion)
+	}
 	var capp *app.App
 	if height != -1 {
-		capp = app.New(logger, db, traceStore, false, map[int64]bool{}, "", uint(1), encCfg, appOpts)
+		capp = app.New(logger, db, traceStore, false, map[int64]bool{}, "", uint(1), encCfg, appOpts, setProtocolVersion)
 
 		if err := capp.LoadHeight(height); err != nil {
 			return servertypes.ExportedApp{}, err
 		}
 	} else {
-		capp = app.New(logger, db, traceStore, true, map[int64]bool{}, "", uint(1), encCfg, appOpts)
+		capp = app.New(logger, db, traceStore, true, map[int64]bool{}, "", uint(1), encCfg, appOpts, setProtocolVersion)
 	}
 
 	return capp.ExportAppStateAndValidators(forZeroHeight, jailWhiteList)
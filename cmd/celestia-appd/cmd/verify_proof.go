@@ -0,0 +1,116 @@
+package cmd
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/celestiaorg/celestia-app/pkg/proof"
+	"github.com/spf13/cobra"
+)
+
+const (
+	// FlagDataRoot is the hex encoded data root to verify a proof against.
+	FlagDataRoot = "data-root"
+	// FlagDAH is the path to the data availability header to verify a proof
+	// against.
+	FlagDAH = "dah"
+
+	// stdinPath is the path used to read from stdin.
+	stdinPath = "-"
+)
+
+// verifyProofCmd returns a command that verifies a share proof against a data
+// root or a data availability header. No connection to a node is required.
+func verifyProofCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "verify-proof [proof-file]",
+		Short: "Verify a share proof offline",
+		Long: `Verify that a share proof is valid for the provided data root or data availability header.
+The proof can either be JSON encoded, as returned by the Tendermint RPC, or protobuf encoded,
+as returned by the ABCI proof queries. Proofs of the absence of a namespace, as returned
+by the namespace data query, are supported as well. If the proof file is omitted or is "-", the proof is
+read from stdin. The data availability header is read from the file passed via --dah and
+supports the same encodings.`,
+		Example: fmt.Sprintf(`celestia-appd verify-proof proof.json --%s 3D96B7D238E7E0456F6AF8E7CDF0A67BD6CF9C2089ECB559C659DCAA1F880353
+cat proof.json | celestia-appd verify-proof --%s dah.json`, FlagDataRoot, FlagDAH),
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			dataRootHex, err := cmd.Flags().GetString(FlagDataRoot)
+			if err != nil {
+				return err
+			}
+			dahPath, err := cmd.Flags().GetString(FlagDAH)
+			if err != nil {
+				return err
+			}
+			if (dataRootHex == "") == (dahPath == "") {
+				return fmt.Errorf("exactly one of --%s or --%s must be provided", FlagDataRoot, FlagDAH)
+			}
+
+			proofPath := stdinPath
+			if len(args) == 1 {
+				proofPath = args[0]
+			}
+			if proofPath == stdinPath && dahPath == stdinPath {
+				return errors.New("the proof and the data availability header can't both be read from stdin")
+			}
+
+			rawProof, err := readInput(cmd, proofPath)
+			if err != nil {
+				return err
+			}
+			shareProof, err := proof.UnmarshalShareProof(rawProof)
+			if err != nil {
+				return err
+			}
+
+			if dahPath != "" {
+				rawDAH, err := readInput(cmd, dahPath)
+				if err != nil {
+					return err
+				}
+				dah, err := proof.UnmarshalDataAvailabilityHeader(rawDAH)
+				if err != nil {
+					return err
+				}
+				if err := proof.VerifyShareProofWithDAH(shareProof, dah); err != nil {
+					return fmt.Errorf("proof is invalid: %w", err)
+				}
+			} else {
+				dataRoot, err := hex.DecodeString(dataRootHex)
+				if err != nil {
+					return fmt.Errorf("invalid data root: %w", err)
+				}
+				if err := proof.VerifyShareProof(shareProof, dataRoot); err != nil {
+					return fmt.Errorf("proof is invalid: %w", err)
+				}
+			}
+
+			namespace := append([]byte{uint8(shareProof.NamespaceVersion)}, shareProof.NamespaceID...)
+			if proof.IsAbsenceProof(shareProof) {
+				cmd.Printf("proof is valid: namespace %X is absent from the data root\n", namespace)
+				return nil
+			}
+			cmd.Printf("proof is valid: %d shares of namespace %X are committed to by the data root\n",
+				len(shareProof.Data), namespace)
+			return nil
+		},
+	}
+
+	cmd.Flags().String(FlagDataRoot, "", "hex encoded data root to verify the proof against")
+	cmd.Flags().String(FlagDAH, "", "path to the data availability header to verify the proof against")
+
+	return cmd
+}
+
+// readInput reads the contents of the file at path or, if path is "-", of
+// the command's stdin.
+func readInput(cmd *cobra.Command, path string) ([]byte, error) {
+	if path == stdinPath {
+		return io.ReadAll(cmd.InOrStdin())
+	}
+	return os.ReadFile(path)
+}
@@ -33,6 +33,15 @@ const (
 	// ShareVersionZero is the first share version format.
 	ShareVersionZero = uint8(0)
 
+	// ShareVersionOne is the share version format of blobs that embed the
+	// address of the signer of the PFB that paid for them in the first share
+	// of the blob.
+	ShareVersionOne = uint8(1)
+
+	// SignerSize is the size of the signer address embedded in the first
+	// share of a blob using ShareVersionOne.
+	SignerSize = 20
+
 	// DefaultShareVersion is the defacto share version. Use this if you are
 	// unsure of which version to use.
 	DefaultShareVersion = ShareVersionZero
@@ -79,6 +88,11 @@ var (
 	// DefaultCodec is the default codec creator used for data erasure.
 	DefaultCodec = rsmt2d.NewLeoRSCodec
 
-	// SupportedShareVersions is a list of supported share versions.
-	SupportedShareVersions = []uint8{ShareVersionZero}
+	// SupportedShareVersions is a list of share versions that can be split
+	// and parsed. Which of these blobs may use depends on the app version,
+	// see ShareVersions.
+	SupportedShareVersions = []uint8{ShareVersionZero, ShareVersionOne}
 )
+
+// BondDenom is the denomination of the native token that fees are paid in.
+const BondDenom = "utia"
@@ -0,0 +1,11 @@
+// Package v2 contains the constants of the second version of the state
+// machine. Chains run appconsts.LatestVersion, which is v1, and no release
+// upgrades them to v2 yet, so the features gated on v2 stay off until a future
+// upgrade ships.
+package v2
+
+const (
+	Version              uint64 = 2
+	SquareSizeUpperBound int    = 128
+	SubtreeRootThreshold int    = 64
+)
@@ -2,9 +2,15 @@ package appconsts
 
 import (
 	v1 "github.com/celestiaorg/celestia-app/pkg/appconsts/v1"
+	v2 "github.com/celestiaorg/celestia-app/pkg/appconsts/v2"
 )
 
 const (
+	// LatestVersion is the app version that chains run. No release upgrades a
+	// chain to v2 yet, so the features that are gated on v2, i.e. share
+	// version one, the blob base fee, the x/namespace module, PFBs executed
+	// through authz and the fee allowance checks of proposals, stay off until
+	// a future upgrade ships.
 	LatestVersion = v1.Version
 )
 
@@ -12,17 +18,79 @@ const (
 // share commitment. If a blob contains more shares than this number, then the height
 // of the subtree roots will gradually increase so that the amount remains within that limit.
 // The rationale for this value is described in more detail in ADR013
-// (./docs/architecture/adr-013).
+// (./docs/architecture/adr-013). Unknown versions use the value of
+// LatestVersion.
 // ADR013 https://github.com/celestiaorg/celestia-app/blob/e905143e8fe138ce6085ae9a5c1af950a2d87638/docs/architecture/adr-013-non-interactive-default-rules-for-zero-padding.md //nolint: lll
-func SubtreeRootThreshold(_ uint64) int {
-	return v1.SubtreeRootThreshold
+func SubtreeRootThreshold(version uint64) int {
+	switch version {
+	case v2.Version:
+		return v2.SubtreeRootThreshold
+	default:
+		return v1.SubtreeRootThreshold
+	}
 }
 
 // SquareSizeUpperBound is the maximum original square width possible
 // for a version of the state machine. The maximum is decided through
-// governance. See `DefaultGovMaxSquareSize`.
-func SquareSizeUpperBound(_ uint64) int {
-	return v1.SquareSizeUpperBound
+// governance. See `DefaultGovMaxSquareSize`. Unknown versions use the
+// value of LatestVersion.
+func SquareSizeUpperBound(version uint64) int {
+	switch version {
+	case v2.Version:
+		return v2.SquareSizeUpperBound
+	default:
+		return v1.SquareSizeUpperBound
+	}
+}
+
+// ShareVersions returns the share versions that blobs may use for a version
+// of the state machine. ShareVersionOne, which embeds the signer of a blob in
+// its first share, is supported in v2. Unknown versions use the share
+// versions of LatestVersion.
+func ShareVersions(version uint64) []uint8 {
+	switch version {
+	case v2.Version:
+		return []uint8{ShareVersionZero, ShareVersionOne}
+	default:
+		return []uint8{ShareVersionZero}
+	}
+}
+
+// BlobBaseFeeEnabled returns whether the blob base fee is charged and adjusted
+// for a version of the state machine, which is the case in v2. Like for the
+// other versioned values, it is disabled for unknown versions.
+func BlobBaseFeeEnabled(version uint64) bool {
+	return version == v2.Version
+}
+
+// NamespaceRegistryEnabled returns whether the x/namespace module is loaded
+// and the namespace ownership of PFBs enforced for a version of the state
+// machine, which is the case in v2.
+func NamespaceRegistryEnabled(version uint64) bool {
+	return version == v2.Version
+}
+
+// AuthzPayForBlobsEnabled returns whether a MsgPayForBlobs may be executed
+// through an authz MsgExec, with its grant checked before it is included in a
+// block, for a version of the state machine, which is the case in v2.
+func AuthzPayForBlobsEnabled(version uint64) bool {
+	return version == v2.Version
+}
+
+// ProposalFeeAllowancesEnabled returns whether the fee allowances used by the
+// transactions of a proposal, e.g. the blob bytes left of a BlobAllowance, are
+// checked before they are included in a block, for a version of the state
+// machine, which is the case in v2.
+func ProposalFeeAllowancesEnabled(version uint64) bool {
+	return version == v2.Version
+}
+
+// RevertInvalidBlobTxsEnabled returns whether the square builder frees the
+// PFB space it counted for a blob tx that it rejects for an invalid blob, for
+// a version of the state machine, which is the case in v2. In v1 the space
+// remains counted so that the squares of v1 blocks are built as before.
+func RevertInvalidBlobTxsEnabled(version uint64) bool {
+	return version == v2.Version
 }
 
 var (
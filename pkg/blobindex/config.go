@@ -0,0 +1,34 @@
+package blobindex
+
+// FlagEnable is the key of the app.toml option that enables the blob index.
+const FlagEnable = "blob-index.enable"
+
+// Config is the configuration of the blob index in app.toml.
+type Config struct {
+	// Enable enables indexing the blobs of every committed block.
+	Enable bool `mapstructure:"enable"`
+}
+
+// DefaultConfig returns the default configuration of the blob index, which
+// is disabled.
+func DefaultConfig() Config {
+	return Config{Enable: false}
+}
+
+// ConfigTemplate is the app.toml template of the blob index configuration.
+const ConfigTemplate = `
+###############################################################################
+###                         Blob Index Configuration                        ###
+###############################################################################
+
+[blob-index]
+
+# Enable records the namespace, height, PFB transaction hash and share range
+# of every blob included in a committed block in a local database in the data
+# directory, which can be queried with the Query/BlobsByNamespace gRPC method.
+# Every block executed by the node is indexed when it is committed. Blocks
+# committed before the index was enabled, e.g. restored from a state sync
+# snapshot, can be indexed with the "blob-index backfill" command. The heights
+# that weren't indexed are returned by Query/BlobsByNamespace.
+enable = {{ .BlobIndex.Enable }}
+`
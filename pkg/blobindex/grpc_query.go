@@ -0,0 +1,117 @@
+package blobindex
+
+import (
+	"context"
+
+	appns "github.com/celestiaorg/celestia-app/pkg/namespace"
+	blobindexproto "github.com/celestiaorg/celestia-app/proto/celestia/blobindex"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	gogogrpc "github.com/gogo/protobuf/grpc"
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var _ blobindexproto.QueryServer = queryServer{}
+
+// queryServer implements the blob index Query service. The indexer is nil if
+// the blob index is disabled.
+type queryServer struct {
+	indexer *Indexer
+}
+
+// NewQueryServer creates a new blob index query server.
+func NewQueryServer(indexer *Indexer) blobindexproto.QueryServer {
+	return queryServer{indexer: indexer}
+}
+
+// RegisterQueryService registers the blob index query service on the given
+// gRPC server.
+func RegisterQueryService(indexer *Indexer, server gogogrpc.Server) {
+	blobindexproto.RegisterQueryServer(server, NewQueryServer(indexer))
+}
+
+// RegisterGRPCGatewayRoutes mounts the blob index query service's
+// gRPC-gateway routes on the given Mux.
+func RegisterGRPCGatewayRoutes(clientConn gogogrpc.ClientConn, mux *runtime.ServeMux) {
+	_ = blobindexproto.RegisterQueryHandlerClient(context.Background(), mux, blobindexproto.NewQueryClient(clientConn))
+}
+
+// BlobsByNamespace implements the Query/BlobsByNamespace gRPC method.
+func (s queryServer) BlobsByNamespace(_ context.Context, req *blobindexproto.QueryBlobsByNamespaceRequest) (*blobindexproto.QueryBlobsByNamespaceResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if s.indexer == nil {
+		return nil, status.Error(codes.Unavailable, "the blob index is disabled on this node")
+	}
+	namespace, err := appns.From(req.Namespace)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if req.MinHeight < 0 || req.MaxHeight < 0 {
+		return nil, status.Error(codes.InvalidArgument, "heights must not be negative")
+	}
+	if req.MaxHeight != 0 && req.MaxHeight < req.MinHeight {
+		return nil, status.Errorf(codes.InvalidArgument, "max height %d is lower than min height %d", req.MaxHeight, req.MinHeight)
+	}
+
+	pagination := req.Pagination
+	if pagination == nil {
+		pagination = &query.PageRequest{}
+	}
+	if len(pagination.Key) != 0 && pagination.Offset != 0 {
+		return nil, status.Error(codes.InvalidArgument, "either offset or key is expected, got both")
+	}
+	if pagination.Reverse {
+		return nil, status.Error(codes.InvalidArgument, "reverse pagination is not supported")
+	}
+	limit := pagination.Limit
+	if limit == 0 {
+		limit = query.DefaultLimit
+	}
+	// as in the SDK, the total is only counted when paginating by offset
+	countTotal := pagination.CountTotal && len(pagination.Key) == 0
+
+	var (
+		blobs   []blobindexproto.IndexedBlob
+		nextKey []byte
+		total   uint64
+	)
+	err = s.indexer.BlobsByNamespace(namespace, req.MinHeight, req.MaxHeight, pagination.Key, func(key []byte, blob blobindexproto.IndexedBlob) bool {
+		total++
+		switch {
+		case total <= pagination.Offset:
+		case uint64(len(blobs)) < limit:
+			blobs = append(blobs, blob)
+		case nextKey == nil:
+			nextKey = key
+		}
+		// the remaining blobs are only iterated over to count them
+		return nextKey == nil || countTotal
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	// the blobs of the heights that weren't indexed are missing, so they are
+	// returned to let clients tell apart partial results
+	unindexedHeights, err := s.indexer.UnindexedHeights(req.MinHeight, req.MaxHeight)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	lastIndexedHeight, err := s.indexer.LastIndexedHeight()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	pageRes := &query.PageResponse{NextKey: nextKey}
+	if countTotal {
+		pageRes.Total = total
+	}
+	return &blobindexproto.QueryBlobsByNamespaceResponse{
+		Blobs:             blobs,
+		Pagination:        pageRes,
+		UnindexedHeights:  unindexedHeights,
+		LastIndexedHeight: lastIndexedHeight,
+	}, nil
+}
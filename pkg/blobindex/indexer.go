@@ -0,0 +1,303 @@
+package blobindex
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+
+	"github.com/celestiaorg/celestia-app/pkg/appconsts"
+	v1 "github.com/celestiaorg/celestia-app/pkg/appconsts/v1"
+	v2 "github.com/celestiaorg/celestia-app/pkg/appconsts/v2"
+	"github.com/celestiaorg/celestia-app/pkg/da"
+	appns "github.com/celestiaorg/celestia-app/pkg/namespace"
+	"github.com/celestiaorg/celestia-app/pkg/shares"
+	"github.com/celestiaorg/celestia-app/pkg/square"
+	blobindexproto "github.com/celestiaorg/celestia-app/proto/celestia/blobindex"
+	blobtypes "github.com/celestiaorg/celestia-app/x/blob/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	coretypes "github.com/tendermint/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+)
+
+// DBName is the name of the database of the blob index in the data directory
+// of the node.
+const DBName = "blob_index"
+
+// keySize is the size of the key of an indexed blob: the blob prefix and the
+// namespace followed by the height, the index of the PFB in the block and the
+// index of the blob in the PFB.
+const keySize = 1 + appns.NamespaceSize + 8 + 4 + 4
+
+var (
+	// blobPrefix is the prefix of the keys of the indexed blobs.
+	blobPrefix = []byte{0x01}
+	// indexedHeightsPrefix is the prefix of the keys of the ranges of indexed
+	// heights. Each range is stored under its first height and its value is
+	// its last height.
+	indexedHeightsPrefix = []byte{0x02}
+)
+
+// Indexer records, for each namespace, the heights, PFB transactions and
+// share ranges of the blobs included in the blocks of the chain. It also
+// records which heights were indexed, so that the heights whose blobs are
+// missing from the index are known. The index is kept in a database that is
+// local to the node and is not part of the state.
+type Indexer struct {
+	db dbm.DB
+}
+
+// NewIndexer creates an indexer that stores the index in db.
+func NewIndexer(db dbm.DB) *Indexer {
+	return &Indexer{db: db}
+}
+
+// OpenDB opens the database of the blob index in the data directory.
+func OpenDB(dataDir string, backend dbm.BackendType) (dbm.DB, error) {
+	return dbm.NewDB(DBName, backend, dataDir)
+}
+
+// Close closes the database of the index.
+func (i *Indexer) Close() error {
+	return i.db.Close()
+}
+
+// IndexBlock indexes the blobs of the block at height. wrappedPFBs are the
+// PFB transactions of the block wrapped with the share indexes of their
+// blobs, see square.Square.WrappedPFBs.
+func (i *Indexer) IndexBlock(height int64, wrappedPFBs [][]byte) error {
+	batch := i.db.NewBatch()
+	defer batch.Close()
+	for pfbIndex, wpfb := range wrappedPFBs {
+		indexWrapper, isIndexWrapper := coretypes.UnmarshalIndexWrapper(wpfb)
+		if !isIndexWrapper {
+			return fmt.Errorf("PFB %d at height %d is not wrapped", pfbIndex, height)
+		}
+		if err := i.indexPFB(batch, height, pfbIndex, indexWrapper); err != nil {
+			return fmt.Errorf("indexing PFB %d at height %d: %w", pfbIndex, height, err)
+		}
+	}
+	if err := i.markIndexed(batch, height); err != nil {
+		return err
+	}
+	return batch.WriteSync()
+}
+
+// markIndexed adds height to the ranges of indexed heights, merging it with
+// the ranges that end right before or start right after it.
+func (i *Indexer) markIndexed(batch dbm.Batch, height int64) error {
+	start, end := height, height
+	prev, found, err := i.rangeAt(height)
+	if err != nil {
+		return err
+	}
+	if found && prev.End >= height {
+		// the height was already indexed
+		return nil
+	}
+	if found && prev.End == height-1 {
+		start = prev.Start
+	}
+	nextKey := concat(indexedHeightsPrefix, heightBytes(height+1))
+	nextEnd, err := i.db.Get(nextKey)
+	if err != nil {
+		return err
+	}
+	if nextEnd != nil {
+		end = int64(binary.BigEndian.Uint64(nextEnd))
+		if err := batch.Delete(nextKey); err != nil {
+			return err
+		}
+	}
+	return batch.Set(concat(indexedHeightsPrefix, heightBytes(start)), heightBytes(end))
+}
+
+// rangeAt returns the range of indexed heights with the highest start that is
+// lower than or equal to height and false if there is none.
+func (i *Indexer) rangeAt(height int64) (blobindexproto.HeightRange, bool, error) {
+	it, err := i.db.ReverseIterator(indexedHeightsPrefix, concat(indexedHeightsPrefix, heightBytes(height+1)))
+	if err != nil {
+		return blobindexproto.HeightRange{}, false, err
+	}
+	defer it.Close()
+	if !it.Valid() {
+		return blobindexproto.HeightRange{}, false, it.Error()
+	}
+	return heightRange(it.Key(), it.Value()), true, it.Error()
+}
+
+// LastIndexedHeight returns the highest indexed height or zero if no height
+// was indexed.
+func (i *Indexer) LastIndexedHeight() (int64, error) {
+	it, err := i.db.ReverseIterator(indexedHeightsPrefix, sdk.PrefixEndBytes(indexedHeightsPrefix))
+	if err != nil {
+		return 0, err
+	}
+	defer it.Close()
+	if !it.Valid() {
+		return 0, it.Error()
+	}
+	return heightRange(it.Key(), it.Value()).End, it.Error()
+}
+
+// UnindexedHeights returns the ranges of heights between minHeight and
+// maxHeight, both inclusive, that weren't indexed. Heights above the last
+// indexed height aren't considered, nor is maxHeight if it is zero.
+func (i *Indexer) UnindexedHeights(minHeight, maxHeight int64) ([]blobindexproto.HeightRange, error) {
+	if minHeight < 1 {
+		minHeight = 1
+	}
+	lastHeight, err := i.LastIndexedHeight()
+	if err != nil {
+		return nil, err
+	}
+	if maxHeight == 0 || maxHeight > lastHeight {
+		maxHeight = lastHeight
+	}
+
+	it, err := i.db.Iterator(indexedHeightsPrefix, concat(indexedHeightsPrefix, heightBytes(maxHeight+1)))
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+	var gaps []blobindexproto.HeightRange
+	next := minHeight
+	for ; it.Valid(); it.Next() {
+		indexed := heightRange(it.Key(), it.Value())
+		if indexed.End < next {
+			continue
+		}
+		if indexed.Start > next {
+			gaps = append(gaps, blobindexproto.HeightRange{Start: next, End: indexed.Start - 1})
+		}
+		next = indexed.End + 1
+	}
+	if err := it.Error(); err != nil {
+		return nil, err
+	}
+	if next <= maxHeight {
+		gaps = append(gaps, blobindexproto.HeightRange{Start: next, End: maxHeight})
+	}
+	return gaps, nil
+}
+
+func (i *Indexer) indexPFB(batch dbm.Batch, height int64, pfbIndex int, indexWrapper tmproto.IndexWrapper) error {
+	pfb, err := blobtypes.PFBFromTx(indexWrapper.Tx)
+	if err != nil {
+		return err
+	}
+	if len(pfb.BlobSizes) != len(indexWrapper.ShareIndexes) {
+		return fmt.Errorf("expected %d share indexes, but got %d", len(pfb.BlobSizes), len(indexWrapper.ShareIndexes))
+	}
+
+	txHash := tmhash.Sum(indexWrapper.Tx)
+	for blobIndex, start := range indexWrapper.ShareIndexes {
+		blob := blobindexproto.IndexedBlob{
+			Height:    height,
+			TxHash:    txHash,
+			BlobIndex: uint32(blobIndex),
+			Start:     start,
+			End:       start + uint32(shares.SparseSharesNeededForVersion(pfb.BlobSizes[blobIndex], uint8(pfb.ShareVersions[blobIndex]))),
+		}
+		value, err := blob.Marshal()
+		if err != nil {
+			return err
+		}
+		if err := batch.Set(blobKey(pfb.Namespaces[blobIndex], height, pfbIndex, blobIndex), value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// IndexBlockData indexes the blobs of the block at height by constructing its
+// data square from the block's transactions. The data root of the square must
+// match dataHash, which guarantees that the index matches the block. The max
+// square size is only an upper bound of the size of the square, so the square
+// size upper bound of the app version is used as the max square size set by
+// governance when the block was proposed may have changed since then. An
+// error is returned if the app version isn't v1 or v2.
+func (i *Indexer) IndexBlockData(height int64, txs [][]byte, appVersion uint64, dataHash []byte) error {
+	if appVersion != v1.Version && appVersion != v2.Version {
+		return fmt.Errorf("height %d has unsupported app version %d", height, appVersion)
+	}
+	dataSquare, err := square.Construct(txs, appVersion, appconsts.SquareSizeUpperBound(appVersion))
+	if err != nil {
+		return fmt.Errorf("constructing the square of height %d: %w", height, err)
+	}
+	eds, err := da.ExtendShares(shares.ToBytes(dataSquare))
+	if err != nil {
+		return err
+	}
+	dah := da.NewDataAvailabilityHeader(eds)
+	if !bytes.Equal(dah.Hash(), dataHash) {
+		return fmt.Errorf("data root of height %d differs from the data root of the square constructed from its transactions with app version %d", height, appVersion)
+	}
+
+	wrappedPFBs, err := dataSquare.WrappedPFBs()
+	if err != nil {
+		return err
+	}
+	return i.IndexBlock(height, wrappedPFBs.ToSliceOfBytes())
+}
+
+// BlobsByNamespace iterates over the blobs of namespace between minHeight and
+// maxHeight, both inclusive, in the order of their heights and positions in
+// the block. There is no upper bound if maxHeight is zero. If key is set, the
+// iteration starts at the blob with that key instead of at minHeight. The keys
+// passed to fn are relative to the namespace. The iteration stops when fn
+// returns false.
+func (i *Indexer) BlobsByNamespace(namespace appns.Namespace, minHeight, maxHeight int64, key []byte, fn func(key []byte, blob blobindexproto.IndexedBlob) bool) error {
+	prefix := concat(blobPrefix, namespace.Bytes())
+	start := concat(prefix, heightBytes(minHeight))
+	if len(key) != 0 {
+		start = concat(prefix, key)
+	}
+	end := sdk.PrefixEndBytes(prefix)
+	if maxHeight != 0 {
+		end = concat(prefix, heightBytes(maxHeight+1))
+	}
+
+	it, err := i.db.Iterator(start, end)
+	if err != nil {
+		return err
+	}
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		var blob blobindexproto.IndexedBlob
+		if err := blob.Unmarshal(it.Value()); err != nil {
+			return err
+		}
+		if !fn(it.Key()[len(prefix):], blob) {
+			break
+		}
+	}
+	return it.Error()
+}
+
+// blobKey returns the key of the indexed blob.
+func blobKey(namespace []byte, height int64, pfbIndex, blobIndex int) []byte {
+	key := make([]byte, 0, keySize)
+	key = append(key, blobPrefix...)
+	key = append(key, namespace...)
+	key = append(key, heightBytes(height)...)
+	key = binary.BigEndian.AppendUint32(key, uint32(pfbIndex))
+	return binary.BigEndian.AppendUint32(key, uint32(blobIndex))
+}
+
+// heightRange decodes a range of indexed heights from its key and value.
+func heightRange(key, value []byte) blobindexproto.HeightRange {
+	return blobindexproto.HeightRange{
+		Start: int64(binary.BigEndian.Uint64(key[len(indexedHeightsPrefix):])),
+		End:   int64(binary.BigEndian.Uint64(value)),
+	}
+}
+
+func concat(prefix, suffix []byte) []byte {
+	return append(bytes.Clone(prefix), suffix...)
+}
+
+func heightBytes(height int64) []byte {
+	return binary.BigEndian.AppendUint64(nil, uint64(height))
+}
@@ -0,0 +1,191 @@
+package blobindex_test
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/celestiaorg/celestia-app/app"
+	"github.com/celestiaorg/celestia-app/app/encoding"
+	"github.com/celestiaorg/celestia-app/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/pkg/blobindex"
+	"github.com/celestiaorg/celestia-app/pkg/da"
+	appns "github.com/celestiaorg/celestia-app/pkg/namespace"
+	"github.com/celestiaorg/celestia-app/pkg/shares"
+	"github.com/celestiaorg/celestia-app/pkg/square"
+	blobindexproto "github.com/celestiaorg/celestia-app/proto/celestia/blobindex"
+	"github.com/celestiaorg/celestia-app/test/util/blobfactory"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+	coretypes "github.com/tendermint/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestIndexer(t *testing.T) {
+	encCfg := encoding.MakeConfig(app.ModuleEncodingRegisters...)
+	ns1 := appns.MustNewV0(bytes.Repeat([]byte{1}, appns.NamespaceVersionZeroIDSize))
+	ns2 := appns.MustNewV0(bytes.Repeat([]byte{2}, appns.NamespaceVersionZeroIDSize))
+	indexer := blobindex.NewIndexer(dbm.NewMemDB())
+
+	// blocks maps each height to the txs of its block
+	blocks := map[int64][][]byte{
+		1: coretypes.Txs(blobfactory.RandBlobTxsWithNamespaces(encCfg.TxConfig.TxEncoder(), []appns.Namespace{ns1, ns2, ns1}, []int{100, 1000, 2000})).ToSliceOfBytes(),
+		2: nil,
+		3: coretypes.Txs(blobfactory.RandBlobTxsWithNamespaces(encCfg.TxConfig.TxEncoder(), []appns.Namespace{ns2}, []int{100})).ToSliceOfBytes(),
+		4: coretypes.Txs(blobfactory.RandBlobTxsWithNamespaces(encCfg.TxConfig.TxEncoder(), []appns.Namespace{ns1}, []int{5000})).ToSliceOfBytes(),
+	}
+	for height := int64(1); height <= 4; height++ {
+		dataSquare, err := square.Construct(blocks[height], appconsts.LatestVersion, appconsts.DefaultGovMaxSquareSize)
+		require.NoError(t, err)
+		eds, err := da.ExtendShares(shares.ToBytes(dataSquare))
+		require.NoError(t, err)
+		dah := da.NewDataAvailabilityHeader(eds)
+		require.NoError(t, indexer.IndexBlockData(height, blocks[height], appconsts.LatestVersion, dah.Hash()))
+	}
+
+	// the data root of the block must match the constructed square
+	err := indexer.IndexBlockData(5, blocks[1], appconsts.LatestVersion, []byte("invalid"))
+	assert.Error(t, err)
+	// the app version must be known
+	err = indexer.IndexBlockData(5, blocks[1], 0, []byte("invalid"))
+	assert.Error(t, err)
+
+	// expected returns the indexed blob of the PFB at txIndex in the block at
+	// height
+	expected := func(height int64, txIndex int) blobindexproto.IndexedBlob {
+		blobTx, isBlobTx := coretypes.UnmarshalBlobTx(blocks[height][txIndex])
+		require.True(t, isBlobTx)
+		blobRange, err := square.BlobShareRange(blocks[height], txIndex, 0, appconsts.LatestVersion)
+		require.NoError(t, err)
+		return blobindexproto.IndexedBlob{
+			Height: height,
+			TxHash: tmhash.Sum(blobTx.Tx),
+			Start:  uint32(blobRange.Start),
+			End:    uint32(blobRange.End),
+		}
+	}
+
+	server := blobindex.NewQueryServer(indexer)
+	blobsByNamespace := func(ns appns.Namespace, minHeight, maxHeight int64, pagination *query.PageRequest) (*blobindexproto.QueryBlobsByNamespaceResponse, error) {
+		return server.BlobsByNamespace(context.Background(), &blobindexproto.QueryBlobsByNamespaceRequest{
+			Namespace:  ns.Bytes(),
+			MinHeight:  minHeight,
+			MaxHeight:  maxHeight,
+			Pagination: pagination,
+		})
+	}
+
+	type test struct {
+		name                 string
+		namespace            appns.Namespace
+		minHeight, maxHeight int64
+		expected             []blobindexproto.IndexedBlob
+	}
+	tests := []test{
+		{"all heights", ns1, 0, 0, []blobindexproto.IndexedBlob{expected(1, 0), expected(1, 2), expected(4, 0)}},
+		{"min height", ns1, 2, 0, []blobindexproto.IndexedBlob{expected(4, 0)}},
+		{"max height", ns2, 0, 2, []blobindexproto.IndexedBlob{expected(1, 1)}},
+		{"height range", ns2, 1, 3, []blobindexproto.IndexedBlob{expected(1, 1), expected(3, 0)}},
+		{"no blobs", appns.MustNewV0(bytes.Repeat([]byte{3}, appns.NamespaceVersionZeroIDSize)), 0, 0, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := blobsByNamespace(tt.namespace, tt.minHeight, tt.maxHeight, nil)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, resp.Blobs)
+			assert.Nil(t, resp.Pagination.NextKey)
+			assert.Empty(t, resp.UnindexedHeights)
+			assert.EqualValues(t, 4, resp.LastIndexedHeight)
+		})
+	}
+
+	// page through the blobs of ns1 one at a time
+	var blobs []blobindexproto.IndexedBlob
+	pagination := &query.PageRequest{Limit: 1, CountTotal: true}
+	for {
+		resp, err := blobsByNamespace(ns1, 0, 0, pagination)
+		require.NoError(t, err)
+		require.Len(t, resp.Blobs, 1)
+		if pagination.CountTotal {
+			assert.EqualValues(t, 3, resp.Pagination.Total)
+		}
+		blobs = append(blobs, resp.Blobs...)
+		if resp.Pagination.NextKey == nil {
+			break
+		}
+		pagination = &query.PageRequest{Key: resp.Pagination.NextKey, Limit: 1}
+	}
+	assert.Equal(t, tests[0].expected, blobs)
+
+	resp, err := blobsByNamespace(ns1, 0, 0, &query.PageRequest{Offset: 1, Limit: 1})
+	require.NoError(t, err)
+	assert.Equal(t, []blobindexproto.IndexedBlob{expected(1, 2)}, resp.Blobs)
+
+	_, err = blobsByNamespace(ns1, 3, 2, nil)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = blobindex.NewQueryServer(nil).BlobsByNamespace(context.Background(), &blobindexproto.QueryBlobsByNamespaceRequest{Namespace: ns1.Bytes()})
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+}
+
+func TestUnindexedHeights(t *testing.T) {
+	indexer := blobindex.NewIndexer(dbm.NewMemDB())
+	lastHeight, err := indexer.LastIndexedHeight()
+	require.NoError(t, err)
+	assert.Zero(t, lastHeight)
+
+	for _, height := range []int64{3, 2, 6, 5, 9} {
+		require.NoError(t, indexer.IndexBlock(height, nil))
+	}
+	lastHeight, err = indexer.LastIndexedHeight()
+	require.NoError(t, err)
+	assert.EqualValues(t, 9, lastHeight)
+
+	type test struct {
+		name                 string
+		minHeight, maxHeight int64
+		expected             []blobindexproto.HeightRange
+	}
+	tests := []test{
+		{"all heights", 0, 0, []blobindexproto.HeightRange{{Start: 1, End: 1}, {Start: 4, End: 4}, {Start: 7, End: 8}}},
+		{"min height", 5, 0, []blobindexproto.HeightRange{{Start: 7, End: 8}}},
+		{"max height", 0, 5, []blobindexproto.HeightRange{{Start: 1, End: 1}, {Start: 4, End: 4}}},
+		{"height range", 4, 7, []blobindexproto.HeightRange{{Start: 4, End: 4}, {Start: 7, End: 7}}},
+		{"indexed range", 5, 6, nil},
+		{"above the last indexed height", 10, 20, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gaps, err := indexer.UnindexedHeights(tt.minHeight, tt.maxHeight)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, gaps)
+		})
+	}
+
+	// the gaps are returned along with the blobs
+	server := blobindex.NewQueryServer(indexer)
+	req := &blobindexproto.QueryBlobsByNamespaceRequest{
+		Namespace: appns.MustNewV0(bytes.Repeat([]byte{1}, appns.NamespaceVersionZeroIDSize)).Bytes(),
+		MaxHeight: 12,
+	}
+	resp, err := server.BlobsByNamespace(context.Background(), req)
+	require.NoError(t, err)
+	assert.Equal(t, tests[0].expected, resp.UnindexedHeights)
+	assert.EqualValues(t, 9, resp.LastIndexedHeight)
+
+	// indexing the missing heights closes the gaps
+	for _, height := range []int64{4, 1, 8, 7, 4} {
+		require.NoError(t, indexer.IndexBlock(height, nil))
+	}
+	gaps, err := indexer.UnindexedHeights(0, 0)
+	require.NoError(t, err)
+	assert.Empty(t, gaps)
+
+	resp, err = server.BlobsByNamespace(context.Background(), req)
+	require.NoError(t, err)
+	assert.Empty(t, resp.UnindexedHeights)
+	assert.EqualValues(t, 9, resp.LastIndexedHeight)
+}
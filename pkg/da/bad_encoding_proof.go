@@ -0,0 +1,259 @@
+package da
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	"github.com/celestiaorg/nmt"
+	"github.com/celestiaorg/rsmt2d"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/celestiaorg/celestia-app/pkg/appconsts"
+	appns "github.com/celestiaorg/celestia-app/pkg/namespace"
+	"github.com/celestiaorg/celestia-app/pkg/wrapper"
+	daproto "github.com/celestiaorg/celestia-app/proto/celestia/da"
+)
+
+// BadEncodingProof proves that a row or column of the extended data square
+// committed to by a DataAvailabilityHeader is not a valid Reed-Solomon
+// extension of its original half. It consists of enough shares of the row or
+// column to decode it, each of which is proven against the root of the
+// orthogonal column or row. Anyone can then decode the row or column from the
+// shares and check that its root differs from the committed one.
+type BadEncodingProof struct {
+	// Axis is the axis of the incorrectly encoded row or column.
+	Axis rsmt2d.Axis
+	// Index is the index of the incorrectly encoded row or column.
+	Index uint
+	// Shares are shares of the row or column with proofs of their inclusion
+	// in the orthogonal axis.
+	Shares []ShareWithProof
+}
+
+// ShareWithProof is a share of an extended data square along with a proof of
+// its inclusion in a row or column of the square.
+type ShareWithProof struct {
+	// Index is the index of the share in the incorrectly encoded row or column
+	// and thus the index of the orthogonal row or column that Proof is for.
+	Index uint
+	Share []byte
+	Proof nmt.Proof
+}
+
+// NewBadEncodingProof builds a proof from the ErrByzantineData returned by
+// rsmt2d when repairing eds against a DataAvailabilityHeader fails. eds is
+// expected to be the partially repaired square that Repair leaves behind. Only
+// shares of the byzantine row or column whose orthogonal column or row is
+// complete in eds can be proven, and at least half of the shares of the row
+// or column need to be.
+func NewBadEncodingProof(eds *rsmt2d.ExtendedDataSquare, byzErr *rsmt2d.ErrByzantineData) (BadEncodingProof, error) {
+	if byzErr == nil {
+		return BadEncodingProof{}, errors.New("nil byzantine data error")
+	}
+	width := eds.Width()
+	if byzErr.Index >= width {
+		return BadEncodingProof{}, fmt.Errorf("byzantine index %d is out of bounds for a square of width %d", byzErr.Index, width)
+	}
+	squareSize := width / 2
+
+	axisShares := byzErr.Shares
+	if axisShares == nil {
+		axisShares = getAxis(eds, byzErr.Axis, byzErr.Index)
+	}
+
+	proof := BadEncodingProof{
+		Axis:   byzErr.Axis,
+		Index:  byzErr.Index,
+		Shares: make([]ShareWithProof, 0, squareSize),
+	}
+	for i, share := range axisShares {
+		if len(share) == 0 {
+			continue
+		}
+		orthogonal := getAxis(eds, orthogonalAxis(byzErr.Axis), uint(i))
+		if !isComplete(orthogonal) {
+			continue
+		}
+
+		tree := wrapper.NewErasuredNamespacedMerkleTree(uint64(squareSize), uint(i))
+		for _, s := range orthogonal {
+			if err := tree.Push(s); err != nil {
+				return BadEncodingProof{}, err
+			}
+		}
+		nmtProof, err := tree.ProveRange(int(byzErr.Index), int(byzErr.Index)+1)
+		if err != nil {
+			return BadEncodingProof{}, err
+		}
+		proof.Shares = append(proof.Shares, ShareWithProof{
+			Index: uint(i),
+			Share: share,
+			Proof: nmtProof,
+		})
+		if uint(len(proof.Shares)) == squareSize {
+			return proof, nil
+		}
+	}
+	return BadEncodingProof{}, fmt.Errorf("only %d of the %d shares needed to decode %s %d can be proven", len(proof.Shares), squareSize, byzErr.Axis, byzErr.Index)
+}
+
+// Validate returns nil if the proof shows that the row or column of the
+// extended data square committed to by dah is not correctly encoded. An error
+// is returned if the proof is malformed, if a share isn't included in the
+// orthogonal row or column or if the row or column is correctly encoded.
+func (p BadEncodingProof) Validate(dah *DataAvailabilityHeader) error {
+	if err := dah.ValidateBasic(); err != nil {
+		return err
+	}
+	width := uint(len(dah.RowRoots))
+	squareSize := width / 2
+	if p.Axis != rsmt2d.Row && p.Axis != rsmt2d.Col {
+		return fmt.Errorf("invalid axis %d", p.Axis)
+	}
+	if p.Index >= width {
+		return fmt.Errorf("index %d is out of bounds for a square of width %d", p.Index, width)
+	}
+	if uint(len(p.Shares)) < squareSize {
+		return fmt.Errorf("%d shares are needed to decode %s %d, got %d", squareSize, p.Axis, p.Index, len(p.Shares))
+	}
+
+	orthogonalRoots := dah.ColumnRoots
+	axisRoots := dah.RowRoots
+	if p.Axis == rsmt2d.Col {
+		orthogonalRoots, axisRoots = dah.RowRoots, dah.ColumnRoots
+	}
+
+	axisShares := make([][]byte, width)
+	for _, s := range p.Shares {
+		if s.Index >= width {
+			return fmt.Errorf("share index %d is out of bounds for a square of width %d", s.Index, width)
+		}
+		if axisShares[s.Index] != nil {
+			return fmt.Errorf("duplicate share at index %d", s.Index)
+		}
+		if len(s.Share) != appconsts.ShareSize {
+			return fmt.Errorf("share at index %d has size %d, expected %d", s.Index, len(s.Share), appconsts.ShareSize)
+		}
+		if s.Proof.Start() != int(p.Index) || s.Proof.End() != int(p.Index)+1 {
+			return fmt.Errorf("proof of share %d is for range [%d, %d), expected [%d, %d)", s.Index, s.Proof.Start(), s.Proof.End(), p.Index, p.Index+1)
+		}
+		// the nmt wrapper prefixes shares outside of the original data square
+		// with the parity namespace
+		namespace := s.Share[:appconsts.NamespaceSize]
+		if s.Index >= squareSize || p.Index >= squareSize {
+			namespace = appns.ParitySharesNamespace.Bytes()
+		}
+		if !s.Proof.VerifyInclusion(appconsts.NewBaseHashFunc(), namespace, [][]byte{s.Share}, orthogonalRoots[s.Index]) {
+			return fmt.Errorf("share %d is not included in the orthogonal %s", s.Index, orthogonalAxis(p.Axis))
+		}
+		axisShares[s.Index] = s.Share
+	}
+
+	// decode the row or column from the proven shares and extend it again to
+	// obtain the only valid encoding that they can be part of
+	codec := appconsts.DefaultCodec()
+	decoded, err := codec.Decode(append([][]byte(nil), axisShares...))
+	if err != nil {
+		return fmt.Errorf("decoding %s %d: %w", p.Axis, p.Index, err)
+	}
+	parity, err := codec.Encode(decoded[:squareSize])
+	if err != nil {
+		return err
+	}
+	rebuilt := append(decoded[:squareSize:squareSize], parity...)
+
+	for i, share := range axisShares {
+		if share != nil && !bytes.Equal(share, rebuilt[i]) {
+			// the proven shares are not part of a single codeword
+			return nil
+		}
+	}
+
+	tree := wrapper.NewErasuredNamespacedMerkleTree(uint64(squareSize), p.Index)
+	for _, share := range rebuilt {
+		if err := tree.Push(share); err != nil {
+			// the rebuilt shares are not ordered by namespace so no valid
+			// root can commit to them
+			return nil
+		}
+	}
+	root, err := tree.Root()
+	if err != nil {
+		return err
+	}
+	if bytes.Equal(root, axisRoots[p.Index]) {
+		return fmt.Errorf("%s %d is correctly encoded", p.Axis, p.Index)
+	}
+	return nil
+}
+
+// ToProto converts the proof to its protobuf representation.
+func (p BadEncodingProof) ToProto() *daproto.BadEncodingProof {
+	pb := &daproto.BadEncodingProof{
+		Axis:   daproto.Axis(p.Axis),
+		Index:  uint32(p.Index),
+		Shares: make([]*daproto.ShareWithProof, len(p.Shares)),
+	}
+	for i, s := range p.Shares {
+		pb.Shares[i] = &daproto.ShareWithProof{
+			Index: uint32(s.Index),
+			Share: s.Share,
+			Proof: &tmproto.NMTProof{
+				Start: int32(s.Proof.Start()),
+				End:   int32(s.Proof.End()),
+				Nodes: s.Proof.Nodes(),
+			},
+		}
+	}
+	return pb
+}
+
+// BadEncodingProofFromProto converts the protobuf representation of a proof
+// back into a BadEncodingProof.
+func BadEncodingProofFromProto(pb *daproto.BadEncodingProof) (BadEncodingProof, error) {
+	if pb == nil {
+		return BadEncodingProof{}, errors.New("nil bad encoding proof")
+	}
+	p := BadEncodingProof{
+		Axis:   rsmt2d.Axis(pb.Axis),
+		Index:  uint(pb.Index),
+		Shares: make([]ShareWithProof, len(pb.Shares)),
+	}
+	for i, s := range pb.Shares {
+		if s == nil || s.Proof == nil {
+			return BadEncodingProof{}, fmt.Errorf("nil proof for share %d", i)
+		}
+		p.Shares[i] = ShareWithProof{
+			Index: uint(s.Index),
+			Share: s.Share,
+			Proof: nmt.NewInclusionProof(int(s.Proof.Start), int(s.Proof.End), s.Proof.Nodes, true),
+		}
+	}
+	return p, nil
+}
+
+func getAxis(eds *rsmt2d.ExtendedDataSquare, axis rsmt2d.Axis, index uint) [][]byte {
+	if axis == rsmt2d.Row {
+		return eds.Row(index)
+	}
+	return eds.Col(index)
+}
+
+func orthogonalAxis(axis rsmt2d.Axis) rsmt2d.Axis {
+	if axis == rsmt2d.Row {
+		return rsmt2d.Col
+	}
+	return rsmt2d.Row
+}
+
+// isComplete returns true if none of the shares are missing. Missing shares
+// are nil in the square itself but empty in the copies returned by rsmt2d.
+func isComplete(shares [][]byte) bool {
+	for _, share := range shares {
+		if len(share) == 0 {
+			return false
+		}
+	}
+	return true
+}
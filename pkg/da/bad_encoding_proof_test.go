@@ -0,0 +1,193 @@
+package da
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/celestiaorg/rsmt2d"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/celestiaorg/celestia-app/pkg/appconsts"
+	appns "github.com/celestiaorg/celestia-app/pkg/namespace"
+	"github.com/celestiaorg/celestia-app/pkg/wrapper"
+)
+
+// newByzantineSquare returns the shares of an extended data square in which the
+// parity share at (row, col) was replaced after the square was extended, along
+// with the data availability header committing to the corrupted square.
+func newByzantineSquare(t *testing.T, squareSize int, row, col uint) ([][]byte, DataAvailabilityHeader) {
+	eds, err := ExtendShares(generateShares(squareSize * squareSize))
+	require.NoError(t, err)
+	flattened := eds.Flattened()
+	corrupted := make([]byte, appconsts.ShareSize)
+	copy(corrupted, flattened[row*eds.Width()+col])
+	corrupted[appconsts.ShareSize-1] ^= 0xFF
+	flattened[row*eds.Width()+col] = corrupted
+
+	byzantine, err := rsmt2d.ImportExtendedDataSquare(flattened, appconsts.DefaultCodec(), wrapper.NewConstructor(uint64(squareSize)))
+	require.NoError(t, err)
+	return byzantine.Flattened(), NewDataAvailabilityHeader(byzantine)
+}
+
+// repair imports the shares of an extended data square and repairs it against
+// dah, returning the partially repaired square and the byzantine data error.
+func repair(t *testing.T, squareSize int, flattened [][]byte, dah DataAvailabilityHeader) (*rsmt2d.ExtendedDataSquare, *rsmt2d.ErrByzantineData) {
+	eds, err := rsmt2d.ImportExtendedDataSquare(flattened, appconsts.DefaultCodec(), wrapper.NewConstructor(uint64(squareSize)))
+	require.NoError(t, err)
+	err = eds.Repair(dah.RowRoots, dah.ColumnRoots)
+	var byzErr *rsmt2d.ErrByzantineData
+	require.True(t, errors.As(err, &byzErr), err)
+	return eds, byzErr
+}
+
+func TestBadEncodingProof(t *testing.T) {
+	squareSize := 4
+	flattened, dah := newByzantineSquare(t, squareSize, 1, 6)
+
+	type test struct {
+		name string
+		// missing are the indexes of the shares of the flattened square that
+		// are missing before repairing it
+		missing []int
+	}
+	tests := []test{
+		{"complete square", nil},
+		{"incomplete square", []int{0, 9, 63}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			available := make([][]byte, len(flattened))
+			copy(available, flattened)
+			for _, i := range tt.missing {
+				available[i] = nil
+			}
+			eds, byzErr := repair(t, squareSize, available, dah)
+
+			proof, err := NewBadEncodingProof(eds, byzErr)
+			require.NoError(t, err)
+			assert.Len(t, proof.Shares, squareSize)
+			require.NoError(t, proof.Validate(&dah))
+
+			// the proof survives a round trip through protobuf
+			decoded, err := BadEncodingProofFromProto(proof.ToProto())
+			require.NoError(t, err)
+			require.NoError(t, decoded.Validate(&dah))
+		})
+	}
+}
+
+func TestBadEncodingProofInvalid(t *testing.T) {
+	squareSize := 4
+	flattened, dah := newByzantineSquare(t, squareSize, 1, 6)
+	eds, byzErr := repair(t, squareSize, flattened, dah)
+
+	honest, err := ExtendShares(generateShares(squareSize * squareSize))
+	require.NoError(t, err)
+	honestDAH := NewDataAvailabilityHeader(honest)
+
+	type test struct {
+		name   string
+		dah    DataAvailabilityHeader
+		modify func(p *BadEncodingProof)
+	}
+	tests := []test{
+		{
+			name:   "different data availability header",
+			dah:    honestDAH,
+			modify: func(p *BadEncodingProof) {},
+		},
+		{
+			name: "too few shares",
+			dah:  dah,
+			modify: func(p *BadEncodingProof) {
+				p.Shares = p.Shares[1:]
+			},
+		},
+		{
+			name: "duplicate share",
+			dah:  dah,
+			modify: func(p *BadEncodingProof) {
+				p.Shares[1] = p.Shares[0]
+			},
+		},
+		{
+			name: "modified share",
+			dah:  dah,
+			modify: func(p *BadEncodingProof) {
+				share := make([]byte, appconsts.ShareSize)
+				copy(share, p.Shares[0].Share)
+				share[appconsts.ShareSize-1] ^= 0xFF
+				p.Shares[0].Share = share
+			},
+		},
+		{
+			name: "index out of bounds",
+			dah:  dah,
+			modify: func(p *BadEncodingProof) {
+				p.Index = uint(len(dah.RowRoots))
+			},
+		},
+		{
+			name: "invalid axis",
+			dah:  dah,
+			modify: func(p *BadEncodingProof) {
+				p.Axis = 2
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			proof, err := NewBadEncodingProof(eds, byzErr)
+			require.NoError(t, err)
+			tt.modify(&proof)
+			assert.Error(t, proof.Validate(&tt.dah))
+		})
+	}
+
+	t.Run("correctly encoded axis", func(t *testing.T) {
+		proof, err := NewBadEncodingProof(honest, &rsmt2d.ErrByzantineData{Axis: rsmt2d.Row, Index: 2})
+		require.NoError(t, err)
+		assert.Error(t, proof.Validate(&honestDAH))
+	})
+}
+
+// TestBadEncodingProofUnorderedAxis checks that an axis whose shares are not
+// ordered by namespace is proven to be badly encoded, since no valid root can
+// commit to it.
+func TestBadEncodingProofUnorderedAxis(t *testing.T) {
+	squareSize := 2
+	sorted := make([][]byte, squareSize*squareSize)
+	for i := range sorted {
+		sorted[i] = generateShare(appns.MustNewV0(bytes.Repeat([]byte{byte(i + 1)}, appns.NamespaceVersionZeroIDSize)).Bytes())
+	}
+	// the columns are ordered but the first row is not
+	shares := [][]byte{sorted[2], sorted[0], sorted[3], sorted[1]}
+	eds, err := rsmt2d.ComputeExtendedDataSquare(shares, appconsts.DefaultCodec(), wrapper.NewConstructor(uint64(squareSize)))
+	require.NoError(t, err)
+
+	width := eds.Width()
+	dah := DataAvailabilityHeader{
+		RowRoots:    make([][]byte, width),
+		ColumnRoots: make([][]byte, width),
+	}
+	for i := uint(0); i < width; i++ {
+		tree := wrapper.NewErasuredNamespacedMerkleTree(uint64(squareSize), i)
+		for _, share := range eds.Col(i) {
+			require.NoError(t, tree.Push(share))
+		}
+		dah.ColumnRoots[i], err = tree.Root()
+		require.NoError(t, err)
+	}
+	// the row roots of the unordered rows can't be computed so commit to
+	// arbitrary but well formed roots instead
+	for i := range dah.RowRoots {
+		dah.RowRoots[i] = dah.ColumnRoots[i]
+	}
+	require.NoError(t, dah.ValidateBasic())
+
+	proof, err := NewBadEncodingProof(eds, &rsmt2d.ErrByzantineData{Axis: rsmt2d.Row, Index: 0})
+	require.NoError(t, err)
+	assert.NoError(t, proof.Validate(&dah))
+}
@@ -52,7 +52,34 @@ func NewDataAvailabilityHeader(eds *rsmt2d.ExtendedDataSquare) DataAvailabilityH
 	return dah
 }
 
-func ExtendShares(s [][]byte) (*rsmt2d.ExtendedDataSquare, error) {
+// ExtendOption configures how the extended data square of ExtendShares
+// computes its roots.
+type ExtendOption func(*extendConfig)
+
+type extendConfig struct {
+	workers int
+}
+
+// WithWorkers bounds the number of row and column trees of the extended data
+// square that are hashed in parallel to workers, for example to the number of
+// available CPUs, once its roots are computed, e.g. by
+// NewDataAvailabilityHeader. The roots don't depend on it. By default the
+// number of trees is unbounded.
+func WithWorkers(workers int) ExtendOption {
+	return func(cfg *extendConfig) {
+		cfg.workers = workers
+	}
+}
+
+// ExtendShares erasure codes the shares of an original data square into an
+// extended data square whose row and column roots are computed with the nmt
+// wrapper.
+func ExtendShares(s [][]byte, opts ...ExtendOption) (*rsmt2d.ExtendedDataSquare, error) {
+	var cfg extendConfig
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
 	// Check that the length of the square is a power of 2.
 	if !shares.IsPowerOfTwo(len(s)) {
 		return nil, fmt.Errorf("number of shares is not a power of 2: got %d", len(s))
@@ -61,7 +88,7 @@ func ExtendShares(s [][]byte) (*rsmt2d.ExtendedDataSquare, error) {
 
 	// here we construct a tree
 	// Note: uses the nmt wrapper to construct the tree.
-	return rsmt2d.ComputeExtendedDataSquare(s, appconsts.DefaultCodec(), wrapper.NewConstructor(uint64(squareSize)))
+	return rsmt2d.ComputeExtendedDataSquare(s, appconsts.DefaultCodec(), wrapper.NewBoundedConstructor(uint64(squareSize), cfg.workers))
 }
 
 // String returns hex representation of merkle hash of the DAHeader.
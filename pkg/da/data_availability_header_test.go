@@ -2,6 +2,8 @@ package da
 
 import (
 	"bytes"
+	"fmt"
+	"runtime"
 	"sort"
 	"strings"
 	"testing"
@@ -97,6 +99,47 @@ func TestExtendShares(t *testing.T) {
 	}
 }
 
+func TestExtendSharesWithWorkers(t *testing.T) {
+	for _, squareSize := range []int{1, 4, 16} {
+		s := generateShares(squareSize * squareSize)
+		eds, err := ExtendShares(s)
+		require.NoError(t, err)
+		want := NewDataAvailabilityHeader(eds)
+
+		for _, workers := range []int{1, 2, runtime.NumCPU()} {
+			eds, err := ExtendShares(s, WithWorkers(workers))
+			require.NoError(t, err)
+			got := NewDataAvailabilityHeader(eds)
+			assert.Equal(t, want.RowRoots, got.RowRoots, "square size %d workers %d", squareSize, workers)
+			assert.Equal(t, want.ColumnRoots, got.ColumnRoots, "square size %d workers %d", squareSize, workers)
+			assert.Equal(t, want.Hash(), got.Hash(), "square size %d workers %d", squareSize, workers)
+		}
+	}
+}
+
+// BenchmarkExtendShares measures the time to erasure code a square and compute
+// its data availability header for different numbers of workers, where zero
+// workers means that the number of trees hashed in parallel is unbounded.
+func BenchmarkExtendShares(b *testing.B) {
+	workerCounts := []int{0, 1}
+	if runtime.NumCPU() > 1 {
+		workerCounts = append(workerCounts, runtime.NumCPU())
+	}
+	for _, squareSize := range []int{8, 32, 64, 128} {
+		s := generateShares(squareSize * squareSize)
+		for _, workers := range workerCounts {
+			b.Run(fmt.Sprintf("squareSize=%d/workers=%d", squareSize, workers), func(b *testing.B) {
+				b.ReportAllocs()
+				for i := 0; i < b.N; i++ {
+					eds, err := ExtendShares(s, WithWorkers(workers))
+					require.NoError(b, err)
+					NewDataAvailabilityHeader(eds)
+				}
+			})
+		}
+	}
+}
+
 func TestDataAvailabilityHeaderProtoConversion(t *testing.T) {
 	type test struct {
 		name string
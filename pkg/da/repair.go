@@ -0,0 +1,124 @@
+package da
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/celestiaorg/rsmt2d"
+
+	"github.com/celestiaorg/celestia-app/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/pkg/wrapper"
+)
+
+// Coord is the position of a share in an extended data square.
+type Coord struct {
+	Row uint
+	Col uint
+}
+
+// RepairError is returned by Repair if the extended data square can't be
+// repaired from the provided shares.
+type RepairError struct {
+	// Missing are the coordinates of the shares that are still missing after
+	// repairing as much of the square as possible.
+	Missing []Coord
+	// Inconsistent are the coordinates of the provided shares of the row or
+	// column that doesn't match its root in the data availability header.
+	Inconsistent []Coord
+	// Err is the underlying rsmt2d error.
+	Err error
+}
+
+func (e *RepairError) Error() string {
+	return fmt.Sprintf("repairing extended data square: %v (%d shares missing, %d shares inconsistent)", e.Err, len(e.Missing), len(e.Inconsistent))
+}
+
+func (e *RepairError) Unwrap() error {
+	return e.Err
+}
+
+// Repair reconstructs the extended data square committed to by dah from a
+// subset of its shares keyed by their coordinates. The repaired square is
+// verified against the row and column roots of dah. If there aren't enough
+// shares to repair the square, or if a row or column that is repaired doesn't
+// match its root, a *RepairError is returned. In the latter case the error
+// wraps an *rsmt2d.ErrByzantineData and the partially repaired square is
+// returned too, so that a BadEncodingProof can be built from them.
+func Repair(dah *DataAvailabilityHeader, partialShares map[Coord][]byte) (*rsmt2d.ExtendedDataSquare, error) {
+	if err := dah.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	width := uint(len(dah.RowRoots))
+
+	flattened := make([][]byte, width*width)
+	for coord, share := range partialShares {
+		if coord.Row >= width || coord.Col >= width {
+			return nil, fmt.Errorf("coordinate (%d, %d) is out of bounds for a square of width %d", coord.Row, coord.Col, width)
+		}
+		if len(share) != appconsts.ShareSize {
+			return nil, fmt.Errorf("share at (%d, %d) has size %d, expected %d", coord.Row, coord.Col, len(share), appconsts.ShareSize)
+		}
+		flattened[coord.Row*width+coord.Col] = share
+	}
+
+	eds, err := rsmt2d.ImportExtendedDataSquare(flattened, appconsts.DefaultCodec(), wrapper.NewConstructor(uint64(width/2)))
+	if err != nil {
+		return nil, err
+	}
+
+	err = eds.Repair(dah.RowRoots, dah.ColumnRoots)
+	var byzErr *rsmt2d.ErrByzantineData
+	switch {
+	case errors.As(err, &byzErr):
+		return eds, &RepairError{
+			Missing:      missingCoords(eds),
+			Inconsistent: providedCoords(partialShares, byzErr.Axis, byzErr.Index),
+			Err:          err,
+		}
+	case errors.Is(err, rsmt2d.ErrUnrepairableDataSquare):
+		return nil, &RepairError{Missing: missingCoords(eds), Err: err}
+	case err != nil:
+		return nil, err
+	}
+
+	// rsmt2d checks every row and column that it repairs, this additionally
+	// covers the ones that were complete to begin with
+	repaired := NewDataAvailabilityHeader(eds)
+	if !repaired.Equals(dah) {
+		return nil, fmt.Errorf("repaired data root %X differs from the expected data root %X", repaired.Hash(), dah.Hash())
+	}
+	return eds, nil
+}
+
+// missingCoords returns the coordinates of the shares that are missing in eds
+// in row major order.
+func missingCoords(eds *rsmt2d.ExtendedDataSquare) []Coord {
+	var missing []Coord
+	for row := uint(0); row < eds.Width(); row++ {
+		for col, share := range eds.Row(row) {
+			if len(share) == 0 {
+				missing = append(missing, Coord{Row: row, Col: uint(col)})
+			}
+		}
+	}
+	return missing
+}
+
+// providedCoords returns the coordinates of the provided shares that lie on
+// the row or column at index in row major order.
+func providedCoords(partialShares map[Coord][]byte, axis rsmt2d.Axis, index uint) []Coord {
+	var coords []Coord
+	for coord := range partialShares {
+		if (axis == rsmt2d.Row && coord.Row == index) || (axis == rsmt2d.Col && coord.Col == index) {
+			coords = append(coords, coord)
+		}
+	}
+	sort.Slice(coords, func(i, j int) bool {
+		if coords[i].Row != coords[j].Row {
+			return coords[i].Row < coords[j].Row
+		}
+		return coords[i].Col < coords[j].Col
+	})
+	return coords
+}
@@ -0,0 +1,98 @@
+package da
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/celestiaorg/rsmt2d"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// toPartialShares keys the shares of a flattened extended data square by their
+// coordinates, keeping only the ones for which keep returns true.
+func toPartialShares(flattened [][]byte, keep func(Coord) bool) map[Coord][]byte {
+	width := uint(math.Sqrt(float64(len(flattened))))
+	partialShares := make(map[Coord][]byte)
+	for i, share := range flattened {
+		coord := Coord{Row: uint(i) / width, Col: uint(i) % width}
+		if keep(coord) {
+			partialShares[coord] = share
+		}
+	}
+	return partialShares
+}
+
+func TestRepair(t *testing.T) {
+	squareSize := uint(4)
+	eds, err := ExtendShares(generateShares(int(squareSize * squareSize)))
+	require.NoError(t, err)
+	dah := NewDataAvailabilityHeader(eds)
+
+	type test struct {
+		name string
+		keep func(Coord) bool
+	}
+	tests := []test{
+		{"all shares", func(Coord) bool { return true }},
+		{"original data square", func(c Coord) bool { return c.Row < squareSize && c.Col < squareSize }},
+		{"parity data square", func(c Coord) bool { return c.Row >= squareSize && c.Col >= squareSize }},
+		{"first half of each row", func(c Coord) bool { return c.Col%2 == 0 }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repaired, err := Repair(&dah, toPartialShares(eds.Flattened(), tt.keep))
+			require.NoError(t, err)
+			assert.Equal(t, eds.Flattened(), repaired.Flattened())
+		})
+	}
+
+	t.Run("not enough shares", func(t *testing.T) {
+		missing := Coord{Row: 1, Col: 2}
+		partialShares := toPartialShares(eds.Flattened(), func(c Coord) bool {
+			return c.Row < squareSize && c.Col < squareSize && c != missing
+		})
+		_, err := Repair(&dah, partialShares)
+		var repairErr *RepairError
+		require.True(t, errors.As(err, &repairErr), err)
+		assert.ErrorIs(t, err, rsmt2d.ErrUnrepairableDataSquare)
+		assert.Contains(t, repairErr.Missing, missing)
+		assert.Empty(t, repairErr.Inconsistent)
+	})
+
+	t.Run("invalid coordinate", func(t *testing.T) {
+		partialShares := toPartialShares(eds.Flattened(), func(Coord) bool { return true })
+		partialShares[Coord{Row: 2 * squareSize, Col: 0}] = partialShares[Coord{}]
+		_, err := Repair(&dah, partialShares)
+		assert.Error(t, err)
+	})
+
+	t.Run("invalid share size", func(t *testing.T) {
+		partialShares := toPartialShares(eds.Flattened(), func(Coord) bool { return true })
+		partialShares[Coord{}] = partialShares[Coord{}][1:]
+		_, err := Repair(&dah, partialShares)
+		assert.Error(t, err)
+	})
+}
+
+func TestRepairByzantineSquare(t *testing.T) {
+	squareSize := 4
+	flattened, dah := newByzantineSquare(t, squareSize, 1, 6)
+
+	eds, err := Repair(&dah, toPartialShares(flattened, func(Coord) bool { return true }))
+	var repairErr *RepairError
+	require.True(t, errors.As(err, &repairErr), err)
+	var byzErr *rsmt2d.ErrByzantineData
+	require.True(t, errors.As(err, &byzErr), err)
+	assert.Empty(t, repairErr.Missing)
+	assert.Len(t, repairErr.Inconsistent, 2*squareSize)
+	// the corrupted share lies on both the byzantine row and column
+	assert.Contains(t, repairErr.Inconsistent, Coord{Row: 1, Col: 6})
+
+	// the partially repaired square can be used to prove the bad encoding
+	require.NotNil(t, eds)
+	proof, err := NewBadEncodingProof(eds, byzErr)
+	require.NoError(t, err)
+	require.NoError(t, proof.Validate(&dah))
+}
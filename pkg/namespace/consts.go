@@ -40,7 +40,9 @@ var (
 	TxNamespace = MustNewV0([]byte{0, 0, 0, 0, 0, 0, 0, 0, 0, 1})
 
 	// IntermediateStateRootsNamespace is the namespace reserved for
-	// intermediate state root data.
+	// intermediate state root data. No square contains it yet, as the state
+	// of the SDK is only hashed when a block is committed, so the state root
+	// after each transaction isn't available.
 	IntermediateStateRootsNamespace = MustNewV0([]byte{0, 0, 0, 0, 0, 0, 0, 0, 0, 2})
 
 	// PayForBlobNamespace is the namespace reserved for PayForBlobs transactions.
@@ -0,0 +1,208 @@
+package proof
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	"github.com/celestiaorg/celestia-app/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/pkg/da"
+	"github.com/celestiaorg/celestia-app/pkg/inclusion"
+	"github.com/celestiaorg/celestia-app/pkg/shares"
+	"github.com/celestiaorg/celestia-app/pkg/square"
+	proofproto "github.com/celestiaorg/celestia-app/proto/celestia/proof"
+	blobtypes "github.com/celestiaorg/celestia-app/x/blob/types"
+	"github.com/celestiaorg/rsmt2d"
+	"github.com/tendermint/tendermint/crypto/merkle"
+	"github.com/tendermint/tendermint/types"
+)
+
+// BlobInclusionProof proves the inclusion of a blob in the data root and ties
+// the shares of the blob to the share commitment in the MsgPayForBlobs that
+// paid for it.
+type BlobInclusionProof struct {
+	// ShareProof proves the inclusion of the shares of the blob in the data
+	// root.
+	ShareProof types.ShareProof
+	// SubtreeRoots are the roots of the subtrees over the shares of the blob as
+	// defined by the share commitment rules.
+	SubtreeRoots [][]byte
+	// ShareCommitment is the Merkle root of the SubtreeRoots.
+	ShareCommitment []byte
+	// ShareRange is the range of shares the blob occupies in the data square.
+	ShareRange shares.Range
+}
+
+// NewBlobInclusionProof returns a proof of the inclusion of the blob at
+// blobIndex of the PFB transaction with the provided hash. The subtree roots
+// of the share commitment are re-derived from the data square.
+func NewBlobInclusionProof(txs [][]byte, txHash []byte, blobIndex int, appVersion uint64) (BlobInclusionProof, error) {
+	txIndex := types.ToTxs(txs).IndexByHash(txHash)
+	if txIndex < 0 {
+		return BlobInclusionProof{}, fmt.Errorf("tx with hash %X not found", txHash)
+	}
+
+	builder, err := square.NewBuilder(appconsts.SquareSizeUpperBound(appVersion), appconsts.SubtreeRootThreshold(appVersion), txs...)
+	if err != nil {
+		return BlobInclusionProof{}, err
+	}
+	dataSquare, err := builder.Export()
+	if err != nil {
+		return BlobInclusionProof{}, err
+	}
+	start, err := builder.FindBlobStartingIndex(txIndex, blobIndex)
+	if err != nil {
+		return BlobInclusionProof{}, err
+	}
+	blobLen, err := builder.BlobShareLength(txIndex, blobIndex)
+	if err != nil {
+		return BlobInclusionProof{}, err
+	}
+	shareRange := shares.NewRange(start, start+blobLen)
+
+	namespace, err := dataSquare[start].Namespace()
+	if err != nil {
+		return BlobInclusionProof{}, err
+	}
+
+	// the subtree roots are cached while the roots of the extended data square
+	// are computed for the share proof
+	cacher := inclusion.NewSubtreeCacher(uint64(dataSquare.Size()))
+	eds, err := rsmt2d.ComputeExtendedDataSquare(shares.ToBytes(dataSquare), appconsts.DefaultCodec(), cacher.Constructor)
+	if err != nil {
+		return BlobInclusionProof{}, err
+	}
+	shareProof, err := newShareInclusionProofFromEDS(eds, namespace, shareRange)
+	if err != nil {
+		return BlobInclusionProof{}, err
+	}
+	dah := da.NewDataAvailabilityHeader(eds)
+	subtreeRoots, err := inclusion.GetSubtreeRoots(cacher, dah, start, blobLen, appconsts.SubtreeRootThreshold(appVersion))
+	if err != nil {
+		return BlobInclusionProof{}, err
+	}
+
+	return BlobInclusionProof{
+		ShareProof:      shareProof,
+		SubtreeRoots:    subtreeRoots,
+		ShareCommitment: merkle.HashFromByteSlices(subtreeRoots),
+		ShareRange:      shareRange,
+	}, nil
+}
+
+// Validate checks that the shares of the blob are included in the data root
+// and that they match the provided share commitment, which is expected to be
+// taken from the MsgPayForBlobs.
+func (p BlobInclusionProof) Validate(dataRoot []byte, shareCommitment []byte) error {
+	if err := p.ShareProof.Validate(dataRoot); err != nil {
+		return err
+	}
+	shareRange, err := shareProofRange(p.ShareProof)
+	if err != nil {
+		return err
+	}
+	if shareRange != p.ShareRange {
+		return fmt.Errorf("share range mismatch: expected %v, got %v", shareRange, p.ShareRange)
+	}
+	if !bytes.Equal(p.ShareCommitment, merkle.HashFromByteSlices(p.SubtreeRoots)) {
+		return errors.New("share commitment is not the root of the subtree roots")
+	}
+	if !bytes.Equal(p.ShareCommitment, shareCommitment) {
+		return fmt.Errorf("share commitment mismatch: expected %X, got %X", shareCommitment, p.ShareCommitment)
+	}
+
+	// recompute the share commitment from the proven shares so that they are
+	// tied to the subtree roots
+	blobShares, err := shares.FromBytes(p.ShareProof.Data)
+	if err != nil {
+		return err
+	}
+	blobs, err := shares.ParseBlobs(blobShares)
+	if err != nil {
+		return err
+	}
+	if len(blobs) != 1 {
+		return fmt.Errorf("expected the proven shares to contain a single blob, got %d", len(blobs))
+	}
+	signer, err := blobShares[0].Signer()
+	if err != nil {
+		return err
+	}
+	commitment, err := blobtypes.CreateCommitmentWithSigner(&blobtypes.Blob{
+		NamespaceId:      blobs[0].NamespaceID,
+		Data:             blobs[0].Data,
+		ShareVersion:     uint32(blobs[0].ShareVersion),
+		NamespaceVersion: uint32(blobs[0].NamespaceVersion),
+	}, signer)
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(commitment, p.ShareCommitment) {
+		return errors.New("proven shares do not match the share commitment")
+	}
+	return nil
+}
+
+// shareProofRange returns the range of shares of the original data square that
+// is covered by a validated share proof. The proven shares must be contiguous
+// and the width of the data square is taken from the proofs of the rows to the
+// data root, which commits to the roots of every row and column of the
+// extended data square.
+func shareProofRange(p types.ShareProof) (shares.Range, error) {
+	rowProofs := p.RowProof.Proofs
+	if len(rowProofs) == 0 || len(rowProofs) != len(p.ShareProofs) {
+		return shares.Range{}, errors.New("share proof has no rows")
+	}
+	squareSize := rowProofs[0].Total / 4
+	if squareSize == 0 || rowProofs[0].Total%4 != 0 {
+		return shares.Range{}, fmt.Errorf("invalid number of row and column roots: %d", rowProofs[0].Total)
+	}
+	for i, rowProof := range rowProofs {
+		if rowProof.Total != rowProofs[0].Total || rowProof.Index != int64(p.RowProof.StartRow)+int64(i) {
+			return shares.Range{}, fmt.Errorf("row proof %d is not a proof of row %d", i, int64(p.RowProof.StartRow)+int64(i))
+		}
+		shareProof := p.ShareProofs[i]
+		if int64(shareProof.End) > squareSize {
+			return shares.Range{}, fmt.Errorf("share proof %d covers parity shares", i)
+		}
+		if i > 0 && shareProof.Start != 0 {
+			return shares.Range{}, fmt.Errorf("share proof %d doesn't start at the beginning of its row", i)
+		}
+		if i < len(rowProofs)-1 && int64(shareProof.End) != squareSize {
+			return shares.Range{}, fmt.Errorf("share proof %d doesn't end at the end of its row", i)
+		}
+	}
+	start := int64(p.RowProof.StartRow)*squareSize + int64(p.ShareProofs[0].Start)
+	end := int64(p.RowProof.EndRow)*squareSize + int64(p.ShareProofs[len(p.ShareProofs)-1].End)
+	return shares.NewRange(int(start), int(end)), nil
+}
+
+// ToProto converts the proof to its protobuf representation.
+func (p BlobInclusionProof) ToProto() proofproto.BlobInclusionProof {
+	shareProof := p.ShareProof.ToProto()
+	return proofproto.BlobInclusionProof{
+		ShareProof:      &shareProof,
+		SubtreeRoots:    p.SubtreeRoots,
+		ShareCommitment: p.ShareCommitment,
+		StartShare:      uint32(p.ShareRange.Start),
+		EndShare:        uint32(p.ShareRange.End),
+	}
+}
+
+// BlobInclusionProofFromProto converts the protobuf representation of a proof
+// back into a BlobInclusionProof.
+func BlobInclusionProofFromProto(pb *proofproto.BlobInclusionProof) (BlobInclusionProof, error) {
+	if pb == nil || pb.ShareProof == nil {
+		return BlobInclusionProof{}, errors.New("nil blob inclusion proof")
+	}
+	shareProof, err := types.ShareProofFromProto(*pb.ShareProof)
+	if err != nil {
+		return BlobInclusionProof{}, err
+	}
+	return BlobInclusionProof{
+		ShareProof:      shareProof,
+		SubtreeRoots:    pb.SubtreeRoots,
+		ShareCommitment: pb.ShareCommitment,
+		ShareRange:      shares.NewRange(int(pb.StartShare), int(pb.EndShare)),
+	}, nil
+}
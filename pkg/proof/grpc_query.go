@@ -0,0 +1,118 @@
+package proof
+
+import (
+	"context"
+
+	"github.com/celestiaorg/celestia-app/pkg/appconsts"
+	appns "github.com/celestiaorg/celestia-app/pkg/namespace"
+	"github.com/celestiaorg/celestia-app/pkg/shares"
+	"github.com/celestiaorg/celestia-app/pkg/square"
+	proofproto "github.com/celestiaorg/celestia-app/proto/celestia/proof"
+	"github.com/cosmos/cosmos-sdk/client"
+	gogogrpc "github.com/gogo/protobuf/grpc"
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var _ proofproto.QueryServer = queryServer{}
+
+// queryServer implements the proof Query service. As the application does
+// not store blocks, they are fetched from the node the client context is
+// connected to.
+type queryServer struct {
+	clientCtx client.Context
+}
+
+// NewQueryServer creates a new proof query server.
+func NewQueryServer(clientCtx client.Context) proofproto.QueryServer {
+	return queryServer{clientCtx: clientCtx}
+}
+
+// RegisterQueryService registers the proof query service on the given gRPC
+// server.
+func RegisterQueryService(clientCtx client.Context, server gogogrpc.Server) {
+	proofproto.RegisterQueryServer(server, NewQueryServer(clientCtx))
+}
+
+// RegisterGRPCGatewayRoutes mounts the proof query service's gRPC-gateway
+// routes on the given Mux.
+func RegisterGRPCGatewayRoutes(clientConn gogogrpc.ClientConn, mux *runtime.ServeMux) {
+	_ = proofproto.RegisterQueryHandlerClient(context.Background(), mux, proofproto.NewQueryClient(clientConn))
+}
+
+// NamespaceData implements the Query/NamespaceData gRPC method.
+func (s queryServer) NamespaceData(ctx context.Context, req *proofproto.QueryNamespaceDataRequest) (*proofproto.QueryNamespaceDataResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if req.Height <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "height %d must be strictly positive", req.Height)
+	}
+	namespace, err := appns.From(req.Namespace)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	node, err := s.clientCtx.GetNode()
+	if err != nil {
+		return nil, err
+	}
+	height := req.Height
+	res, err := node.Block(ctx, &height)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := NewNamespaceData(res.Block.Data.Txs.ToSliceOfBytes(), res.Block.Header.Version.App, namespace)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	resp.DataRoot = res.Block.DataHash
+	return resp, nil
+}
+
+// NewNamespaceData reconstructs the data square from the block's
+// transactions and returns the proof of the namespace's shares along with the
+// blobs parsed from them. As we don't have access to the application's state
+// machine we use the upper bound square size instead of the square size
+// dictated from governance.
+func NewNamespaceData(txs [][]byte, appVersion uint64, namespace appns.Namespace) (*proofproto.QueryNamespaceDataResponse, error) {
+	dataSquare, err := square.Construct(txs, appVersion, appconsts.SquareSizeUpperBound(appVersion))
+	if err != nil {
+		return nil, err
+	}
+
+	shareProof, err := NewNamespaceProof(dataSquare, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	var blobs []*tmproto.Blob
+	if !namespace.IsReserved() && len(shareProof.Data) > 0 {
+		nsShares, err := shares.FromBytes(shareProof.Data)
+		if err != nil {
+			return nil, err
+		}
+		parsedBlobs, err := shares.ParseBlobs(nsShares)
+		if err != nil {
+			return nil, err
+		}
+		blobs = make([]*tmproto.Blob, len(parsedBlobs))
+		for i, blob := range parsedBlobs {
+			blobs[i] = &tmproto.Blob{
+				NamespaceId:      blob.NamespaceID,
+				Data:             blob.Data,
+				ShareVersion:     uint32(blob.ShareVersion),
+				NamespaceVersion: uint32(blob.NamespaceVersion),
+			}
+		}
+	}
+
+	pShareProof := shareProof.ToProto()
+	return &proofproto.QueryNamespaceDataResponse{
+		Proof: &pShareProof,
+		Blobs: blobs,
+	}, nil
+}
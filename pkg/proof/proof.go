@@ -11,6 +11,7 @@ import (
 	"github.com/celestiaorg/celestia-app/pkg/shares"
 	"github.com/celestiaorg/celestia-app/pkg/square"
 	"github.com/celestiaorg/celestia-app/pkg/wrapper"
+	"github.com/celestiaorg/rsmt2d"
 	"github.com/tendermint/tendermint/crypto/merkle"
 	tmbytes "github.com/tendermint/tendermint/libs/bytes"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
@@ -59,16 +60,26 @@ func NewShareInclusionProof(
 	namespace appns.Namespace,
 	shareRange shares.Range,
 ) (types.ShareProof, error) {
-	squareSize := dataSquare.Size()
-	startRow := shareRange.Start / squareSize
-	endRow := (shareRange.End - 1) / squareSize
-	startLeaf := shareRange.Start % squareSize
-	endLeaf := (shareRange.End - 1) % squareSize
-
 	eds, err := da.ExtendShares(shares.ToBytes(dataSquare))
 	if err != nil {
 		return types.ShareProof{}, err
 	}
+	return newShareInclusionProofFromEDS(eds, namespace, shareRange)
+}
+
+// newShareInclusionProofFromEDS returns an NMT inclusion proof for a set of
+// shares belonging to the same namespace to the data root of the extended data
+// square. Expects the share range to be pre-validated.
+func newShareInclusionProofFromEDS(
+	eds *rsmt2d.ExtendedDataSquare,
+	namespace appns.Namespace,
+	shareRange shares.Range,
+) (types.ShareProof, error) {
+	squareSize := int(eds.Width() / 2)
+	startRow := shareRange.Start / squareSize
+	endRow := (shareRange.End - 1) / squareSize
+	startLeaf := shareRange.Start % squareSize
+	endLeaf := (shareRange.End - 1) % squareSize
 
 	edsRowRoots := eds.RowRoots()
 
@@ -154,3 +165,74 @@ func NewShareInclusionProof(
 		NamespaceVersion: uint32(namespace.Version),
 	}, nil
 }
+
+// NewNamespaceProof returns a proof of all the shares of the namespace in the
+// data square. If the namespace is not present in the square, the returned
+// proof contains no data and a single NMT absence proof for the row in which
+// the namespace would have been located.
+func NewNamespaceProof(dataSquare square.Square, namespace appns.Namespace) (types.ShareProof, error) {
+	shareRange, err := shares.GetShareRangeForNamespace(dataSquare, namespace)
+	if err != nil {
+		return types.ShareProof{}, err
+	}
+	if !shareRange.IsEmpty() {
+		return NewShareInclusionProof(dataSquare, namespace, shareRange)
+	}
+
+	// shares are ordered by namespace so the namespace would have been located
+	// in the row of the last share with a smaller namespace
+	squareSize := dataSquare.Size()
+	row := 0
+	for i := range dataSquare {
+		ns, err := dataSquare[i].Namespace()
+		if err != nil {
+			return types.ShareProof{}, err
+		}
+		if ns.IsGreaterThan(namespace) {
+			break
+		}
+		row = i / squareSize
+	}
+
+	eds, err := da.ExtendShares(shares.ToBytes(dataSquare))
+	if err != nil {
+		return types.ShareProof{}, err
+	}
+	edsRowRoots := eds.RowRoots()
+	_, allProofs := merkle.ProofsFromByteSlices(append(edsRowRoots, eds.ColRoots()...))
+
+	tree := wrapper.NewErasuredNamespacedMerkleTree(uint64(squareSize), uint(row))
+	for _, share := range eds.Row(uint(row)) {
+		if err := tree.Push(share); err != nil {
+			return types.ShareProof{}, err
+		}
+	}
+	root, err := tree.Root()
+	if err != nil {
+		return types.ShareProof{}, err
+	}
+	if !bytes.Equal(edsRowRoots[row], root) {
+		return types.ShareProof{}, errors.New("eds row root is different than tree root")
+	}
+	proof, err := tree.ProveNamespace(namespace)
+	if err != nil {
+		return types.ShareProof{}, err
+	}
+
+	return types.ShareProof{
+		RowProof: types.RowProof{
+			RowRoots: []tmbytes.HexBytes{edsRowRoots[row]},
+			Proofs:   []*merkle.Proof{allProofs[row]},
+			StartRow: uint32(row),
+			EndRow:   uint32(row),
+		},
+		ShareProofs: []*tmproto.NMTProof{{
+			Start:    int32(proof.Start()),
+			End:      int32(proof.End()),
+			Nodes:    proof.Nodes(),
+			LeafHash: proof.LeafHash(),
+		}},
+		NamespaceID:      namespace.ID,
+		NamespaceVersion: uint32(namespace.Version),
+	}, nil
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/celestiaorg/celestia-app/app/encoding"
 	"github.com/celestiaorg/celestia-app/test/util/blobfactory"
 	"github.com/celestiaorg/celestia-app/test/util/testfactory"
+	blobtypes "github.com/celestiaorg/celestia-app/x/blob/types"
 
 	"github.com/celestiaorg/celestia-app/pkg/da"
 	"github.com/celestiaorg/celestia-app/pkg/proof"
@@ -16,8 +17,10 @@ import (
 	"github.com/celestiaorg/celestia-app/pkg/appconsts"
 	appns "github.com/celestiaorg/celestia-app/pkg/namespace"
 	"github.com/celestiaorg/celestia-app/pkg/shares"
+	"github.com/celestiaorg/nmt"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	coretypes "github.com/tendermint/tendermint/types"
 )
 
 func TestNewTxInclusionProof(t *testing.T) {
@@ -218,3 +221,135 @@ func TestNewShareInclusionProof(t *testing.T) {
 		})
 	}
 }
+
+func TestNewNamespaceProof(t *testing.T) {
+	ns1 := appns.MustNewV0(bytes.Repeat([]byte{1}, appns.NamespaceVersionZeroIDSize))
+	ns2 := appns.MustNewV0(bytes.Repeat([]byte{2}, appns.NamespaceVersionZeroIDSize))
+	ns3 := appns.MustNewV0(bytes.Repeat([]byte{3}, appns.NamespaceVersionZeroIDSize))
+	beforeFirstNs := appns.MustNewV0(append(bytes.Repeat([]byte{1}, appns.NamespaceVersionZeroIDSize-1), 0))
+	betweenNs := appns.MustNewV0(append(bytes.Repeat([]byte{1}, appns.NamespaceVersionZeroIDSize-1), 2))
+	afterLastNs := appns.MustNewV0(bytes.Repeat([]byte{4}, appns.NamespaceVersionZeroIDSize))
+
+	encCfg := encoding.MakeConfig(app.ModuleEncodingRegisters...)
+	blobTxs := blobfactory.RandBlobTxsWithNamespaces(encCfg.TxConfig.TxEncoder(), []appns.Namespace{ns1, ns2, ns3, ns3}, []int{500, 5000, 500, 1000})
+	txs := testfactory.GenerateRandomTxs(50, 500)
+	txs = append(txs, blobTxs...)
+
+	dataSquare, err := square.Construct(txs.ToSliceOfBytes(), appconsts.LatestVersion, appconsts.SquareSizeUpperBound(appconsts.LatestVersion))
+	require.NoError(t, err)
+	eds, err := da.ExtendShares(shares.ToBytes(dataSquare))
+	require.NoError(t, err)
+	dah := da.NewDataAvailabilityHeader(eds)
+	dataRoot := dah.Hash()
+
+	t.Run("namespaces present in the square", func(t *testing.T) {
+		for _, ns := range []appns.Namespace{appns.TxNamespace, appns.PayForBlobNamespace, ns1, ns2, ns3} {
+			shareRange, err := shares.GetShareRangeForNamespace(dataSquare, ns)
+			require.NoError(t, err)
+
+			nsProof, err := proof.NewNamespaceProof(dataSquare, ns)
+			require.NoError(t, err)
+			assert.NoError(t, nsProof.Validate(dataRoot))
+			assert.Equal(t, shares.ToBytes(dataSquare[shareRange.Start:shareRange.End]), nsProof.Data)
+		}
+	})
+
+	t.Run("namespace absent from the square", func(t *testing.T) {
+		for name, absentNs := range map[string]appns.Namespace{
+			"before the first blob": beforeFirstNs,
+			"between two blobs":     betweenNs,
+			"after the last blob":   afterLastNs,
+		} {
+			nsProof, err := proof.NewNamespaceProof(dataSquare, absentNs)
+			require.NoError(t, err, name)
+			require.Empty(t, nsProof.Data, name)
+			require.NoError(t, nsProof.RowProof.Validate(dataRoot), name)
+			require.Len(t, nsProof.ShareProofs, 1, name)
+
+			pNmtProof := nsProof.ShareProofs[0]
+			require.NotEmpty(t, pNmtProof.LeafHash, name)
+			nmtProof := nmt.NewAbsenceProof(int(pNmtProof.Start), int(pNmtProof.End), pNmtProof.Nodes, pNmtProof.LeafHash, true)
+			assert.True(t, nmtProof.VerifyNamespace(appconsts.NewBaseHashFunc(), absentNs.Bytes(), nil, nsProof.RowProof.RowRoots[0]), name)
+			assert.True(t, proof.IsAbsenceProof(nsProof), name)
+			assert.NoError(t, proof.VerifyShareProof(nsProof, dataRoot), name)
+			assert.NoError(t, proof.VerifyShareProofWithDAH(nsProof, &dah), name)
+
+			// the proof doesn't prove the absence of a namespace that is
+			// present in the square
+			present := nsProof
+			present.NamespaceID = ns2.ID
+			assert.Error(t, proof.VerifyShareProof(present, dataRoot), name)
+		}
+	})
+}
+
+func TestNewNamespaceData(t *testing.T) {
+	ns1 := appns.MustNewV0(bytes.Repeat([]byte{1}, appns.NamespaceVersionZeroIDSize))
+	ns2 := appns.MustNewV0(bytes.Repeat([]byte{2}, appns.NamespaceVersionZeroIDSize))
+
+	encCfg := encoding.MakeConfig(app.ModuleEncodingRegisters...)
+	blobTxs := blobfactory.RandBlobTxsWithNamespaces(encCfg.TxConfig.TxEncoder(), []appns.Namespace{ns1, ns1, ns2}, []int{100, 2000, 100})
+	txs := coretypes.Txs(blobTxs).ToSliceOfBytes()
+
+	resp, err := proof.NewNamespaceData(txs, appconsts.LatestVersion, ns1)
+	require.NoError(t, err)
+	require.Len(t, resp.Blobs, 2)
+	for i, tx := range txs[:2] {
+		blobTx, isBlobTx := coretypes.UnmarshalBlobTx(tx)
+		require.True(t, isBlobTx)
+		assert.Equal(t, blobTx.Blobs[0].Data, resp.Blobs[i].Data)
+		assert.Equal(t, ns1.ID, resp.Blobs[i].NamespaceId)
+	}
+
+	// reserved namespaces contain no blobs
+	resp, err = proof.NewNamespaceData(txs, appconsts.LatestVersion, appns.PayForBlobNamespace)
+	require.NoError(t, err)
+	require.Empty(t, resp.Blobs)
+	require.NotEmpty(t, resp.Proof.Data)
+}
+
+func TestNewBlobInclusionProof(t *testing.T) {
+	encCfg := encoding.MakeConfig(app.ModuleEncodingRegisters...)
+	blobTxs := blobfactory.RandBlobTxs(encCfg.TxConfig.TxEncoder(), 5, 3, 2000)
+	blockTxs := append(testfactory.GenerateRandomTxs(5, 200), blobTxs...).ToSliceOfBytes()
+
+	dataSquare, err := square.Construct(blockTxs, appconsts.LatestVersion, appconsts.SquareSizeUpperBound(appconsts.LatestVersion))
+	require.NoError(t, err)
+	eds, err := da.ExtendShares(shares.ToBytes(dataSquare))
+	require.NoError(t, err)
+	dah := da.NewDataAvailabilityHeader(eds)
+
+	blobTx, isBlobTx := coretypes.UnmarshalBlobTx(blobTxs[2])
+	require.True(t, isBlobTx)
+	for blobIndex, blob := range blobTx.Blobs {
+		commitment, err := blobtypes.CreateCommitment(blob)
+		require.NoError(t, err)
+
+		blobProof, err := proof.NewBlobInclusionProof(blockTxs, blobTxs[2].Hash(), blobIndex, appconsts.LatestVersion)
+		require.NoError(t, err)
+		assert.Equal(t, commitment, blobProof.ShareCommitment)
+		assert.NoError(t, blobProof.Validate(dah.Hash(), commitment))
+
+		pbProof := blobProof.ToProto()
+		decoded, err := proof.BlobInclusionProofFromProto(&pbProof)
+		require.NoError(t, err)
+		assert.NoError(t, decoded.Validate(dah.Hash(), commitment))
+
+		// a proof must not validate against a different blob's commitment
+		otherCommitment, err := blobtypes.CreateCommitment(blobTx.Blobs[(blobIndex+1)%len(blobTx.Blobs)])
+		require.NoError(t, err)
+		assert.Error(t, blobProof.Validate(dah.Hash(), otherCommitment))
+
+		// a proof must not validate with a share range that isn't covered by
+		// its share proof
+		shifted := blobProof
+		shifted.ShareRange = shares.NewRange(blobProof.ShareRange.Start+1, blobProof.ShareRange.End+1)
+		assert.Error(t, shifted.Validate(dah.Hash(), commitment))
+	}
+
+	_, err = proof.NewBlobInclusionProof(blockTxs, []byte("unknown"), 0, appconsts.LatestVersion)
+	assert.Error(t, err)
+
+	_, err = proof.NewBlobInclusionProof(blockTxs, blobTxs[2].Hash(), len(blobTx.Blobs), appconsts.LatestVersion)
+	assert.Error(t, err)
+}
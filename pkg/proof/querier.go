@@ -2,6 +2,7 @@ package proof
 
 import (
 	"bytes"
+	"encoding/hex"
 	"fmt"
 	"strconv"
 
@@ -153,3 +154,48 @@ func ParseNamespace(rawShares []shares.Share, startShare, endShare int) (appns.N
 	}
 	return startShareNs, nil
 }
+
+const BlobInclusionQueryPath = "blobInclusionProof"
+
+// QueryBlobInclusionProof defines the logic performed when querying for the
+// inclusion proof of a blob to the data root. The hex encoded hash of the PFB
+// transaction and the index of the blob within it should be appended to the
+// path. The marshalled bytes of the blob inclusion proof
+// (proofproto.BlobInclusionProof) are returned.
+//
+// example path for proving the second blob of a PFB:
+// custom/blobInclusionProof/<tx_hash>/1
+func QueryBlobInclusionProof(_ sdk.Context, path []string, req abci.RequestQuery) ([]byte, error) {
+	// parse the tx hash and the blob index from the path
+	if len(path) != 2 {
+		return nil, fmt.Errorf("expected query path length: 2 actual: %d ", len(path))
+	}
+	txHash, err := hex.DecodeString(path[0])
+	if err != nil {
+		return nil, err
+	}
+	blobIndex, err := strconv.ParseInt(path[1], 10, 64)
+	if err != nil {
+		return nil, err
+	}
+
+	// unmarshal the block data that is passed from the ABCI client
+	pbb := new(tmproto.Block)
+	err = pbb.Unmarshal(req.Data)
+	if err != nil {
+		return nil, fmt.Errorf("error reading block: %w", err)
+	}
+
+	// create and marshal the blob inclusion proof, which we return in the form of []byte
+	blobProof, err := NewBlobInclusionProof(pbb.Data.Txs, txHash, int(blobIndex), pbb.Header.Version.App)
+	if err != nil {
+		return nil, err
+	}
+	pBlobProof := blobProof.ToProto()
+	rawBlobProof, err := pBlobProof.Marshal()
+	if err != nil {
+		return nil, err
+	}
+
+	return rawBlobProof, nil
+}
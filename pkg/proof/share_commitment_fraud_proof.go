@@ -0,0 +1,237 @@
+package proof
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	"github.com/celestiaorg/celestia-app/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/pkg/da"
+	appns "github.com/celestiaorg/celestia-app/pkg/namespace"
+	"github.com/celestiaorg/celestia-app/pkg/shares"
+	"github.com/celestiaorg/celestia-app/pkg/square"
+	proofproto "github.com/celestiaorg/celestia-app/proto/celestia/proof"
+	blobtypes "github.com/celestiaorg/celestia-app/x/blob/types"
+	"github.com/tendermint/tendermint/crypto/merkle"
+	"github.com/tendermint/tendermint/types"
+)
+
+// ShareCommitmentFraudProof proves that a blob in the data square does not
+// match the share commitment of the MsgPayForBlobs that paid for it, as
+// described in ADR-011. It consists of a proof of the PFB transaction, whose
+// IndexWrapper points to the first share of the blob, and a proof of the
+// shares of the blob. Light clients can use it to reject blocks whose blobs
+// break the share commitment rules that ProcessProposal enforces.
+//
+// Verifying the proof doesn't check the signature of the PFB transaction as
+// that requires state.
+type ShareCommitmentFraudProof struct {
+	// PFBProof proves the inclusion of the shares that contain the PFB
+	// transaction, wrapped in an IndexWrapper, in the data root.
+	PFBProof types.ShareProof
+	// BlobIndex is the index of the blob in the MsgPayForBlobs.
+	BlobIndex uint32
+	// BlobProof proves the inclusion of the shares that the IndexWrapper
+	// assigns to the blob.
+	BlobProof types.ShareProof
+	// SubtreeRoots are the roots of the subtrees over the shares of the blob
+	// as defined by the share commitment rules.
+	SubtreeRoots [][]byte
+}
+
+// NewShareCommitmentFraudProof returns a proof for the blob at blobIndex of the
+// PFB transaction at txIndex. The proof is built regardless of whether the
+// blob matches its share commitment, in which case it fails to verify.
+func NewShareCommitmentFraudProof(txs [][]byte, txIndex uint64, blobIndex uint32, appVersion uint64) (ShareCommitmentFraudProof, error) {
+	if txIndex >= uint64(len(txs)) {
+		return ShareCommitmentFraudProof{}, fmt.Errorf("txIndex %d out of bounds", txIndex)
+	}
+	if _, isBlobTx := types.UnmarshalBlobTx(txs[txIndex]); !isBlobTx {
+		return ShareCommitmentFraudProof{}, fmt.Errorf("tx %d is not a blob tx", txIndex)
+	}
+
+	builder, err := square.NewBuilder(appconsts.SquareSizeUpperBound(appVersion), appconsts.SubtreeRootThreshold(appVersion), txs...)
+	if err != nil {
+		return ShareCommitmentFraudProof{}, err
+	}
+	dataSquare, err := builder.Export()
+	if err != nil {
+		return ShareCommitmentFraudProof{}, err
+	}
+
+	pfbRange, err := builder.FindTxShareRange(int(txIndex))
+	if err != nil {
+		return ShareCommitmentFraudProof{}, err
+	}
+	pfbProof, err := NewShareInclusionProof(dataSquare, appns.PayForBlobNamespace, pfbRange)
+	if err != nil {
+		return ShareCommitmentFraudProof{}, err
+	}
+
+	start, err := builder.FindBlobStartingIndex(int(txIndex), int(blobIndex))
+	if err != nil {
+		return ShareCommitmentFraudProof{}, err
+	}
+	blobLen, err := builder.BlobShareLength(int(txIndex), int(blobIndex))
+	if err != nil {
+		return ShareCommitmentFraudProof{}, err
+	}
+	namespace, err := dataSquare[start].Namespace()
+	if err != nil {
+		return ShareCommitmentFraudProof{}, err
+	}
+	blobProof, err := NewShareInclusionProof(dataSquare, namespace, shares.NewRange(start, start+blobLen))
+	if err != nil {
+		return ShareCommitmentFraudProof{}, err
+	}
+
+	subtreeRoots, err := blobtypes.SubtreeRoots(dataSquare[start:start+blobLen], namespace)
+	if err != nil {
+		return ShareCommitmentFraudProof{}, err
+	}
+
+	return ShareCommitmentFraudProof{
+		PFBProof:     pfbProof,
+		BlobIndex:    blobIndex,
+		BlobProof:    blobProof,
+		SubtreeRoots: subtreeRoots,
+	}, nil
+}
+
+// Verify returns nil if the proof shows that the block committed to by dah
+// contains a blob that doesn't match the share commitment in the
+// MsgPayForBlobs that paid for it. An error is returned if the proof is
+// malformed or if the blob matches its share commitment.
+func (p ShareCommitmentFraudProof) Verify(dah *da.DataAvailabilityHeader) error {
+	if err := VerifyShareProofWithDAH(p.PFBProof, dah); err != nil {
+		return fmt.Errorf("verifying PFB proof: %w", err)
+	}
+	if err := VerifyShareProofWithDAH(p.BlobProof, dah); err != nil {
+		return fmt.Errorf("verifying blob proof: %w", err)
+	}
+	pfbNamespace, err := proofNamespace(p.PFBProof)
+	if err != nil {
+		return err
+	}
+	if !pfbNamespace.Equals(appns.PayForBlobNamespace) {
+		return fmt.Errorf("PFB proof is for namespace %X instead of the PFB namespace", pfbNamespace.Bytes())
+	}
+
+	squareSize := len(dah.RowRoots) / 2
+	blobStart := int(p.BlobProof.RowProof.StartRow)*squareSize + int(p.BlobProof.ShareProofs[0].Start)
+	pfb, err := findPFB(p.PFBProof.Data, p.BlobIndex, uint32(blobStart))
+	if err != nil {
+		return err
+	}
+
+	blobNamespace, err := proofNamespace(p.BlobProof)
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(blobNamespace.Bytes(), pfb.Namespaces[p.BlobIndex]) {
+		return fmt.Errorf("blob proof is for namespace %X but the PFB pays for namespace %X", blobNamespace.Bytes(), pfb.Namespaces[p.BlobIndex])
+	}
+	if pfb.ShareVersions[p.BlobIndex] > appconsts.MaxShareVersion {
+		return fmt.Errorf("unsupported share version %d", pfb.ShareVersions[p.BlobIndex])
+	}
+	sharesNeeded := shares.SparseSharesNeededForVersion(pfb.BlobSizes[p.BlobIndex], uint8(pfb.ShareVersions[p.BlobIndex]))
+	if len(p.BlobProof.Data) != sharesNeeded {
+		return fmt.Errorf("blob proof contains %d shares but the PFB pays for %d", len(p.BlobProof.Data), sharesNeeded)
+	}
+
+	blobShares, err := shares.FromBytes(p.BlobProof.Data)
+	if err != nil {
+		return err
+	}
+	subtreeRoots, err := blobtypes.SubtreeRoots(blobShares, blobNamespace)
+	if err != nil {
+		return err
+	}
+	if len(subtreeRoots) != len(p.SubtreeRoots) {
+		return fmt.Errorf("proof contains %d subtree roots, expected %d", len(p.SubtreeRoots), len(subtreeRoots))
+	}
+	for i, root := range subtreeRoots {
+		if !bytes.Equal(root, p.SubtreeRoots[i]) {
+			return fmt.Errorf("subtree root %d does not match the shares of the blob", i)
+		}
+	}
+
+	if bytes.Equal(merkle.HashFromByteSlices(subtreeRoots), pfb.ShareCommitments[p.BlobIndex]) {
+		return errors.New("blob matches the share commitment of the PFB")
+	}
+	return nil
+}
+
+// findPFB parses the transactions in the proven PFB shares and returns the
+// MsgPayForBlobs whose IndexWrapper places the blob at blobIndex at the
+// provided share index. Only one blob can start at any share of the square.
+func findPFB(pfbShares [][]byte, blobIndex uint32, blobStart uint32) (*blobtypes.MsgPayForBlobs, error) {
+	rawShares, err := shares.FromBytes(pfbShares)
+	if err != nil {
+		return nil, err
+	}
+	txs, err := shares.ParseTxs(rawShares)
+	if err != nil {
+		return nil, err
+	}
+	for _, tx := range txs {
+		indexWrapper, isIndexWrapper := types.UnmarshalIndexWrapper(tx)
+		if !isIndexWrapper {
+			continue
+		}
+		if blobIndex >= uint32(len(indexWrapper.ShareIndexes)) || indexWrapper.ShareIndexes[blobIndex] != blobStart {
+			continue
+		}
+		pfb, err := blobtypes.PFBFromTx(indexWrapper.Tx)
+		if err != nil {
+			return nil, err
+		}
+		numBlobs := len(indexWrapper.ShareIndexes)
+		if len(pfb.Namespaces) != numBlobs || len(pfb.BlobSizes) != numBlobs || len(pfb.ShareCommitments) != numBlobs || len(pfb.ShareVersions) != numBlobs {
+			return nil, fmt.Errorf("PFB pays for a different number of blobs than its IndexWrapper has share indexes (%d)", numBlobs)
+		}
+		return pfb, nil
+	}
+	return nil, fmt.Errorf("PFB shares contain no PFB that places blob %d at share %d", blobIndex, blobStart)
+}
+
+func proofNamespace(proof types.ShareProof) (appns.Namespace, error) {
+	if proof.NamespaceVersion > appns.NamespaceVersionMax {
+		return appns.Namespace{}, fmt.Errorf("invalid namespace version %d", proof.NamespaceVersion)
+	}
+	return appns.New(uint8(proof.NamespaceVersion), proof.NamespaceID)
+}
+
+// ToProto converts the proof to its protobuf representation.
+func (p ShareCommitmentFraudProof) ToProto() proofproto.ShareCommitmentFraudProof {
+	pfbProof := p.PFBProof.ToProto()
+	blobProof := p.BlobProof.ToProto()
+	return proofproto.ShareCommitmentFraudProof{
+		PfbProof:     &pfbProof,
+		BlobIndex:    p.BlobIndex,
+		BlobProof:    &blobProof,
+		SubtreeRoots: p.SubtreeRoots,
+	}
+}
+
+// ShareCommitmentFraudProofFromProto converts the protobuf representation of a
+// proof back into a ShareCommitmentFraudProof.
+func ShareCommitmentFraudProofFromProto(pb *proofproto.ShareCommitmentFraudProof) (ShareCommitmentFraudProof, error) {
+	if pb == nil || pb.PfbProof == nil || pb.BlobProof == nil {
+		return ShareCommitmentFraudProof{}, errors.New("nil share commitment fraud proof")
+	}
+	pfbProof, err := types.ShareProofFromProto(*pb.PfbProof)
+	if err != nil {
+		return ShareCommitmentFraudProof{}, err
+	}
+	blobProof, err := types.ShareProofFromProto(*pb.BlobProof)
+	if err != nil {
+		return ShareCommitmentFraudProof{}, err
+	}
+	return ShareCommitmentFraudProof{
+		PFBProof:     pfbProof,
+		BlobIndex:    pb.BlobIndex,
+		BlobProof:    blobProof,
+		SubtreeRoots: pb.SubtreeRoots,
+	}, nil
+}
@@ -0,0 +1,133 @@
+package proof_test
+
+import (
+	"testing"
+
+	"github.com/celestiaorg/celestia-app/app"
+	"github.com/celestiaorg/celestia-app/app/encoding"
+	"github.com/celestiaorg/celestia-app/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/pkg/da"
+	"github.com/celestiaorg/celestia-app/pkg/proof"
+	"github.com/celestiaorg/celestia-app/pkg/shares"
+	"github.com/celestiaorg/celestia-app/pkg/square"
+	"github.com/celestiaorg/celestia-app/test/util/blobfactory"
+	"github.com/celestiaorg/celestia-app/test/util/testfactory"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	tmrand "github.com/tendermint/tendermint/libs/rand"
+	coretypes "github.com/tendermint/tendermint/types"
+)
+
+// newFraudulentBlobTx replaces the data of the blob at blobIndex with random
+// bytes of the same length, so that the blob no longer matches the share
+// commitment of the PFB.
+func newFraudulentBlobTx(t *testing.T, rawTx coretypes.Tx, blobIndex int) coretypes.Tx {
+	blobTx, isBlobTx := coretypes.UnmarshalBlobTx(rawTx)
+	require.True(t, isBlobTx)
+	blobTx.Blobs[blobIndex].Data = tmrand.Bytes(len(blobTx.Blobs[blobIndex].Data))
+	fraudulent, err := coretypes.MarshalBlobTx(blobTx.Tx, blobTx.Blobs...)
+	require.NoError(t, err)
+	return fraudulent
+}
+
+// buildDAH constructs the data square of txs the way a block proposer would
+// and returns its data availability header.
+func buildDAH(t *testing.T, txs [][]byte) da.DataAvailabilityHeader {
+	dataSquare, err := square.Construct(txs, appconsts.LatestVersion, appconsts.SquareSizeUpperBound(appconsts.LatestVersion))
+	require.NoError(t, err)
+	eds, err := da.ExtendShares(shares.ToBytes(dataSquare))
+	require.NoError(t, err)
+	return da.NewDataAvailabilityHeader(eds)
+}
+
+func TestShareCommitmentFraudProof(t *testing.T) {
+	encCfg := encoding.MakeConfig(app.ModuleEncodingRegisters...)
+	blobTxs := blobfactory.RandBlobTxs(encCfg.TxConfig.TxEncoder(), 4, 3, 2000)
+	blobTxs[2] = newFraudulentBlobTx(t, blobTxs[2], 1)
+	blockTxs := append(testfactory.GenerateRandomTxs(5, 200), blobTxs...).ToSliceOfBytes()
+	dah := buildDAH(t, blockTxs)
+	txIndex := uint64(5 + 2)
+
+	fraudProof, err := proof.NewShareCommitmentFraudProof(blockTxs, txIndex, 1, appconsts.LatestVersion)
+	require.NoError(t, err)
+	require.NoError(t, fraudProof.Verify(&dah))
+
+	// the proof survives a round trip through protobuf
+	pbProof := fraudProof.ToProto()
+	decoded, err := proof.ShareCommitmentFraudProofFromProto(&pbProof)
+	require.NoError(t, err)
+	require.NoError(t, decoded.Verify(&dah))
+
+	// the other blobs of the same PFB match their commitments
+	for _, blobIndex := range []uint32{0, 2} {
+		honestProof, err := proof.NewShareCommitmentFraudProof(blockTxs, txIndex, blobIndex, appconsts.LatestVersion)
+		require.NoError(t, err)
+		assert.Error(t, honestProof.Verify(&dah))
+	}
+
+	_, err = proof.NewShareCommitmentFraudProof(blockTxs, 0, 0, appconsts.LatestVersion)
+	assert.Error(t, err)
+	_, err = proof.NewShareCommitmentFraudProof(blockTxs, txIndex, 3, appconsts.LatestVersion)
+	assert.Error(t, err)
+}
+
+func TestShareCommitmentFraudProofInvalid(t *testing.T) {
+	encCfg := encoding.MakeConfig(app.ModuleEncodingRegisters...)
+	blobTxs := blobfactory.RandBlobTxs(encCfg.TxConfig.TxEncoder(), 3, 2, 2000)
+	blobTxs[1] = newFraudulentBlobTx(t, blobTxs[1], 0)
+	blockTxs := blobTxs.ToSliceOfBytes()
+	dah := buildDAH(t, blockTxs)
+
+	otherTxs := blobfactory.RandBlobTxs(encCfg.TxConfig.TxEncoder(), 3, 2, 2000).ToSliceOfBytes()
+	otherDAH := buildDAH(t, otherTxs)
+
+	type test struct {
+		name   string
+		dah    da.DataAvailabilityHeader
+		modify func(p *proof.ShareCommitmentFraudProof)
+	}
+	tests := []test{
+		{
+			name:   "different data availability header",
+			dah:    otherDAH,
+			modify: func(p *proof.ShareCommitmentFraudProof) {},
+		},
+		{
+			name: "different blob index",
+			dah:  dah,
+			modify: func(p *proof.ShareCommitmentFraudProof) {
+				p.BlobIndex = 1
+			},
+		},
+		{
+			name: "blob proof swapped with the PFB proof",
+			dah:  dah,
+			modify: func(p *proof.ShareCommitmentFraudProof) {
+				p.BlobProof = p.PFBProof
+			},
+		},
+		{
+			name: "modified subtree root",
+			dah:  dah,
+			modify: func(p *proof.ShareCommitmentFraudProof) {
+				p.SubtreeRoots[0] = tmrand.Bytes(len(p.SubtreeRoots[0]))
+			},
+		},
+		{
+			name: "missing subtree root",
+			dah:  dah,
+			modify: func(p *proof.ShareCommitmentFraudProof) {
+				p.SubtreeRoots = p.SubtreeRoots[1:]
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fraudProof, err := proof.NewShareCommitmentFraudProof(blockTxs, 1, 0, appconsts.LatestVersion)
+			require.NoError(t, err)
+			require.NoError(t, fraudProof.Verify(&dah))
+			tt.modify(&fraudProof)
+			assert.Error(t, fraudProof.Verify(&tt.dah))
+		})
+	}
+}
@@ -0,0 +1,169 @@
+package proof
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/celestiaorg/celestia-app/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/pkg/da"
+	daproto "github.com/celestiaorg/celestia-app/proto/celestia/da"
+	"github.com/celestiaorg/nmt"
+	tmjson "github.com/tendermint/tendermint/libs/json"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	"github.com/tendermint/tendermint/types"
+)
+
+// VerifyShareProof verifies, without any network access, that the shares of
+// the proof are included in the rows committed to by the proof and that those
+// rows are committed to by the provided data root. For an absence proof, as
+// returned by NewNamespaceProof for a namespace that isn't in the square, it
+// verifies that the namespace is absent from the row committed to by the proof
+// instead.
+func VerifyShareProof(shareProof types.ShareProof, dataRoot []byte) error {
+	if len(dataRoot) != 32 {
+		return fmt.Errorf("data root must be 32 bytes, got %d", len(dataRoot))
+	}
+	if err := validateShareProofStructure(shareProof); err != nil {
+		return err
+	}
+	if IsAbsenceProof(shareProof) {
+		return verifyAbsenceProof(shareProof, dataRoot)
+	}
+	return shareProof.Validate(dataRoot)
+}
+
+// IsAbsenceProof reports whether the share proof proves that its namespace is
+// absent from the data square rather than the inclusion of shares. An absence
+// proof contains no shares and a single NMT proof with the hash of the leaf
+// that takes the place of the namespace in its row.
+func IsAbsenceProof(shareProof types.ShareProof) bool {
+	return len(shareProof.Data) == 0 && len(shareProof.ShareProofs) == 1 &&
+		shareProof.ShareProofs[0] != nil && len(shareProof.ShareProofs[0].LeafHash) != 0
+}
+
+// verifyAbsenceProof verifies that the namespace of the absence proof is absent
+// from the row of the proof and that the row is committed to by the data root.
+func verifyAbsenceProof(shareProof types.ShareProof, dataRoot []byte) error {
+	if shareProof.NamespaceVersion > math.MaxUint8 {
+		return fmt.Errorf("invalid namespace version %d", shareProof.NamespaceVersion)
+	}
+	if len(shareProof.RowProof.RowRoots) != 1 {
+		return fmt.Errorf("an absence proof must prove a single row, got %d", len(shareProof.RowProof.RowRoots))
+	}
+	nmtProof := shareProof.ShareProofs[0]
+	if nmtProof.Start < 0 || nmtProof.End <= nmtProof.Start {
+		return fmt.Errorf("invalid absence proof range [%d, %d)", nmtProof.Start, nmtProof.End)
+	}
+	if err := shareProof.RowProof.Validate(dataRoot); err != nil {
+		return err
+	}
+
+	namespace := append([]byte{uint8(shareProof.NamespaceVersion)}, shareProof.NamespaceID...)
+	absenceProof := nmt.NewAbsenceProof(int(nmtProof.Start), int(nmtProof.End), nmtProof.Nodes, nmtProof.LeafHash, true)
+	if !absenceProof.VerifyNamespace(appconsts.NewBaseHashFunc(), namespace, nil, shareProof.RowProof.RowRoots[0]) {
+		return errors.New("absence proof failed to verify")
+	}
+	return nil
+}
+
+// VerifyShareProofWithDAH behaves like VerifyShareProof but checks the proof
+// against a DataAvailabilityHeader. In addition to verifying the proof against
+// the data root derived from the header, the row roots of the proof must
+// match those of the header.
+func VerifyShareProofWithDAH(shareProof types.ShareProof, dah *da.DataAvailabilityHeader) error {
+	if err := dah.ValidateBasic(); err != nil {
+		return err
+	}
+	if err := validateShareProofStructure(shareProof); err != nil {
+		return err
+	}
+	rowProof := shareProof.RowProof
+	if int(rowProof.EndRow) >= len(dah.RowRoots) {
+		return fmt.Errorf("end row %d is out of range for a data availability header with %d rows", rowProof.EndRow, len(dah.RowRoots))
+	}
+	for i, rowRoot := range rowProof.RowRoots {
+		if !bytes.Equal(rowRoot, dah.RowRoots[int(rowProof.StartRow)+i]) {
+			return fmt.Errorf("row root %d of the proof does not match the data availability header", int(rowProof.StartRow)+i)
+		}
+	}
+	return VerifyShareProof(shareProof, dah.Hash())
+}
+
+// validateShareProofStructure checks the lengths of the proof's fields so that
+// verification of a malformed proof fails with an error instead of panicking.
+func validateShareProofStructure(shareProof types.ShareProof) error {
+	rowProof := shareProof.RowProof
+	if len(shareProof.ShareProofs) == 0 {
+		return errors.New("share proof contains no NMT proofs")
+	}
+	if rowProof.EndRow < rowProof.StartRow {
+		return fmt.Errorf("end row %d is smaller than start row %d", rowProof.EndRow, rowProof.StartRow)
+	}
+	if int(rowProof.EndRow-rowProof.StartRow)+1 != len(rowProof.RowRoots) {
+		return fmt.Errorf("the number of rows %d must equal the number of row roots %d", int(rowProof.EndRow-rowProof.StartRow)+1, len(rowProof.RowRoots))
+	}
+	if len(rowProof.Proofs) != len(rowProof.RowRoots) {
+		return fmt.Errorf("the number of row proofs %d must equal the number of row roots %d", len(rowProof.Proofs), len(rowProof.RowRoots))
+	}
+	for i, p := range rowProof.Proofs {
+		if p == nil {
+			return fmt.Errorf("row proof %d is nil", i)
+		}
+	}
+	for i, p := range shareProof.ShareProofs {
+		if p == nil {
+			return fmt.Errorf("share proof %d is nil", i)
+		}
+	}
+	return nil
+}
+
+// UnmarshalShareProof decodes a ShareProof. Both the JSON encoding returned by
+// the Tendermint RPC and the protobuf encoding returned by the ABCI queries of
+// this package are supported.
+func UnmarshalShareProof(bz []byte) (types.ShareProof, error) {
+	if isJSON(bz) {
+		var shareProof types.ShareProof
+		if err := tmjson.Unmarshal(bz, &shareProof); err != nil {
+			return types.ShareProof{}, fmt.Errorf("error decoding JSON share proof: %w", err)
+		}
+		return shareProof, nil
+	}
+
+	var pbShareProof tmproto.ShareProof
+	if err := pbShareProof.Unmarshal(bz); err != nil {
+		return types.ShareProof{}, fmt.Errorf("error decoding protobuf share proof: %w", err)
+	}
+	if pbShareProof.RowProof != nil && len(pbShareProof.RowProof.RowRoots) != len(pbShareProof.RowProof.Proofs) {
+		return types.ShareProof{}, fmt.Errorf("the number of row proofs %d must equal the number of row roots %d", len(pbShareProof.RowProof.Proofs), len(pbShareProof.RowProof.RowRoots))
+	}
+	return types.ShareProofFromProto(pbShareProof)
+}
+
+// UnmarshalDataAvailabilityHeader decodes a DataAvailabilityHeader from its
+// JSON or protobuf encoding.
+func UnmarshalDataAvailabilityHeader(bz []byte) (*da.DataAvailabilityHeader, error) {
+	if isJSON(bz) {
+		dah := new(da.DataAvailabilityHeader)
+		if err := tmjson.Unmarshal(bz, dah); err != nil {
+			return nil, fmt.Errorf("error decoding JSON data availability header: %w", err)
+		}
+		return dah, dah.ValidateBasic()
+	}
+
+	var pbDAH daproto.DataAvailabilityHeader
+	if err := pbDAH.Unmarshal(bz); err != nil {
+		return nil, fmt.Errorf("error decoding protobuf data availability header: %w", err)
+	}
+	return da.DataAvailabilityHeaderFromProto(&pbDAH)
+}
+
+// isJSON reports whether the encoded message is a JSON object rather than a
+// protobuf encoded one.
+func isJSON(bz []byte) bool {
+	trimmed := bytes.TrimSpace(bz)
+	return len(trimmed) > 0 && trimmed[0] == '{' && json.Valid(trimmed)
+}
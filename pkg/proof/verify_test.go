@@ -0,0 +1,85 @@
+package proof_test
+
+import (
+	"testing"
+
+	"github.com/celestiaorg/celestia-app/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/pkg/da"
+	"github.com/celestiaorg/celestia-app/pkg/proof"
+	"github.com/celestiaorg/celestia-app/pkg/shares"
+	"github.com/celestiaorg/celestia-app/pkg/square"
+	"github.com/celestiaorg/celestia-app/test/util/testfactory"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	tmjson "github.com/tendermint/tendermint/libs/json"
+)
+
+func TestVerifyShareProof(t *testing.T) {
+	txs := testfactory.GenerateRandomTxs(100, 500).ToSliceOfBytes()
+	dataSquare, err := square.Construct(txs, appconsts.LatestVersion, appconsts.SquareSizeUpperBound(appconsts.LatestVersion))
+	require.NoError(t, err)
+	eds, err := da.ExtendShares(shares.ToBytes(dataSquare))
+	require.NoError(t, err)
+	dah := da.NewDataAvailabilityHeader(eds)
+
+	shareProof, err := proof.NewTxInclusionProof(txs, 50, appconsts.LatestVersion)
+	require.NoError(t, err)
+
+	jsonProof, err := tmjson.Marshal(shareProof)
+	require.NoError(t, err)
+	pbProof := shareProof.ToProto()
+	protoProof, err := pbProof.Marshal()
+	require.NoError(t, err)
+
+	jsonDAH, err := tmjson.Marshal(dah)
+	require.NoError(t, err)
+	pbDAH, err := dah.ToProto()
+	require.NoError(t, err)
+	protoDAH, err := pbDAH.Marshal()
+	require.NoError(t, err)
+
+	for _, rawProof := range [][]byte{jsonProof, protoProof} {
+		decodedProof, err := proof.UnmarshalShareProof(rawProof)
+		require.NoError(t, err)
+		assert.NoError(t, proof.VerifyShareProof(decodedProof, dah.Hash()))
+
+		for _, rawDAH := range [][]byte{jsonDAH, protoDAH} {
+			decodedDAH, err := proof.UnmarshalDataAvailabilityHeader(rawDAH)
+			require.NoError(t, err)
+			assert.NoError(t, proof.VerifyShareProofWithDAH(decodedProof, decodedDAH))
+		}
+	}
+
+	t.Run("wrong data root", func(t *testing.T) {
+		wrongRoot := make([]byte, 32)
+		assert.Error(t, proof.VerifyShareProof(shareProof, wrongRoot))
+		assert.Error(t, proof.VerifyShareProof(shareProof, dah.Hash()[:31]))
+	})
+
+	t.Run("row roots not in the data availability header", func(t *testing.T) {
+		otherDAH := da.MinDataAvailabilityHeader()
+		assert.Error(t, proof.VerifyShareProofWithDAH(shareProof, &otherDAH))
+	})
+
+	t.Run("tampered shares", func(t *testing.T) {
+		tampered, err := proof.UnmarshalShareProof(protoProof)
+		require.NoError(t, err)
+		tampered.Data[0] = make([]byte, appconsts.ShareSize)
+		assert.Error(t, proof.VerifyShareProof(tampered, dah.Hash()))
+	})
+
+	t.Run("malformed proofs do not panic", func(t *testing.T) {
+		malformed, err := proof.UnmarshalShareProof(protoProof)
+		require.NoError(t, err)
+		malformed.RowProof.Proofs = nil
+		assert.Error(t, proof.VerifyShareProof(malformed, dah.Hash()))
+
+		malformed, err = proof.UnmarshalShareProof(protoProof)
+		require.NoError(t, err)
+		malformed.RowProof.EndRow += 10
+		assert.Error(t, proof.VerifyShareProofWithDAH(malformed, &dah))
+
+		_, err = proof.UnmarshalShareProof([]byte("{not json"))
+		assert.Error(t, err)
+	})
+}
@@ -1,8 +1,7 @@
 package shares
 
 import (
-	"bytes"
-	"fmt"
+	"io"
 
 	"github.com/celestiaorg/celestia-app/pkg/appconsts"
 	coretypes "github.com/tendermint/tendermint/types"
@@ -40,44 +39,28 @@ func ParseBlobs(shares []Share) ([]coretypes.Blob, error) {
 // any padding sequences.
 func ParseShares(shares []Share, ignorePadding bool) ([]ShareSequence, error) {
 	sequences := []ShareSequence{}
-	currentSequence := ShareSequence{}
-
-	for _, share := range shares {
-		if err := share.Validate(); err != nil {
-			return sequences, err
-		}
-		isStart, err := share.IsSequenceStart()
-		if err != nil {
-			return sequences, err
+	// sequenceLenErr is the first error caused by a sequence whose number of
+	// shares doesn't match its sequence length. These sequences are still
+	// parsed so that all sequences are returned along with the error.
+	var sequenceLenErr error
+	reader := newSequenceReader(shares)
+	for {
+		sequence, _, err := reader.Next()
+		if err == io.EOF {
+			break
 		}
-		ns, err := share.Namespace()
 		if err != nil {
-			return sequences, err
-		}
-		if isStart {
-			if len(currentSequence.Shares) > 0 {
-				sequences = append(sequences, currentSequence)
-			}
-			currentSequence = ShareSequence{
-				Shares:    []Share{share},
-				Namespace: ns,
+			if len(sequence.Shares) == 0 {
+				return sequences, err
 			}
-		} else {
-			if !bytes.Equal(currentSequence.Namespace.Bytes(), ns.Bytes()) {
-				return sequences, fmt.Errorf("share sequence %v has inconsistent namespace IDs with share %v", currentSequence, share)
+			if sequenceLenErr == nil {
+				sequenceLenErr = err
 			}
-			currentSequence.Shares = append(currentSequence.Shares, share)
 		}
+		sequences = append(sequences, sequence)
 	}
-
-	if len(currentSequence.Shares) > 0 {
-		sequences = append(sequences, currentSequence)
-	}
-
-	for _, sequence := range sequences {
-		if err := sequence.validSequenceLen(); err != nil {
-			return sequences, err
-		}
+	if sequenceLenErr != nil {
+		return sequences, sequenceLenErr
 	}
 
 	result := []ShareSequence{}
@@ -0,0 +1,215 @@
+package shares
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/celestiaorg/celestia-app/pkg/appconsts"
+	coretypes "github.com/tendermint/tendermint/types"
+)
+
+// shareSource yields shares one at a time. io.EOF is returned once all shares
+// have been read.
+type shareSource interface {
+	nextShare() (Share, error)
+}
+
+// readerSource reads shares from a stream of raw shares.
+type readerSource struct {
+	r io.Reader
+}
+
+func (s *readerSource) nextShare() (Share, error) {
+	data := make([]byte, appconsts.ShareSize)
+	if _, err := io.ReadFull(s.r, data); err != nil {
+		if errors.Is(err, io.ErrUnexpectedEOF) {
+			return Share{}, fmt.Errorf("stream ended in the middle of a share: %w", err)
+		}
+		return Share{}, err
+	}
+	return Share{data: data}, nil
+}
+
+// sliceSource reads shares from shares that are already in memory.
+type sliceSource struct {
+	shares []Share
+}
+
+func (s *sliceSource) nextShare() (Share, error) {
+	if len(s.shares) == 0 {
+		return Share{}, io.EOF
+	}
+	share := s.shares[0]
+	s.shares = s.shares[1:]
+	return share, nil
+}
+
+// SequenceReader reads share sequences from a stream of shares one at a time.
+// In contrast to ParseShares, only the shares of the sequence being read are
+// held in memory, which keeps the memory footprint of parsing large squares
+// bounded by the size of the largest sequence.
+type SequenceReader struct {
+	src shareSource
+	// index is the index of the next share to be read from src.
+	index int
+	// next is the first share of the next sequence if it has already been
+	// read from src.
+	next *Share
+	// err is returned by all subsequent calls once reading has failed or
+	// reached the end of the stream.
+	err error
+}
+
+// NewSequenceReader returns a SequenceReader that reads shares of
+// appconsts.ShareSize bytes from r.
+func NewSequenceReader(r io.Reader) *SequenceReader {
+	return &SequenceReader{src: &readerSource{r: r}}
+}
+
+func newSequenceReader(shares []Share) *SequenceReader {
+	return &SequenceReader{src: &sliceSource{shares: shares}}
+}
+
+// Next returns the next share sequence along with the range of shares it
+// occupies, relative to the first share of the stream. io.EOF is returned
+// once there are no more sequences. If the number of shares in the sequence
+// doesn't match the sequence length of its first share, the sequence is
+// returned along with an error and subsequent sequences can still be read.
+// All other errors are final.
+func (sr *SequenceReader) Next() (ShareSequence, Range, error) {
+	if sr.err != nil {
+		return ShareSequence{}, Range{}, sr.err
+	}
+	sequence, shareRange, err := sr.readSequence()
+	if err != nil {
+		sr.err = err
+		return ShareSequence{}, Range{}, err
+	}
+	return sequence, shareRange, sequence.validSequenceLen()
+}
+
+func (sr *SequenceReader) readSequence() (ShareSequence, Range, error) {
+	var first Share
+	if sr.next != nil {
+		first = *sr.next
+		sr.next = nil
+	} else {
+		share, err := sr.readShare()
+		if err != nil {
+			return ShareSequence{}, Range{}, err
+		}
+		first = share
+	}
+	start := sr.index - 1
+
+	isStart, err := first.IsSequenceStart()
+	if err != nil {
+		return ShareSequence{}, Range{}, err
+	}
+	if !isStart {
+		return ShareSequence{}, Range{}, fmt.Errorf("share %d is a continuation share without a sequence start share", start)
+	}
+	ns, err := first.Namespace()
+	if err != nil {
+		return ShareSequence{}, Range{}, err
+	}
+	sequence := ShareSequence{
+		Namespace: ns,
+		Shares:    []Share{first},
+	}
+
+	for {
+		share, err := sr.readShare()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return ShareSequence{}, Range{}, err
+		}
+		isStart, err := share.IsSequenceStart()
+		if err != nil {
+			return ShareSequence{}, Range{}, err
+		}
+		if isStart {
+			sr.next = &share
+			break
+		}
+		shareNs, err := share.Namespace()
+		if err != nil {
+			return ShareSequence{}, Range{}, err
+		}
+		if !bytes.Equal(sequence.Namespace.Bytes(), shareNs.Bytes()) {
+			return ShareSequence{}, Range{}, fmt.Errorf("share sequence %v has inconsistent namespace IDs with share %v", sequence, share)
+		}
+		sequence.Shares = append(sequence.Shares, share)
+	}
+
+	return sequence, NewRange(start, start+len(sequence.Shares)), nil
+}
+
+// readShare reads and validates the next share of the source.
+func (sr *SequenceReader) readShare() (Share, error) {
+	share, err := sr.src.nextShare()
+	if err != nil {
+		return Share{}, err
+	}
+	if err := share.Validate(); err != nil {
+		return Share{}, err
+	}
+	sr.index++
+	return share, nil
+}
+
+// NextBlob returns the next blob along with the range of shares it occupies,
+// relative to the first share of the stream. Padding and compact share
+// sequences are skipped. io.EOF is returned once there are no more blobs.
+func (sr *SequenceReader) NextBlob() (coretypes.Blob, Range, error) {
+	for {
+		blob, shareRange, isBlob, err := sr.nextBlob(appconsts.SupportedShareVersions)
+		if err != nil || isBlob {
+			return blob, shareRange, err
+		}
+	}
+}
+
+// nextBlob reads the next sequence and converts it to a blob. isBlob is false
+// if the sequence is padding or consists of compact shares.
+func (sr *SequenceReader) nextBlob(supportedShareVersions []uint8) (blob coretypes.Blob, shareRange Range, isBlob bool, err error) {
+	sequence, shareRange, err := sr.Next()
+	if err != nil {
+		return coretypes.Blob{}, Range{}, false, err
+	}
+	for _, share := range sequence.Shares {
+		if err := share.DoesSupportVersions(supportedShareVersions); err != nil {
+			return coretypes.Blob{}, Range{}, false, err
+		}
+	}
+	isPadding, err := sequence.isPadding()
+	if err != nil {
+		return coretypes.Blob{}, Range{}, false, err
+	}
+	isCompact, err := sequence.Shares[0].IsCompactShare()
+	if err != nil {
+		return coretypes.Blob{}, Range{}, false, err
+	}
+	if isPadding || isCompact {
+		return coretypes.Blob{}, shareRange, false, nil
+	}
+
+	version, err := sequence.Shares[0].Version()
+	if err != nil {
+		return coretypes.Blob{}, Range{}, false, err
+	}
+	data, err := sequence.RawData()
+	if err != nil {
+		return coretypes.Blob{}, Range{}, false, err
+	}
+	return coretypes.Blob{
+		NamespaceID:      sequence.Namespace.ID,
+		Data:             data,
+		ShareVersion:     version,
+		NamespaceVersion: sequence.Namespace.Version,
+	}, shareRange, true, nil
+}
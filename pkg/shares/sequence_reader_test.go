@@ -0,0 +1,123 @@
+package shares
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	appns "github.com/celestiaorg/celestia-app/pkg/namespace"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/types"
+)
+
+func TestSequenceReader(t *testing.T) {
+	ns1 := appns.MustNewV0(bytes.Repeat([]byte{1}, appns.NamespaceVersionZeroIDSize))
+	ns2 := appns.MustNewV0(bytes.Repeat([]byte{2}, appns.NamespaceVersionZeroIDSize))
+
+	txShares, _, _, err := SplitTxs(generateRandomTxs(5, 300))
+	require.NoError(t, err)
+	blobs := []types.Blob{
+		generateRandomBlobWithNamespace(ns1, 1000),
+		generateRandomBlobWithNamespace(ns2, 100),
+	}
+	blobShares, err := SplitBlobs(len(txShares), nil, blobs, false)
+	require.NoError(t, err)
+	ns1Padding, err := NamespacePaddingShare(ns1)
+	require.NoError(t, err)
+
+	var dataSquare []Share
+	dataSquare = append(dataSquare, txShares...)
+	dataSquare = append(dataSquare, ReservedPaddingShare())
+	blobOneLen := SparseSharesNeeded(uint32(len(blobs[0].Data)))
+	dataSquare = append(dataSquare, blobShares[:blobOneLen]...)
+	dataSquare = append(dataSquare, ns1Padding)
+	dataSquare = append(dataSquare, blobShares[blobOneLen:]...)
+	dataSquare = append(dataSquare, TailPaddingShares(3)...)
+
+	t.Run("sequences", func(t *testing.T) {
+		want, err := ParseShares(dataSquare, false)
+		require.NoError(t, err)
+
+		reader := NewSequenceReader(bytes.NewReader(bytes.Join(ToBytes(dataSquare), nil)))
+		cursor := 0
+		for i := 0; ; i++ {
+			sequence, shareRange, err := reader.Next()
+			if err == io.EOF {
+				assert.Len(t, want, i)
+				break
+			}
+			require.NoError(t, err)
+			assert.Equal(t, want[i], sequence)
+			assert.Equal(t, NewRange(cursor, cursor+len(sequence.Shares)), shareRange)
+			cursor = shareRange.End
+		}
+		assert.Equal(t, len(dataSquare), cursor)
+	})
+
+	t.Run("blobs", func(t *testing.T) {
+		reader := NewSequenceReader(bytes.NewReader(bytes.Join(ToBytes(dataSquare), nil)))
+		wantRanges := []Range{
+			NewRange(len(txShares)+1, len(txShares)+1+blobOneLen),
+			NewRange(len(txShares)+2+blobOneLen, len(txShares)+2+len(blobShares)),
+		}
+		for i := range blobs {
+			blob, shareRange, err := reader.NextBlob()
+			require.NoError(t, err)
+			assert.Equal(t, blobs[i], blob)
+			assert.Equal(t, wantRanges[i], shareRange)
+		}
+		_, _, err := reader.NextBlob()
+		assert.Equal(t, io.EOF, err)
+	})
+
+	t.Run("stream ending in the middle of a share", func(t *testing.T) {
+		raw := bytes.Join(ToBytes(dataSquare), nil)
+		reader := NewSequenceReader(bytes.NewReader(raw[:len(raw)-1]))
+		for {
+			_, _, err = reader.Next()
+			if err != nil {
+				break
+			}
+		}
+		assert.Error(t, err)
+		assert.NotEqual(t, io.EOF, err)
+	})
+
+	t.Run("stream starting with a continuation share", func(t *testing.T) {
+		reader := NewSequenceReader(bytes.NewReader(bytes.Join(ToBytes(blobShares[1:blobOneLen]), nil)))
+		_, _, err := reader.Next()
+		assert.Error(t, err)
+		assert.NotEqual(t, io.EOF, err)
+	})
+}
+
+func TestTxReader(t *testing.T) {
+	txs := generateRandomTxs(20, 300)
+	txShares, _, shareRanges, err := SplitTxs(txs)
+	require.NoError(t, err)
+
+	reader := NewTxReader(bytes.NewReader(bytes.Join(ToBytes(txShares), nil)))
+	for _, want := range txs {
+		tx, shareRange, err := reader.Next()
+		require.NoError(t, err)
+		assert.Equal(t, want, tx)
+		assert.Equal(t, shareRanges[want.Key()], shareRange)
+	}
+	_, _, err = reader.Next()
+	assert.Equal(t, io.EOF, err)
+
+	// a stream that starts in the middle of the sequence only returns the
+	// transactions that start in it
+	reader = NewTxReader(bytes.NewReader(bytes.Join(ToBytes(txShares[3:]), nil)))
+	tx, shareRange, err := reader.Next()
+	require.NoError(t, err)
+	for _, want := range txs {
+		if bytes.Equal(want, tx) {
+			r := shareRanges[want.Key()]
+			assert.Equal(t, NewRange(r.Start-3, r.End-3), shareRange)
+			return
+		}
+	}
+	t.Fatal("parsed transaction is not part of the original transactions")
+}
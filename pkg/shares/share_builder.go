@@ -3,6 +3,7 @@ package shares
 import (
 	"encoding/binary"
 	"errors"
+	"fmt"
 
 	"github.com/celestiaorg/celestia-app/pkg/appconsts"
 	appns "github.com/celestiaorg/celestia-app/pkg/namespace"
@@ -89,6 +90,9 @@ func (b *Builder) IsEmptyShare() bool {
 	if b.isFirstShare {
 		expectedLen += appconsts.SequenceLenBytes
 	}
+	if b.hasSigner() {
+		expectedLen += appconsts.SignerSize
+	}
 	return len(b.rawShareData) == expectedLen
 }
 
@@ -171,6 +175,27 @@ func (b *Builder) WriteSequenceLen(sequenceLen uint32) error {
 	return nil
 }
 
+// WriteSigner writes the signer's address to the first share of a blob using
+// appconsts.ShareVersionOne.
+func (b *Builder) WriteSigner(signer []byte) error {
+	if b == nil {
+		return errors.New("the builder object is not initialized (is nil)")
+	}
+	if !b.hasSigner() {
+		return errors.New("only the first sparse share of share version one contains a signer")
+	}
+	if len(signer) != appconsts.SignerSize {
+		return fmt.Errorf("signer must be %d bytes, got %d", appconsts.SignerSize, len(signer))
+	}
+	copy(b.rawShareData[appconsts.NamespaceSize+appconsts.ShareInfoBytes+appconsts.SequenceLenBytes:], signer)
+	return nil
+}
+
+// hasSigner returns true if the share being built contains a signer.
+func (b *Builder) hasSigner() bool {
+	return b.isFirstShare && !b.isCompactShare && b.shareVersion == appconsts.ShareVersionOne
+}
+
 // FlipSequenceStart flips the sequence start indicator of the share provided
 func (b *Builder) FlipSequenceStart() {
 	infoByteIndex := b.indexOfInfoBytes()
@@ -218,6 +243,10 @@ func (b *Builder) prepareSparseShare() error {
 		shareData = append(shareData, placeholderSequenceLen...)
 	}
 
+	if b.hasSigner() {
+		shareData = append(shareData, make([]byte, appconsts.SignerSize)...)
+	}
+
 	b.rawShareData = shareData
 	return nil
 }
@@ -34,6 +34,15 @@ func (s ShareSequence) RawData() (data []byte, err error) {
 	return data[:sequenceLen], nil
 }
 
+// Signer returns the address of the signer embedded in the first share of
+// the sequence. It returns nil, nil if the sequence doesn't contain a signer.
+func (s ShareSequence) Signer() ([]byte, error) {
+	if len(s.Shares) == 0 {
+		return nil, fmt.Errorf("share sequence %v has no shares", s)
+	}
+	return s.Shares[0].Signer()
+}
+
 func (s ShareSequence) SequenceLen() (uint32, error) {
 	if len(s.Shares) == 0 {
 		return 0, fmt.Errorf("invalid sequence length because share sequence %v has no shares", s)
@@ -97,7 +106,11 @@ func numberOfSharesNeeded(firstShare Share) (sharesUsed int, err error) {
 	if isCompact {
 		return CompactSharesNeeded(int(sequenceLen)), nil
 	}
-	return SparseSharesNeeded(sequenceLen), nil
+	version, err := firstShare.Version()
+	if err != nil {
+		return 0, err
+	}
+	return SparseSharesNeededForVersion(sequenceLen, version), nil
 }
 
 // CompactSharesNeeded returns the number of compact shares needed to store a
@@ -124,15 +137,28 @@ func CompactSharesNeeded(sequenceLen int) (sharesNeeded int) {
 // SparseSharesNeeded returns the number of shares needed to store a sequence of
 // length sequenceLen.
 func SparseSharesNeeded(sequenceLen uint32) (sharesNeeded int) {
+	return SparseSharesNeededForVersion(sequenceLen, appconsts.ShareVersionZero)
+}
+
+// SparseSharesNeededForVersion returns the number of shares needed to store a
+// sequence of length sequenceLen using the provided share version. The first
+// share of appconsts.ShareVersionOne has less room for data as it contains
+// the signer.
+func SparseSharesNeededForVersion(sequenceLen uint32, shareVersion uint8) (sharesNeeded int) {
 	if sequenceLen == 0 {
 		return 0
 	}
 
-	if sequenceLen < appconsts.FirstSparseShareContentSize {
+	firstShareContentSize := appconsts.FirstSparseShareContentSize
+	if shareVersion == appconsts.ShareVersionOne {
+		firstShareContentSize -= appconsts.SignerSize
+	}
+
+	if sequenceLen < uint32(firstShareContentSize) {
 		return 1
 	}
 
-	bytesAvailable := appconsts.FirstSparseShareContentSize
+	bytesAvailable := firstShareContentSize
 	sharesNeeded++
 	for uint32(bytesAvailable) < sequenceLen {
 		bytesAvailable += appconsts.ContinuationSparseShareContentSize
@@ -111,6 +111,42 @@ func (s *Share) SequenceLen() (sequenceLen uint32, err error) {
 	return binary.BigEndian.Uint32(s.data[start:end]), nil
 }
 
+// Signer returns the address of the signer embedded in the first share of a
+// blob using appconsts.ShareVersionOne. It returns nil, nil for all other
+// shares as they don't contain a signer.
+func (s *Share) Signer() ([]byte, error) {
+	hasSigner, err := s.hasSigner()
+	if err != nil {
+		return nil, err
+	}
+	if !hasSigner {
+		return nil, nil
+	}
+	start := appconsts.NamespaceSize + appconsts.ShareInfoBytes + appconsts.SequenceLenBytes
+	end := start + appconsts.SignerSize
+	if len(s.data) < end {
+		return nil, fmt.Errorf("share %s with length %d is too short to contain a signer", s, len(s.data))
+	}
+	return s.data[start:end], nil
+}
+
+// hasSigner returns true if this is the first sparse share of a sequence
+// using appconsts.ShareVersionOne.
+func (s *Share) hasSigner() (bool, error) {
+	infoByte, err := s.InfoByte()
+	if err != nil {
+		return false, err
+	}
+	if !infoByte.IsSequenceStart() || infoByte.Version() != appconsts.ShareVersionOne {
+		return false, nil
+	}
+	isCompact, err := s.IsCompactShare()
+	if err != nil {
+		return false, err
+	}
+	return !isCompact, nil
+}
+
 // IsPadding returns whether this *share is padding or not.
 func (s *Share) IsPadding() (bool, error) {
 	isNamespacePadding, err := s.isNamespacePadding()
@@ -162,7 +198,7 @@ func (s *Share) ToBytes() []byte {
 }
 
 // RawData returns the raw share data. The raw share data does not contain the
-// namespace ID, info byte, sequence length, or reserved bytes.
+// namespace ID, info byte, sequence length, signer, or reserved bytes.
 func (s *Share) RawData() (rawData []byte, err error) {
 	if len(s.data) < s.rawDataStartIndex() {
 		return rawData, fmt.Errorf("share %s is too short to contain raw data", s)
@@ -181,6 +217,11 @@ func (s *Share) rawDataStartIndex() int {
 		panic(err)
 	}
 
+	hasSigner, err := s.hasSigner()
+	if err != nil {
+		panic(err)
+	}
+
 	index := appconsts.NamespaceSize + appconsts.ShareInfoBytes
 	if isStart {
 		index += appconsts.SequenceLenBytes
@@ -188,6 +229,9 @@ func (s *Share) rawDataStartIndex() int {
 	if isCompact {
 		index += appconsts.CompactShareReservedBytes
 	}
+	if hasSigner {
+		index += appconsts.SignerSize
+	}
 	return index
 }
 
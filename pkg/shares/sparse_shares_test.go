@@ -1,6 +1,7 @@
 package shares
 
 import (
+	"bytes"
 	"testing"
 
 	"github.com/celestiaorg/celestia-app/pkg/appconsts"
@@ -84,3 +85,31 @@ func TestSparseShareSplitterCount(t *testing.T) {
 		})
 	}
 }
+
+func TestSparseShareSplitterShareVersionOne(t *testing.T) {
+	signer := bytes.Repeat([]byte{0xAB}, appconsts.SignerSize)
+	blob := testfactory.GenerateRandomBlobOfShareCount(3)
+	blob.ShareVersion = appconsts.ShareVersionOne
+
+	sss := NewSparseShareSplitter()
+	require.Error(t, sss.Write(blob), "share version one requires a signer")
+	require.NoError(t, sss.WriteWithSigner(blob, signer))
+	shares := sss.Export()
+	// the signer takes up room in the first share so the blob no longer fits
+	// into the same number of shares as with share version zero
+	assert.Len(t, shares, 4)
+	assert.Equal(t, len(shares), SparseSharesNeededForVersion(uint32(len(blob.Data)), appconsts.ShareVersionOne))
+
+	got, err := shares[0].Signer()
+	require.NoError(t, err)
+	assert.Equal(t, signer, got)
+	got, err = shares[1].Signer()
+	require.NoError(t, err)
+	assert.Nil(t, got)
+
+	blobs, err := ParseBlobs(shares)
+	require.NoError(t, err)
+	require.Len(t, blobs, 1)
+	assert.Equal(t, blob.Data, blobs[0].Data)
+	assert.Equal(t, appconsts.ShareVersionOne, blobs[0].ShareVersion)
+}
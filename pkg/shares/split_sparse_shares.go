@@ -22,11 +22,22 @@ func NewSparseShareSplitter() *SparseShareSplitter {
 }
 
 // Write writes the provided blob to this sparse share splitter. It returns an
-// error or nil if no error is encountered.
+// error or nil if no error is encountered. Blobs using
+// appconsts.ShareVersionOne must be written using WriteWithSigner.
 func (sss *SparseShareSplitter) Write(blob coretypes.Blob) error {
+	return sss.WriteWithSigner(blob, nil)
+}
+
+// WriteWithSigner writes the provided blob to this sparse share splitter. The
+// signer is embedded in the first share of blobs using
+// appconsts.ShareVersionOne and ignored for all other share versions.
+func (sss *SparseShareSplitter) WriteWithSigner(blob coretypes.Blob, signer []byte) error {
 	if !slices.Contains(appconsts.SupportedShareVersions, blob.ShareVersion) {
 		return fmt.Errorf("unsupported share version: %d", blob.ShareVersion)
 	}
+	if blob.ShareVersion == appconsts.ShareVersionOne && len(signer) != appconsts.SignerSize {
+		return fmt.Errorf("share version one blobs require a signer of %d bytes, got %d", appconsts.SignerSize, len(signer))
+	}
 
 	rawData := blob.Data
 	blobNamespace, err := appns.New(blob.NamespaceVersion, blob.NamespaceID)
@@ -42,6 +53,11 @@ func (sss *SparseShareSplitter) Write(blob coretypes.Blob) error {
 	if err := b.WriteSequenceLen(uint32(len(rawData))); err != nil {
 		return err
 	}
+	if blob.ShareVersion == appconsts.ShareVersionOne {
+		if err := b.WriteSigner(signer); err != nil {
+			return err
+		}
+	}
 
 	for rawData != nil {
 
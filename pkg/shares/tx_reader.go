@@ -0,0 +1,151 @@
+package shares
+
+import (
+	"encoding/binary"
+	"io"
+
+	"github.com/celestiaorg/celestia-app/pkg/appconsts"
+	coretypes "github.com/tendermint/tendermint/types"
+)
+
+// TxReader reads the units (transactions or PFB transactions) contained in a
+// stream of compact shares one at a time. The stream may start in the middle
+// of a sequence, in which case the reserved bytes of the first share are used
+// to find the first unit that starts in the stream. Units that are not fully
+// contained in the stream are skipped.
+type TxReader struct {
+	src                    shareSource
+	supportedShareVersions []uint8
+
+	// index is the index of the next share to be read from src.
+	index int
+	// buf holds the raw data of the shares that has been read but not yet
+	// returned as a unit.
+	buf []byte
+	// spans tracks which shares the raw data in buf belongs to.
+	spans []shareSpan
+	// eof is true once all shares have been read from src.
+	eof bool
+	// done is true once the rest of the raw data is known to be padding.
+	done bool
+	err  error
+}
+
+// shareSpan is the number of bytes of raw data that a share contributed to
+// the buffer of a TxReader.
+type shareSpan struct {
+	index int
+	len   int
+}
+
+// NewTxReader returns a TxReader that reads compact shares of
+// appconsts.ShareSize bytes from r.
+func NewTxReader(r io.Reader) *TxReader {
+	return &TxReader{src: &readerSource{r: r}, supportedShareVersions: appconsts.SupportedShareVersions}
+}
+
+func newTxReader(shares []Share, supportedShareVersions []uint8) *TxReader {
+	return &TxReader{src: &sliceSource{shares: shares}, supportedShareVersions: supportedShareVersions}
+}
+
+// Next returns the next unit along with the range of shares it spans,
+// relative to the first share of the stream. io.EOF is returned once there
+// are no more units. All shares of the stream are checked for supported
+// share versions before io.EOF is returned.
+func (tr *TxReader) Next() (coretypes.Tx, Range, error) {
+	for tr.err == nil {
+		// the length delimiter is only parsed once it is guaranteed to be
+		// complete
+		if !tr.done && (tr.eof || len(tr.buf) >= binary.MaxVarintLen64) {
+			actualData, unitLen, err := ParseDelimiter(tr.buf)
+			if err != nil {
+				tr.err = err
+				break
+			}
+			delimiterLen := len(tr.buf) - len(actualData)
+			switch {
+			case unitLen == 0:
+				// the rest of the raw data is padding
+				tr.done = true
+			case unitLen <= uint64(len(actualData)):
+				unitEnd := delimiterLen + int(unitLen)
+				shareRange := NewRange(tr.shareIndexAt(0), tr.shareIndexAt(unitEnd-1)+1)
+				tx := make(coretypes.Tx, unitLen)
+				copy(tx, actualData[:unitLen])
+				tr.consume(unitEnd)
+				return tx, shareRange, nil
+			case tr.eof:
+				// the last unit is not fully contained in the stream
+				tr.done = true
+			}
+		}
+
+		if tr.eof {
+			tr.err = io.EOF
+			break
+		}
+		tr.readShare()
+	}
+	return nil, Range{}, tr.err
+}
+
+// readShare reads the next share from the source and appends its raw data to
+// the buffer unless the rest of the stream is known to be padding.
+func (tr *TxReader) readShare() {
+	share, err := tr.src.nextShare()
+	if err == io.EOF {
+		tr.eof = true
+		return
+	}
+	if err != nil {
+		tr.err = err
+		return
+	}
+	if err := share.DoesSupportVersions(tr.supportedShareVersions); err != nil {
+		tr.err = err
+		return
+	}
+	index := tr.index
+	tr.index++
+	if tr.done {
+		return
+	}
+
+	var raw []byte
+	if index == 0 {
+		raw, err = share.RawDataUsingReserved()
+	} else {
+		raw, err = share.RawData()
+	}
+	if err != nil {
+		tr.err = err
+		return
+	}
+	tr.buf = append(tr.buf, raw...)
+	tr.spans = append(tr.spans, shareSpan{index: index, len: len(raw)})
+}
+
+// shareIndexAt returns the index of the share that the byte at offset of the
+// buffer belongs to.
+func (tr *TxReader) shareIndexAt(offset int) int {
+	for _, span := range tr.spans {
+		if offset < span.len {
+			return span.index
+		}
+		offset -= span.len
+	}
+	return tr.index - 1
+}
+
+// consume removes the first n bytes from the buffer.
+func (tr *TxReader) consume(n int) {
+	tr.buf = tr.buf[n:]
+	for n > 0 && len(tr.spans) > 0 {
+		if n < tr.spans[0].len {
+			tr.spans[0].len -= n
+			return
+		}
+		n -= tr.spans[0].len
+		tr.spans = tr.spans[1:]
+	}
+}
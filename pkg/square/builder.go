@@ -32,6 +32,24 @@ type Builder struct {
 
 	done                 bool
 	subtreeRootThreshold int
+
+	// revertInvalidBlobTxs frees the PFB space counted for a blob tx that is
+	// rejected for an invalid blob. See appconsts.RevertInvalidBlobTxsEnabled.
+	revertInvalidBlobTxs bool
+	// packingStrategy determines which blob txs BuildPrioritized appends and
+	// in which order.
+	packingStrategy PackingStrategy
+}
+
+// BuilderOption configures optional behaviour of a Builder.
+type BuilderOption func(*Builder)
+
+// WithAppVersion enables the behaviour of the builder that depends on the app
+// version, i.e. whether the space of blob txs with invalid blobs is freed.
+func WithAppVersion(appVersion uint64) BuilderOption {
+	return func(b *Builder) {
+		b.revertInvalidBlobTxs = appconsts.RevertInvalidBlobTxsEnabled(appVersion)
+	}
 }
 
 func NewBuilder(maxSquareSize, subtreeRootThreshold int, txs ...[]byte) (*Builder, error) {
@@ -73,6 +91,19 @@ func NewBuilder(maxSquareSize, subtreeRootThreshold int, txs ...[]byte) (*Builde
 	return builder, nil
 }
 
+// NewBuilderWithOptions returns an empty builder configured by the provided
+// options.
+func NewBuilderWithOptions(maxSquareSize, subtreeRootThreshold int, opts ...BuilderOption) (*Builder, error) {
+	builder, err := NewBuilder(maxSquareSize, subtreeRootThreshold)
+	if err != nil {
+		return nil, err
+	}
+	for _, opt := range opts {
+		opt(builder)
+	}
+	return builder, nil
+}
+
 // AppendTx attempts to allocate the transaction to the square. It returns false if there is not
 // enough space in the square to fit the transaction.
 func (b *Builder) AppendTx(tx []byte) bool {
@@ -101,12 +132,29 @@ func (b *Builder) AppendBlobTx(blobTx coretypes.BlobTx) bool {
 	// create a new blob element for each blob and track the worst-case share count
 	blobElements := make([]*element, len(blobTx.Blobs))
 	maxBlobShareCount := 0
+	var signer []byte
 	for idx, blobProto := range blobTx.Blobs {
 		blob, err := types.BlobFromProto(blobProto)
 		if err != nil {
+			if b.revertInvalidBlobTxs {
+				b.pfbCounter.Revert()
+			}
 			return false
 		}
 		blobElements[idx] = newElement(blob, len(b.pfbs), idx, b.subtreeRootThreshold)
+		// blobs of share version one embed the signer of the PFB in their first share
+		if blob.ShareVersion == appconsts.ShareVersionOne {
+			if signer == nil {
+				signer, err = types.PFBSignerFromTx(blobTx.Tx)
+				if err != nil {
+					if b.revertInvalidBlobTxs {
+						b.pfbCounter.Revert()
+					}
+					return false
+				}
+			}
+			blobElements[idx].signer = signer
+		}
 		maxBlobShareCount += blobElements[idx].maxShareOffset()
 	}
 
@@ -176,7 +224,7 @@ func (b *Builder) Export() (Square, error) {
 			}
 		}
 		// Finally write the blob itself
-		if err := blobWriter.Write(element.blob); err != nil {
+		if err := blobWriter.WriteWithSigner(element.blob, element.signer); err != nil {
 			return nil, fmt.Errorf("writing blob into sparse shares: %w", err)
 		}
 		// increment the cursor by the size of the blob
@@ -365,7 +413,9 @@ func fullNamespace(blob core.Blob) []byte {
 }
 
 type element struct {
-	blob       core.Blob
+	blob core.Blob
+	// signer is the address of the PFB signer for blobs of share version one
+	signer     []byte
 	pfbIndex   int
 	blobIndex  int
 	numShares  int
@@ -373,7 +423,7 @@ type element struct {
 }
 
 func newElement(blob core.Blob, pfbIndex, blobIndex, subtreeRootThreshold int) *element {
-	numShares := shares.SparseSharesNeeded(uint32(len(blob.Data)))
+	numShares := shares.SparseSharesNeededForVersion(uint32(len(blob.Data)), blob.ShareVersion)
 	return &element{
 		blob:      blob,
 		pfbIndex:  pfbIndex,
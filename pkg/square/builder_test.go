@@ -3,18 +3,22 @@ package square_test
 import (
 	"bytes"
 	"fmt"
+	"math"
 	"math/rand"
 	"testing"
 
 	"github.com/celestiaorg/celestia-app/app"
 	"github.com/celestiaorg/celestia-app/app/encoding"
 	"github.com/celestiaorg/celestia-app/pkg/appconsts"
+	v1 "github.com/celestiaorg/celestia-app/pkg/appconsts/v1"
+	v2 "github.com/celestiaorg/celestia-app/pkg/appconsts/v2"
 	ns "github.com/celestiaorg/celestia-app/pkg/namespace"
 	"github.com/celestiaorg/celestia-app/pkg/shares"
 	"github.com/celestiaorg/celestia-app/pkg/square"
 	"github.com/celestiaorg/celestia-app/test/util/blobfactory"
 	"github.com/celestiaorg/celestia-app/test/util/testfactory"
 	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 	"github.com/tendermint/tendermint/types"
 	core "github.com/tendermint/tendermint/types"
 	coretypes "github.com/tendermint/tendermint/types"
@@ -130,6 +134,82 @@ func TestBuilderRejectsBlobTransactions(t *testing.T) {
 	}
 }
 
+// TestBuilderRejectsInvalidBlobs tests that, from app version 2, a blob tx
+// that is rejected for an invalid blob doesn't take space from the PFBs that
+// are appended after it, so that the square matches the one constructed from
+// the included txs.
+func TestBuilderRejectsInvalidBlobs(t *testing.T) {
+	ns1 := ns.MustNewV0(bytes.Repeat([]byte{1}, ns.NamespaceVersionZeroIDSize))
+	txs := generateBlobTxsWithNamespaces(t, ns1.Repeat(2), [][]int{{100}, {100}})
+	require.Len(t, txs, 2)
+
+	builder, err := square.NewBuilderWithOptions(appconsts.DefaultSquareSizeUpperBound, appconsts.DefaultSubtreeRootThreshold,
+		square.WithAppVersion(v2.Version))
+	require.NoError(t, err)
+	for _, tx := range txs {
+		invalidBlobTx, isBlobTx := coretypes.UnmarshalBlobTx(tx)
+		require.True(t, isBlobTx)
+		invalidBlob := *invalidBlobTx.Blobs[0]
+		invalidBlob.ShareVersion = math.MaxUint8 + 1
+		invalidBlobTx.Blobs = []*tmproto.Blob{&invalidBlob}
+		require.False(t, builder.AppendBlobTx(invalidBlobTx))
+	}
+	blobTx, isBlobTx := coretypes.UnmarshalBlobTx(txs[0])
+	require.True(t, isBlobTx)
+	require.True(t, builder.AppendBlobTx(blobTx))
+
+	dataSquare, err := builder.Export()
+	require.NoError(t, err)
+	constructed, err := square.Construct(txs[:1], v2.Version, appconsts.DefaultSquareSizeUpperBound)
+	require.NoError(t, err)
+	require.True(t, dataSquare.Equals(constructed))
+}
+
+// TestBuilderRejectsBlobTxsWithoutSigner tests that a blob tx with a share
+// version one blob whose signer can't be read from the tx is rejected, and
+// that its PFB space is only freed from app version 2.
+func TestBuilderRejectsBlobTxsWithoutSigner(t *testing.T) {
+	ns1 := ns.MustNewV0(bytes.Repeat([]byte{1}, ns.NamespaceVersionZeroIDSize))
+	txs := generateBlobTxsWithNamespaces(t, ns1.Repeat(1), [][]int{{100}})
+	require.Len(t, txs, 1)
+
+	testCases := []struct {
+		name       string
+		appVersion uint64
+		// freed is whether the space of the rejected txs is freed
+		freed bool
+	}{
+		{name: "v1", appVersion: v1.Version, freed: false},
+		{name: "v2", appVersion: v2.Version, freed: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			builder, err := square.NewBuilderWithOptions(appconsts.DefaultSquareSizeUpperBound, appconsts.DefaultSubtreeRootThreshold,
+				square.WithAppVersion(tc.appVersion))
+			require.NoError(t, err)
+			invalidBlobTx, isBlobTx := coretypes.UnmarshalBlobTx(txs[0])
+			require.True(t, isBlobTx)
+			invalidBlob := *invalidBlobTx.Blobs[0]
+			invalidBlob.ShareVersion = uint32(appconsts.ShareVersionOne)
+			invalidBlobTx.Blobs = []*tmproto.Blob{&invalidBlob}
+			// the signer of the PFB can't be read from an undecodable tx
+			invalidBlobTx.Tx = make([]byte, 300)
+			require.False(t, builder.AppendBlobTx(invalidBlobTx))
+
+			blobTx, isBlobTx := coretypes.UnmarshalBlobTx(txs[0])
+			require.True(t, isBlobTx)
+			require.True(t, builder.AppendBlobTx(blobTx))
+
+			dataSquare, err := builder.Export()
+			require.NoError(t, err)
+			constructed, err := square.Construct(txs, tc.appVersion, appconsts.DefaultSquareSizeUpperBound)
+			require.NoError(t, err)
+			require.Equal(t, tc.freed, dataSquare.Equals(constructed))
+		})
+	}
+}
+
 func TestBuilderInvalidConstructor(t *testing.T) {
 	_, err := square.NewBuilder(-4, appconsts.DefaultSubtreeRootThreshold)
 	require.Error(t, err)
@@ -0,0 +1,144 @@
+package square
+
+import (
+	"fmt"
+
+	"github.com/celestiaorg/celestia-app/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/pkg/namespace"
+	"github.com/celestiaorg/celestia-app/pkg/shares"
+)
+
+// PaddingType is the kind of padding that a share is used for.
+type PaddingType string
+
+const (
+	// NoPadding is used for shares that contain data.
+	NoPadding PaddingType = ""
+	// NamespacePadding is used for the padding shares between blobs that
+	// align each blob to its subtree root boundary.
+	NamespacePadding PaddingType = "namespace"
+	// ReservedPadding is used for the padding shares between the compact
+	// shares of the reserved namespaces and the first blob.
+	ReservedPadding PaddingType = "reserved"
+	// TailPadding is used for the padding shares that fill the square after
+	// the last blob.
+	TailPadding PaddingType = "tail"
+)
+
+// ShareLayout describes the content of a share in a square and the
+// transaction or blob it belongs to.
+type ShareLayout struct {
+	// Index is the index of the share in the square.
+	Index     int
+	Namespace namespace.Namespace
+	// SequenceStart is true if the share is the first share of a sequence.
+	SequenceStart bool
+	// Compact is true for the shares of the transactions and the PFBs, and
+	// false for blob and padding shares.
+	Compact bool
+	Padding PaddingType
+	// TxIndexes are the indexes of the transactions that are at least
+	// partially contained in a compact share. For a blob share, it contains
+	// the index of the PFB that paid for the blob. It is empty for padding
+	// shares.
+	TxIndexes []int
+	// BlobIndex is the index of the blob within the PFB that paid for it or
+	// -1 if the share isn't a blob share.
+	BlobIndex int
+}
+
+// Layout constructs the square of the exact list of ordered transactions, see
+// Construct, and describes each of its shares.
+func Layout(txs [][]byte, appVersion uint64, maxSquareSize int) (Square, []ShareLayout, error) {
+	builder, err := NewBuilder(maxSquareSize, appconsts.SubtreeRootThreshold(appVersion), txs...)
+	if err != nil {
+		return nil, nil, err
+	}
+	square, err := builder.Export()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	layout := make([]ShareLayout, len(square))
+	for i := range square {
+		layout[i], err = shareLayout(i, square[i])
+		if err != nil {
+			return nil, nil, fmt.Errorf("share %d: %w", i, err)
+		}
+	}
+
+	for txIndex := 0; txIndex < builder.NumTxs(); txIndex++ {
+		txRange, err := builder.FindTxShareRange(txIndex)
+		if err != nil {
+			return nil, nil, err
+		}
+		for i := txRange.Start; i < txRange.End; i++ {
+			layout[i].TxIndexes = append(layout[i].TxIndexes, txIndex)
+		}
+		if txIndex < len(builder.txs) {
+			continue
+		}
+
+		pfb, err := builder.GetWrappedPFB(txIndex)
+		if err != nil {
+			return nil, nil, err
+		}
+		for blobIndex := range pfb.ShareIndexes {
+			start, err := builder.FindBlobStartingIndex(txIndex, blobIndex)
+			if err != nil {
+				return nil, nil, err
+			}
+			length, err := builder.BlobShareLength(txIndex, blobIndex)
+			if err != nil {
+				return nil, nil, err
+			}
+			for i := start; i < start+length; i++ {
+				layout[i].TxIndexes = []int{txIndex}
+				layout[i].BlobIndex = blobIndex
+			}
+		}
+	}
+
+	return square, layout, nil
+}
+
+// shareLayout describes the share at index without the transaction or blob
+// it belongs to.
+func shareLayout(index int, share shares.Share) (ShareLayout, error) {
+	ns, err := share.Namespace()
+	if err != nil {
+		return ShareLayout{}, err
+	}
+	isSequenceStart, err := share.IsSequenceStart()
+	if err != nil {
+		return ShareLayout{}, err
+	}
+	isCompact, err := share.IsCompactShare()
+	if err != nil {
+		return ShareLayout{}, err
+	}
+	isPadding, err := share.IsPadding()
+	if err != nil {
+		return ShareLayout{}, err
+	}
+
+	padding := NoPadding
+	switch {
+	case !isPadding:
+	case ns.IsTailPadding():
+		padding = TailPadding
+	case ns.IsReservedPadding():
+		padding = ReservedPadding
+	default:
+		padding = NamespacePadding
+	}
+
+	return ShareLayout{
+		Index:         index,
+		Namespace:     ns,
+		SequenceStart: isSequenceStart,
+		Compact:       isCompact,
+		Padding:       padding,
+		BlobIndex:     -1,
+	}, nil
+}
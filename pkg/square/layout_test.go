@@ -0,0 +1,68 @@
+package square_test
+
+import (
+	"testing"
+
+	"github.com/celestiaorg/celestia-app/app"
+	"github.com/celestiaorg/celestia-app/app/encoding"
+	"github.com/celestiaorg/celestia-app/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/pkg/square"
+	"github.com/celestiaorg/celestia-app/test/util/blobfactory"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	coretypes "github.com/tendermint/tendermint/types"
+)
+
+func TestLayout(t *testing.T) {
+	encCfg := encoding.MakeConfig(app.ModuleEncodingRegisters...)
+	normalTxs := coretypes.Txs(blobfactory.GenerateManyRawSendTxs(encCfg.TxConfig, 5)).ToSliceOfBytes()
+	blobTxs := blobfactory.RandBlobTxsRandomlySized(encCfg.TxConfig.TxEncoder(), 5, 2000, 3).ToSliceOfBytes()
+	txs := append(normalTxs, blobTxs...)
+
+	dataSquare, layout, err := square.Layout(txs, appconsts.LatestVersion, appconsts.DefaultSquareSizeUpperBound)
+	require.NoError(t, err)
+	expectedSquare, err := square.Construct(txs, appconsts.LatestVersion, appconsts.DefaultSquareSizeUpperBound)
+	require.NoError(t, err)
+	require.True(t, expectedSquare.Equals(dataSquare))
+	require.Len(t, layout, len(dataSquare))
+
+	for i, share := range layout {
+		assert.Equal(t, i, share.Index)
+		if share.Padding != square.NoPadding {
+			assert.Empty(t, share.TxIndexes)
+			assert.Equal(t, -1, share.BlobIndex)
+			continue
+		}
+		require.NotEmpty(t, share.TxIndexes, "share %d", i)
+		assert.Equal(t, share.Compact, share.BlobIndex == -1)
+	}
+
+	for txIndex := range txs {
+		txRange, err := square.TxShareRange(txs, txIndex, appconsts.LatestVersion)
+		require.NoError(t, err)
+		for i := txRange.Start; i < txRange.End; i++ {
+			assert.Contains(t, layout[i].TxIndexes, txIndex)
+			assert.True(t, layout[i].Compact)
+		}
+		assert.True(t, layout[txRange.Start].SequenceStart || len(layout[txRange.Start].TxIndexes) > 1)
+
+		blobTx, isBlobTx := coretypes.UnmarshalBlobTx(txs[txIndex])
+		if !isBlobTx {
+			continue
+		}
+		for blobIndex := range blobTx.Blobs {
+			blobRange, err := square.BlobShareRange(txs, txIndex, blobIndex, appconsts.LatestVersion)
+			require.NoError(t, err)
+			assert.True(t, layout[blobRange.Start].SequenceStart)
+			for i := blobRange.Start; i < blobRange.End; i++ {
+				assert.Equal(t, []int{txIndex}, layout[i].TxIndexes)
+				assert.Equal(t, blobIndex, layout[i].BlobIndex)
+			}
+		}
+	}
+
+	_, layout, err = square.Layout(nil, appconsts.LatestVersion, appconsts.DefaultSquareSizeUpperBound)
+	require.NoError(t, err)
+	require.Len(t, layout, 1)
+	assert.Equal(t, square.TailPadding, layout[0].Padding)
+}
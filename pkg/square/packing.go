@@ -0,0 +1,251 @@
+package square
+
+import (
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/celestiaorg/celestia-app/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/pkg/shares"
+	"github.com/celestiaorg/celestia-app/x/blob/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/tendermint/tendermint/pkg/consts"
+	coretypes "github.com/tendermint/tendermint/proto/tendermint/types"
+)
+
+// PackingStrategy determines which blob transactions BuildPrioritized
+// appends to the square, and in which order, when not all of them may fit.
+type PackingStrategy uint8
+
+const (
+	// GasPricePacking appends blob transactions in order of gas price. This
+	// is the default strategy.
+	GasPricePacking PackingStrategy = iota
+	// MaxFeePacking selects the set of blob transactions that pays the highest
+	// total fee among the sets that fit in the space left after the normal
+	// transactions. Each blob transaction occupies the shares it would take
+	// in the worst case, including the padding that the non-interactive
+	// default rules may require before each blob and the space taken by the
+	// PFB itself. The selected transactions are appended in order of fee per
+	// share, after which the remaining transactions are appended wherever
+	// they still fit so that the space left by the worst case estimates is
+	// filled as well. A signer's transactions are only selected in sequence
+	// order. The selection is exact among the signers whose first transaction
+	// pays the most per share, up to a number that decreases with the space
+	// left in the square. See packingCellsPerShare.
+	MaxFeePacking
+)
+
+// packingCellsPerShare bounds the size of the knapsack solved by
+// MaxFeePacking relative to the maximum number of shares of the square: the
+// number of signers times the number of shares left in the square doesn't
+// exceed packingCellsPerShare times the maximum number of shares. This keeps
+// the memory of the selection at 64 bytes per share, i.e. 1 MiB in a square
+// of size 128, and its time in the order of milliseconds.
+const packingCellsPerShare = 32
+
+// WithPackingStrategy sets the strategy used by BuildPrioritized to select and
+// order blob transactions.
+func WithPackingStrategy(strategy PackingStrategy) BuilderOption {
+	return func(b *Builder) {
+		b.packingStrategy = strategy
+	}
+}
+
+// packMaxFee returns the blob transactions in the order in which they should
+// be appended to the builder so that the fee paid by the transactions that
+// fit is maximised. Transactions of the dropped signers are ignored.
+//
+// The selection is a knapsack over the shares left in the square where the
+// transactions of a signer form a group of which only a prefix, in sequence
+// order, may be chosen. It takes O(capacity * transactions) time and
+// O(capacity * signers) memory. If there are too many signers for the bound
+// set by packingCellsPerShare, only the groups whose first transaction pays
+// the most per share are considered and the others are appended after the
+// selected transactions in order of fee per share.
+func (b *Builder) packMaxFee(txs []*prioritizedTx, dropped map[string]bool) []*prioritizedTx {
+	capacity := b.maxCapacity - b.currentSize
+	if b.pfbCounter.Size() == 0 {
+		// the first compact share holds fewer bytes than the continuation
+		// shares that the PFBs are counted in
+		capacity--
+	}
+	if capacity <= 0 {
+		return txs
+	}
+
+	groups := groupBySigner(txs, dropped)
+	// the fee of each item is capped so that the sum of all the selected
+	// fees, each of which occupies at least one share, can't overflow
+	maxFee := int64(math.MaxInt64 / (capacity + 1))
+	items := make([][]packingItem, len(groups))
+	totalCost := 0
+	for i, group := range groups {
+		items[i] = make([]packingItem, len(group))
+		for j, tx := range group {
+			fee := tx.gasPrice.MulInt(sdk.NewIntFromUint64(tx.gas)).TruncateInt()
+			item := packingItem{fee: maxFee}
+			if fee.IsInt64() && fee.Int64() < maxFee {
+				item.fee = fee.Int64()
+			}
+			if cost, ok := b.blobTxShareCost(tx.blobTx); ok {
+				item.cost = cost
+				tx.priority = sdk.NewDec(item.fee).QuoInt64(int64(cost))
+			} else {
+				// the blobs are invalid so the tx will never fit
+				item.cost = capacity + 1
+				tx.priority = sdk.ZeroDec()
+			}
+			items[i][j] = item
+			totalCost += item.cost
+		}
+	}
+
+	selected := make(map[*prioritizedTx]bool, len(txs))
+	if totalCost <= capacity {
+		// every transaction fits even in the worst case
+		for _, tx := range txs {
+			selected[tx] = true
+		}
+	} else {
+		candidates := packingCandidates(groups, packingCellsPerShare*b.maxCapacity/(capacity+1))
+		candidateItems := make([][]packingItem, len(candidates))
+		for i, group := range candidates {
+			candidateItems[i] = items[group]
+		}
+		for i, n := range selectPrefixes(candidateItems, capacity) {
+			for _, tx := range groups[candidates[i]][:n] {
+				selected[tx] = true
+			}
+		}
+	}
+
+	chosen := make([]*prioritizedTx, 0, len(txs))
+	rest := make([]*prioritizedTx, 0, len(txs))
+	for _, tx := range txs {
+		if selected[tx] {
+			chosen = append(chosen, tx)
+		} else {
+			rest = append(rest, tx)
+		}
+	}
+	return append(orderByPriority(chosen), orderByPriority(rest)...)
+}
+
+// packingCandidates returns the indexes of at most limit groups, preferring
+// the groups whose first transaction pays the most per share.
+func packingCandidates(groups [][]*prioritizedTx, limit int) []int {
+	candidates := make([]int, len(groups))
+	for i := range groups {
+		candidates[i] = i
+	}
+	if len(candidates) <= limit {
+		return candidates
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return groups[candidates[i]][0].priority.GT(groups[candidates[j]][0].priority)
+	})
+	candidates = candidates[:limit]
+	sort.Ints(candidates)
+	return candidates
+}
+
+// packingItem is a blob transaction as seen by the knapsack: the number of
+// shares it occupies in the worst case and the fee it pays.
+type packingItem struct {
+	cost int
+	fee  int64
+}
+
+// selectPrefixes solves the grouped knapsack over the provided capacity. It
+// returns, for each group, the number of leading items that are selected.
+func selectPrefixes(groups [][]packingItem, capacity int) []int {
+	// best[c] is the highest fee of the groups seen so far using at most c
+	// shares. choice[i][c] is the number of items of group i selected for it.
+	best := make([]int64, capacity+1)
+	next := make([]int64, capacity+1)
+	choice := make([][]uint16, len(groups))
+	for i, group := range groups {
+		choice[i] = make([]uint16, capacity+1)
+		copy(next, best)
+		cost, fee := 0, int64(0)
+		for n := 1; n <= len(group) && n <= math.MaxUint16; n++ {
+			cost += group[n-1].cost
+			fee += group[n-1].fee
+			if cost > capacity {
+				break
+			}
+			for c := cost; c <= capacity; c++ {
+				if candidate := best[c-cost] + fee; candidate > next[c] {
+					next[c] = candidate
+					choice[i][c] = uint16(n)
+				}
+			}
+		}
+		best, next = next, best
+	}
+
+	counts := make([]int, len(groups))
+	c := capacity
+	for i := len(groups) - 1; i >= 0; i-- {
+		n := int(choice[i][c])
+		counts[i] = n
+		for _, item := range groups[i][:n] {
+			c -= item.cost
+		}
+	}
+	return counts
+}
+
+// groupBySigner groups the transactions by signer, each group being sorted by
+// sequence. Transactions without a known signer form a group of their own.
+func groupBySigner(txs []*prioritizedTx, dropped map[string]bool) [][]*prioritizedTx {
+	index := make(map[string]int)
+	groups := make([][]*prioritizedTx, 0, len(txs))
+	for _, tx := range txs {
+		if dropped[tx.signer] {
+			continue
+		}
+		key := tx.signer
+		if key == "" {
+			key = fmt.Sprintf("index-%d", tx.index)
+		}
+		i, ok := index[key]
+		if !ok {
+			i = len(groups)
+			index[key] = i
+			groups = append(groups, nil)
+		}
+		groups[i] = append(groups[i], tx)
+	}
+	for _, group := range groups {
+		sort.SliceStable(group, func(i, j int) bool {
+			return group[i].sequence < group[j].sequence
+		})
+	}
+	return groups
+}
+
+// blobTxShareCost returns the number of shares the blob tx would occupy in the
+// worst case and whether its blobs are valid. The PFB is counted in whole
+// continuation compact shares. Together with the share that packMaxFee
+// reserves for the shorter first compact share, the PFBs never occupy more
+// shares than they are counted for, however they are combined.
+func (b *Builder) blobTxShareCost(blobTx coretypes.BlobTx) (int, bool) {
+	iw := &coretypes.IndexWrapper{
+		Tx:           blobTx.Tx,
+		TypeId:       consts.ProtoIndexWrapperTypeID,
+		ShareIndexes: worstCaseShareIndexes(len(blobTx.Blobs), b.maxCapacity),
+	}
+	size := iw.Size()
+	size += shares.DelimLen(uint64(size))
+	cost := (size + appconsts.ContinuationCompactShareContentSize - 1) / appconsts.ContinuationCompactShareContentSize
+	for idx, blobProto := range blobTx.Blobs {
+		blob, err := types.BlobFromProto(blobProto)
+		if err != nil {
+			return 0, false
+		}
+		cost += newElement(blob, 0, idx, b.subtreeRootThreshold).maxShareOffset()
+	}
+	return cost, true
+}
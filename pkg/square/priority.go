@@ -0,0 +1,221 @@
+package square
+
+import (
+	"container/heap"
+	"fmt"
+	"sort"
+
+	"github.com/celestiaorg/celestia-app/pkg/appconsts"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	coretypes "github.com/tendermint/tendermint/proto/tendermint/types"
+	core "github.com/tendermint/tendermint/types"
+)
+
+// BuildPrioritized behaves like Build but, rather than appending transactions
+// in the order they were provided, it first orders the normal and the blob
+// transactions by gas price. Transactions from the same signer always remain
+// in sequence (nonce) order. If a transaction does not fit in the square, all
+// later transactions from the same signer are dropped as they would otherwise
+// fail with an invalid sequence. Transactions that can not be decoded are
+// given the lowest priority.
+//
+// The options configure the builder, e.g. which blob transactions are
+// appended to the square and in which order. See PackingStrategy.
+func BuildPrioritized(txs [][]byte, decoder sdk.TxDecoder, appVersion uint64, maxSquareSize int, opts ...BuilderOption) (Square, [][]byte, error) {
+	opts = append([]BuilderOption{WithAppVersion(appVersion)}, opts...)
+	builder, err := NewBuilderWithOptions(maxSquareSize, appconsts.SubtreeRootThreshold(appVersion), opts...)
+	if err != nil {
+		return nil, nil, err
+	}
+	normalTxs, blobTxs := prioritizeTxs(txs, decoder)
+
+	// dropped keeps track of signers that had a transaction which didn't fit
+	// in the square.
+	dropped := make(map[string]bool)
+	included := make([][]byte, 0, len(normalTxs)+len(blobTxs))
+	for _, ptx := range normalTxs {
+		if dropped[ptx.signer] {
+			continue
+		}
+		if builder.AppendTx(ptx.tx) {
+			included = append(included, ptx.tx)
+		} else if ptx.signer != "" {
+			dropped[ptx.signer] = true
+		}
+	}
+	if builder.packingStrategy == MaxFeePacking {
+		blobTxs = builder.packMaxFee(blobTxs, dropped)
+	}
+	for _, ptx := range blobTxs {
+		if dropped[ptx.signer] {
+			continue
+		}
+		if builder.AppendBlobTx(ptx.blobTx) {
+			included = append(included, ptx.tx)
+		} else if ptx.signer != "" {
+			dropped[ptx.signer] = true
+		}
+	}
+	square, err := builder.Export()
+	return square, included, err
+}
+
+// prioritizedTx is a transaction annotated with the information needed to
+// order it within a square.
+type prioritizedTx struct {
+	tx       []byte
+	blobTx   coretypes.BlobTx
+	signer   string
+	sequence uint64
+	gasPrice sdk.Dec
+	gas      uint64
+	// priority determines the order in which txs are appended. Unless
+	// otherwise specified, it is the gas price.
+	priority sdk.Dec
+	// index is the position of the tx in the original list. It is used to
+	// break ties so that the ordering is deterministic.
+	index int
+}
+
+// prioritizeTxs separates the normal and blob transactions and orders each
+// set by gas price while preserving the sequence order of each signer.
+func prioritizeTxs(txs [][]byte, decoder sdk.TxDecoder) (normalTxs, blobTxs []*prioritizedTx) {
+	normalTxs = make([]*prioritizedTx, 0, len(txs))
+	blobTxs = make([]*prioritizedTx, 0, len(txs))
+	for idx, tx := range txs {
+		ptx := &prioritizedTx{tx: tx, index: idx, gasPrice: sdk.ZeroDec(), priority: sdk.ZeroDec()}
+		sdkTxBytes := tx
+		blobTx, isBlobTx := core.UnmarshalBlobTx(tx)
+		if isBlobTx {
+			ptx.blobTx = blobTx
+			sdkTxBytes = blobTx.Tx
+		}
+		if sdkTx, err := decoder(sdkTxBytes); err == nil {
+			ptx.signer, ptx.sequence = signerAndSequence(sdkTx)
+			ptx.gasPrice = GasPrice(sdkTx)
+			ptx.priority = ptx.gasPrice
+			if feeTx, ok := sdkTx.(sdk.FeeTx); ok {
+				ptx.gas = feeTx.GetGas()
+			}
+		}
+		if isBlobTx {
+			blobTxs = append(blobTxs, ptx)
+		} else {
+			normalTxs = append(normalTxs, ptx)
+		}
+	}
+	return orderByPriority(normalTxs), orderByPriority(blobTxs)
+}
+
+// GasPrice returns the gas price of a transaction. If the fee consists of
+// multiple denominations, the lowest gas price is returned, mirroring how the
+// SDK calculates the priority of a transaction. Transactions without a fee or
+// gas limit have a gas price of zero.
+func GasPrice(tx sdk.Tx) sdk.Dec {
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok || feeTx.GetGas() == 0 || feeTx.GetFee().IsZero() {
+		return sdk.ZeroDec()
+	}
+	gas := sdk.NewIntFromUint64(feeTx.GetGas())
+	var gasPrice sdk.Dec
+	for i, coin := range feeTx.GetFee() {
+		price := sdk.NewDecFromInt(coin.Amount).QuoInt(gas)
+		if i == 0 || price.LT(gasPrice) {
+			gasPrice = price
+		}
+	}
+	return gasPrice
+}
+
+// signerAndSequence returns the first signer of the transaction and the
+// sequence of its signature. An empty signer is returned if it can not be
+// determined.
+func signerAndSequence(tx sdk.Tx) (string, uint64) {
+	sigTx, ok := tx.(authsigning.SigVerifiableTx)
+	if !ok {
+		return "", 0
+	}
+	signers := sigTx.GetSigners()
+	sigs, err := sigTx.GetSignaturesV2()
+	if err != nil || len(signers) == 0 || len(sigs) == 0 {
+		return "", 0
+	}
+	return signers[0].String(), sigs[0].Sequence
+}
+
+// orderByPriority orders the transactions by descending priority. Each
+// signer's transactions are first sorted by sequence and then merged so that
+// a transaction is never placed before one from the same signer with a lower
+// sequence.
+func orderByPriority(txs []*prioritizedTx) []*prioritizedTx {
+	queues := make(map[string]*signerQueue)
+	txQueues := &signerQueues{}
+	for _, tx := range txs {
+		key := tx.signer
+		if key == "" {
+			// txs without a known signer are independent of one another
+			key = fmt.Sprintf("index-%d", tx.index)
+		}
+		q, ok := queues[key]
+		if !ok {
+			q = &signerQueue{}
+			queues[key] = q
+			*txQueues = append(*txQueues, q)
+		}
+		q.txs = append(q.txs, tx)
+	}
+	for _, q := range *txQueues {
+		sort.SliceStable(q.txs, func(i, j int) bool {
+			return q.txs[i].sequence < q.txs[j].sequence
+		})
+	}
+
+	heap.Init(txQueues)
+	ordered := make([]*prioritizedTx, 0, len(txs))
+	for txQueues.Len() > 0 {
+		q := (*txQueues)[0]
+		ordered = append(ordered, q.txs[0])
+		q.txs = q.txs[1:]
+		if len(q.txs) == 0 {
+			heap.Pop(txQueues)
+		} else {
+			heap.Fix(txQueues, 0)
+		}
+	}
+	return ordered
+}
+
+// signerQueue is the sequence ordered list of pending transactions of a
+// single signer.
+type signerQueue struct {
+	txs []*prioritizedTx
+}
+
+// signerQueues implements heap.Interface, ordering the queues by the priority
+// of the next transaction in each queue.
+type signerQueues []*signerQueue
+
+var _ heap.Interface = (*signerQueues)(nil)
+
+func (s signerQueues) Len() int { return len(s) }
+
+func (s signerQueues) Less(i, j int) bool {
+	a, b := s[i].txs[0], s[j].txs[0]
+	if !a.priority.Equal(b.priority) {
+		return a.priority.GT(b.priority)
+	}
+	return a.index < b.index
+}
+
+func (s signerQueues) Swap(i, j int) { s[i], s[j] = s[j], s[i] }
+
+func (s *signerQueues) Push(x any) { *s = append(*s, x.(*signerQueue)) }
+
+func (s *signerQueues) Pop() any {
+	old := *s
+	n := len(old)
+	q := old[n-1]
+	*s = old[:n-1]
+	return q
+}
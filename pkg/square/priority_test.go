@@ -0,0 +1,214 @@
+package square_test
+
+import (
+	"testing"
+
+	"github.com/celestiaorg/celestia-app/app"
+	"github.com/celestiaorg/celestia-app/app/encoding"
+	"github.com/celestiaorg/celestia-app/pkg/appconsts"
+	ns "github.com/celestiaorg/celestia-app/pkg/namespace"
+	"github.com/celestiaorg/celestia-app/pkg/shares"
+	"github.com/celestiaorg/celestia-app/pkg/square"
+	"github.com/celestiaorg/celestia-app/test/util/blobfactory"
+	"github.com/celestiaorg/celestia-app/test/util/testfactory"
+	blobtypes "github.com/celestiaorg/celestia-app/x/blob/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+	coretypes "github.com/tendermint/tendermint/types"
+)
+
+const priorityTestGasLimit = 100000
+
+func TestBuildPrioritized(t *testing.T) {
+	encCfg := encoding.MakeConfig(app.ModuleEncodingRegisters...)
+	kr := testfactory.GenerateKeyring("a", "b", "c")
+	signerA := blobtypes.NewKeyringSigner(kr, "a", "chainid")
+	signerB := blobtypes.NewKeyringSigner(kr, "b", "chainid")
+	signerC := blobtypes.NewKeyringSigner(kr, "c", "chainid")
+
+	t.Run("higher gas price blob txs are included first", func(t *testing.T) {
+		low := priorityBlobTx(t, encCfg, signerA, 0, 1, 6)
+		mid := priorityBlobTx(t, encCfg, signerB, 0, 2, 6)
+		high := priorityBlobTx(t, encCfg, signerC, 0, 3, 6)
+
+		// a 4x4 square only has space for two of the blobs
+		dataSquare, txs, err := square.BuildPrioritized([][]byte{low, mid, high}, encCfg.TxConfig.TxDecoder(), appconsts.LatestVersion, 4)
+		require.NoError(t, err)
+		require.Equal(t, [][]byte{high, mid}, txs)
+
+		constructed, err := square.Construct(txs, appconsts.LatestVersion, 4)
+		require.NoError(t, err)
+		require.True(t, dataSquare.Equals(constructed))
+	})
+
+	t.Run("sequence order of a signer is preserved", func(t *testing.T) {
+		a0 := priorityNormalTx(t, encCfg, signerA, 0, 1)
+		a1 := priorityNormalTx(t, encCfg, signerA, 1, 3)
+		b0 := priorityNormalTx(t, encCfg, signerB, 0, 2)
+
+		_, txs, err := square.BuildPrioritized([][]byte{a1, b0, a0}, encCfg.TxConfig.TxDecoder(), appconsts.LatestVersion, appconsts.DefaultSquareSizeUpperBound)
+		require.NoError(t, err)
+		require.Equal(t, [][]byte{b0, a0, a1}, txs)
+	})
+
+	t.Run("normal txs are placed before blob txs", func(t *testing.T) {
+		normalTx := priorityNormalTx(t, encCfg, signerA, 0, 1)
+		blobTx := priorityBlobTx(t, encCfg, signerB, 0, 10, 1)
+
+		_, txs, err := square.BuildPrioritized([][]byte{blobTx, normalTx}, encCfg.TxConfig.TxDecoder(), appconsts.LatestVersion, appconsts.DefaultSquareSizeUpperBound)
+		require.NoError(t, err)
+		require.Equal(t, [][]byte{normalTx, blobTx}, txs)
+	})
+
+	t.Run("later txs of a signer are dropped if an earlier one doesn't fit", func(t *testing.T) {
+		a0 := priorityBlobTx(t, encCfg, signerA, 0, 3, 10)
+		b0 := priorityBlobTx(t, encCfg, signerB, 0, 2, 6)
+		b1 := priorityBlobTx(t, encCfg, signerB, 1, 5, 1)
+
+		_, txs, err := square.BuildPrioritized([][]byte{a0, b0, b1}, encCfg.TxConfig.TxDecoder(), appconsts.LatestVersion, 4)
+		require.NoError(t, err)
+		require.Equal(t, [][]byte{a0}, txs)
+	})
+
+	t.Run("undecodable txs have the lowest priority", func(t *testing.T) {
+		invalidTx := []byte{1, 2, 3}
+		normalTx := priorityNormalTx(t, encCfg, signerA, 0, 1)
+
+		_, txs, err := square.BuildPrioritized([][]byte{invalidTx, normalTx}, encCfg.TxConfig.TxDecoder(), appconsts.LatestVersion, appconsts.DefaultSquareSizeUpperBound)
+		require.NoError(t, err)
+		require.Equal(t, [][]byte{normalTx, invalidTx}, txs)
+	})
+}
+
+func TestGasPrice(t *testing.T) {
+	encCfg := encoding.MakeConfig(app.ModuleEncodingRegisters...)
+	kr := testfactory.GenerateKeyring("a")
+	signer := blobtypes.NewKeyringSigner(kr, "a", "chainid")
+
+	rawTx := priorityNormalTx(t, encCfg, signer, 0, 50)
+	sdkTx, err := encCfg.TxConfig.TxDecoder()(rawTx)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDecWithPrec(5, 4), square.GasPrice(sdkTx))
+}
+
+// priorityBlobTx returns a blob tx with a single blob that occupies the
+// provided number of shares and pays the provided fee.
+func priorityBlobTx(t testing.TB, encCfg encoding.Config, signer *blobtypes.KeyringSigner, sequence uint64, fee int64, shareCount int) []byte {
+	addr, err := signer.GetSignerInfo().GetAddress()
+	require.NoError(t, err)
+	blobs := blobfactory.RandBlobsWithNamespace(ns.RandomBlobNamespaces(1), []int{shares.AvailableBytesFromSparseShares(shareCount)})
+	msg, err := blobtypes.NewMsgPayForBlobs(addr.String(), blobs...)
+	require.NoError(t, err)
+
+	signer.SetSequence(sequence)
+	rawTx := blobfactory.CreateRawTx(encCfg.TxConfig, msg, signer, priorityTxOpts(fee)...)
+	blobTx, err := coretypes.MarshalBlobTx(rawTx, blobs...)
+	require.NoError(t, err)
+	return blobTx
+}
+
+// priorityNormalTx returns a send tx that pays the provided fee.
+func priorityNormalTx(t testing.TB, encCfg encoding.Config, signer *blobtypes.KeyringSigner, sequence uint64, fee int64) []byte {
+	addr, err := signer.GetSignerInfo().GetAddress()
+	require.NoError(t, err)
+	msg := banktypes.NewMsgSend(addr, addr, sdk.NewCoins(sdk.NewCoin(app.BondDenom, sdk.NewInt(1))))
+	signer.SetSequence(sequence)
+	return blobfactory.CreateRawTx(encCfg.TxConfig, msg, signer, priorityTxOpts(fee)...)
+}
+
+func priorityTxOpts(fee int64) []blobtypes.TxBuilderOption {
+	return []blobtypes.TxBuilderOption{
+		blobtypes.SetFeeAmount(sdk.NewCoins(sdk.NewCoin(app.BondDenom, sdk.NewInt(fee)))),
+		blobtypes.SetGasLimit(priorityTestGasLimit),
+	}
+}
+
+func TestBuildPrioritizedMaxFeePacking(t *testing.T) {
+	encCfg := encoding.MakeConfig(app.ModuleEncodingRegisters...)
+	kr := testfactory.GenerateKeyring("a", "b", "c")
+	signerA := blobtypes.NewKeyringSigner(kr, "a", "chainid")
+	signerB := blobtypes.NewKeyringSigner(kr, "b", "chainid")
+	signerC := blobtypes.NewKeyringSigner(kr, "c", "chainid")
+
+	t.Run("smaller blobs that pay more in total are preferred", func(t *testing.T) {
+		large := priorityBlobTx(t, encCfg, signerA, 0, 12, 12)
+		smallOne := priorityBlobTx(t, encCfg, signerB, 0, 10, 6)
+		smallTwo := priorityBlobTx(t, encCfg, signerC, 0, 10, 6)
+		txs := [][]byte{large, smallOne, smallTwo}
+
+		// the large blob pays the highest gas price so it is chosen first by
+		// the default strategy, leaving no space for the smaller blobs
+		_, included, err := square.BuildPrioritized(txs, encCfg.TxConfig.TxDecoder(), appconsts.LatestVersion, 4)
+		require.NoError(t, err)
+		require.Equal(t, [][]byte{large}, included)
+
+		dataSquare, included, err := square.BuildPrioritized(txs, encCfg.TxConfig.TxDecoder(), appconsts.LatestVersion, 4,
+			square.WithPackingStrategy(square.MaxFeePacking))
+		require.NoError(t, err)
+		require.Equal(t, [][]byte{smallOne, smallTwo}, included)
+
+		// the square must be reproducible from the included txs
+		constructed, err := square.Construct(included, appconsts.LatestVersion, 4)
+		require.NoError(t, err)
+		require.True(t, dataSquare.Equals(constructed))
+	})
+
+	t.Run("the set with the highest fee beats the highest fee per share", func(t *testing.T) {
+		// the large blob pays the most per share but leaves too little space
+		// for either of the smaller blobs, which together pay more
+		large := priorityBlobTx(t, encCfg, signerA, 0, 22, 10)
+		smallOne := priorityBlobTx(t, encCfg, signerB, 0, 12, 6)
+		smallTwo := priorityBlobTx(t, encCfg, signerC, 0, 12, 6)
+		txs := [][]byte{large, smallOne, smallTwo}
+
+		dataSquare, included, err := square.BuildPrioritized(txs, encCfg.TxConfig.TxDecoder(), appconsts.LatestVersion, 4,
+			square.WithPackingStrategy(square.MaxFeePacking))
+		require.NoError(t, err)
+		require.Equal(t, [][]byte{smallOne, smallTwo}, included)
+
+		constructed, err := square.Construct(included, appconsts.LatestVersion, 4)
+		require.NoError(t, err)
+		require.True(t, dataSquare.Equals(constructed))
+	})
+
+	t.Run("only the best paying signers are selected beyond the knapsack bound", func(t *testing.T) {
+		// the knapsack of a square of size 4 considers at most 32 signers
+		accounts := testfactory.GenerateAccounts(40)
+		kr := testfactory.GenerateKeyring(accounts...)
+		txs := make([][]byte, len(accounts))
+		for i, account := range accounts {
+			signer := blobtypes.NewKeyringSigner(kr, account, "chainid")
+			txs[i] = priorityBlobTx(t, encCfg, signer, 0, int64(i+1), 1)
+		}
+
+		dataSquare, included, err := square.BuildPrioritized(txs, encCfg.TxConfig.TxDecoder(), appconsts.LatestVersion, 4,
+			square.WithPackingStrategy(square.MaxFeePacking))
+		require.NoError(t, err)
+		// each tx is counted for a compact and a sparse share out of the 15
+		// shares left after the one reserved for the first compact share, so
+		// the 7 best paying txs are selected and appended first
+		require.GreaterOrEqual(t, len(included), 7)
+		for i := 0; i < 7; i++ {
+			require.Equal(t, txs[len(txs)-1-i], included[i])
+		}
+
+		constructed, err := square.Construct(included, appconsts.LatestVersion, 4)
+		require.NoError(t, err)
+		require.True(t, dataSquare.Equals(constructed))
+	})
+
+	t.Run("a signer's txs are selected in sequence order", func(t *testing.T) {
+		// the second tx of signer a pays a lot but can only be included after
+		// its first tx, which pays little
+		first := priorityBlobTx(t, encCfg, signerA, 0, 1, 6)
+		second := priorityBlobTx(t, encCfg, signerA, 1, 100, 6)
+		other := priorityBlobTx(t, encCfg, signerB, 0, 50, 6)
+		txs := [][]byte{second, other, first}
+
+		_, included, err := square.BuildPrioritized(txs, encCfg.TxConfig.TxDecoder(), appconsts.LatestVersion, 4,
+			square.WithPackingStrategy(square.MaxFeePacking))
+		require.NoError(t, err)
+		require.Equal(t, [][]byte{first, second}, included)
+	})
+}
@@ -20,7 +20,7 @@ import (
 // not check the underlying validity of the transactions.
 // Errors should not occur and would reflect a violation in an invariant.
 func Build(txs [][]byte, appVersion uint64, maxSquareSize int) (Square, [][]byte, error) {
-	builder, err := NewBuilder(maxSquareSize, appconsts.SubtreeRootThreshold(appVersion))
+	builder, err := NewBuilderWithOptions(maxSquareSize, appconsts.SubtreeRootThreshold(appVersion), WithAppVersion(appVersion))
 	if err != nil {
 		return nil, nil, err
 	}
@@ -122,7 +122,7 @@ func Deconstruct(s Square, decoder types.TxDecoder) (core.Txs, error) {
 		if len(pfbMsgs) != 1 {
 			return nil, fmt.Errorf("expected PFB to have 1 message, but got %d", len(pfbMsgs))
 		}
-		pfb, isPfb := pfbMsgs[0].(*blob.MsgPayForBlobs)
+		pfb, isPfb := blob.PFBFromMsg(pfbMsgs[0])
 		if !isPfb {
 			return nil, fmt.Errorf("expected PFB message, but got %T", pfbMsgs[0])
 		}
@@ -132,7 +132,7 @@ func Deconstruct(s Square, decoder types.TxDecoder) (core.Txs, error) {
 
 		blobs := make([]*coreproto.Blob, len(wpfb.ShareIndexes))
 		for j, shareIndex := range wpfb.ShareIndexes {
-			end := int(shareIndex) + shares.SparseSharesNeeded(pfb.BlobSizes[j])
+			end := int(shareIndex) + shares.SparseSharesNeededForVersion(pfb.BlobSizes[j], uint8(pfb.ShareVersions[j]))
 			parsedBlobs, err := shares.ParseBlobs(s[shareIndex:end])
 			if err != nil {
 				return nil, err
@@ -4,9 +4,14 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/celestiaorg/celestia-app/app"
+	"github.com/celestiaorg/celestia-app/app/encoding"
 	"github.com/celestiaorg/celestia-app/pkg/appconsts"
 	"github.com/celestiaorg/celestia-app/pkg/square"
+	"github.com/celestiaorg/celestia-app/test/util/testfactory"
+	blobtypes "github.com/celestiaorg/celestia-app/x/blob/types"
 	"github.com/stretchr/testify/require"
+	tmrand "github.com/tendermint/tendermint/libs/rand"
 )
 
 func BenchmarkSquareConstruct(b *testing.B) {
@@ -45,3 +50,109 @@ func BenchmarkSquareBuild(b *testing.B) {
 		})
 	}
 }
+
+// BenchmarkPackingStrategies compares the fee revenue and the padding waste of
+// the squares built by the gas price and max fee packing strategies from the
+// same oversubscribed set of blob transactions.
+func BenchmarkPackingStrategies(b *testing.B) {
+	const (
+		signers      = 20
+		txsPerSigner = 15
+		squareSize   = 32
+	)
+	encCfg := encoding.MakeConfig(app.ModuleEncodingRegisters...)
+	accounts := testfactory.GenerateAccounts(signers)
+	kr := testfactory.GenerateKeyring(accounts...)
+	rand := tmrand.NewRand()
+	rand.Seed(1)
+
+	txs := make([][]byte, 0, signers*txsPerSigner)
+	fees := make(map[string]int64, signers*txsPerSigner)
+	for _, account := range accounts {
+		signer := blobtypes.NewKeyringSigner(kr, account, "chainid")
+		for sequence := 0; sequence < txsPerSigner; sequence++ {
+			// mostly small blobs with the occasional large one
+			shareCount := 1 + rand.Intn(8)
+			if rand.Intn(10) == 0 {
+				shareCount = 64 + rand.Intn(200)
+			}
+			fee := int64(1 + rand.Intn(1000))
+			tx := priorityBlobTx(b, encCfg, signer, uint64(sequence), fee, shareCount)
+			txs = append(txs, tx)
+			fees[string(tx)] = fee
+		}
+	}
+
+	strategies := []struct {
+		name     string
+		strategy square.PackingStrategy
+	}{
+		{"gas-price", square.GasPricePacking},
+		{"max-fee", square.MaxFeePacking},
+	}
+	for _, tt := range strategies {
+		b.Run(tt.name, func(b *testing.B) {
+			var (
+				dataSquare square.Square
+				included   [][]byte
+				err        error
+			)
+			for i := 0; i < b.N; i++ {
+				dataSquare, included, err = square.BuildPrioritized(txs, encCfg.TxConfig.TxDecoder(), appconsts.LatestVersion, squareSize,
+					square.WithPackingStrategy(tt.strategy))
+				require.NoError(b, err)
+			}
+			revenue := int64(0)
+			for _, tx := range included {
+				revenue += fees[string(tx)]
+			}
+			padding := 0
+			for _, share := range dataSquare {
+				isPadding, err := share.IsPadding()
+				require.NoError(b, err)
+				if isPadding {
+					padding++
+				}
+			}
+			b.ReportMetric(float64(revenue), "revenue")
+			b.ReportMetric(float64(padding), "padding_shares")
+			b.ReportMetric(float64(len(included)), "txs_included")
+		})
+	}
+}
+
+// BenchmarkMaxFeePackingLargeSquare measures the max fee packing strategy in a
+// square of the largest size oversubscribed by the blob transactions of
+// thousands of signers. In the larger sets, the knapsack is solved among the
+// signers whose first transaction pays the most per share.
+func BenchmarkMaxFeePackingLargeSquare(b *testing.B) {
+	squareSize := appconsts.DefaultSquareSizeUpperBound
+	encCfg := encoding.MakeConfig(app.ModuleEncodingRegisters...)
+	for _, signers := range []int{500, 2000, 5000} {
+		b.Run(fmt.Sprintf("signers=%d", signers), func(b *testing.B) {
+			accounts := testfactory.GenerateAccounts(signers)
+			kr := testfactory.GenerateKeyring(accounts...)
+			rand := tmrand.NewRand()
+			rand.Seed(1)
+
+			// the blobs take about one and a half times the square on average
+			meanShareCount := 3 * squareSize * squareSize / (2 * signers)
+			txs := make([][]byte, 0, signers)
+			for _, account := range accounts {
+				signer := blobtypes.NewKeyringSigner(kr, account, "chainid")
+				fee := int64(1 + rand.Intn(1000))
+				txs = append(txs, priorityBlobTx(b, encCfg, signer, 0, fee, 1+rand.Intn(2*meanShareCount)))
+			}
+
+			var included [][]byte
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				var err error
+				_, included, err = square.BuildPrioritized(txs, encCfg.TxConfig.TxDecoder(), appconsts.LatestVersion, squareSize,
+					square.WithPackingStrategy(square.MaxFeePacking))
+				require.NoError(b, err)
+			}
+			b.ReportMetric(float64(len(included)), "txs_included")
+		})
+	}
+}
@@ -199,6 +199,19 @@ func TestSquareDeconstruct(t *testing.T) {
 		require.NoError(t, err)
 		require.Equal(t, txs, recomputedTxs.ToSliceOfBytes())
 	})
+	t.Run("ShareVersionOne", func(t *testing.T) {
+		signer := blob.NewKeyringSigner(testfactory.GenerateKeyring("signer"), "signer", "chainid")
+		blobs := blobfactory.RandBlobsWithNamespace(ns.RandomBlobNamespaces(2), []int{100, 2000})
+		for _, b := range blobs {
+			b.ShareVersion = uint32(appconsts.ShareVersionOne)
+		}
+		txs := [][]byte{blobfactory.MultiBlobTx(t, encCfg.TxConfig.TxEncoder(), signer, 0, 0, blobs...)}
+		dataSquare, err := square.Construct(txs, appconsts.LatestVersion, appconsts.DefaultSquareSizeUpperBound)
+		require.NoError(t, err)
+		recomputedTxs, err := square.Deconstruct(dataSquare, encCfg.TxConfig.TxDecoder())
+		require.NoError(t, err)
+		require.Equal(t, txs, recomputedTxs.ToSliceOfBytes())
+	})
 	t.Run("EmptySquare", func(t *testing.T) {
 		tx, err := square.Deconstruct(square.EmptySquare(), encCfg.TxConfig.TxDecoder())
 		require.NoError(t, err)
@@ -0,0 +1,567 @@
+// Package user provides a client for users to submit transactions, in
+// particular PayForBlobs, to a celestia-app network.
+package user
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/celestiaorg/celestia-app/pkg/appconsts"
+	blobtypes "github.com/celestiaorg/celestia-app/x/blob/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	sdktx "github.com/cosmos/cosmos-sdk/types/tx"
+	abci "github.com/tendermint/tendermint/abci/types"
+	coretypes "github.com/tendermint/tendermint/types"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// DefaultPollTime is how often the client polls the node to check whether
+	// a transaction has been committed.
+	DefaultPollTime = 3 * time.Second
+
+	// DefaultGasMultiplier is multiplied with the estimated gas of a
+	// transaction to account for inaccuracies of the estimate.
+	DefaultGasMultiplier = 1.1
+
+	// DefaultPendingTimeout is how long a broadcast transaction is tracked
+	// if it isn't confirmed with ConfirmTx.
+	DefaultPendingTimeout = 10 * time.Minute
+
+	// maxSequenceRetries is the number of times a transaction is resigned
+	// and rebroadcast after being rejected because of a sequence mismatch.
+	maxSequenceRetries = 3
+)
+
+// gogoCodec marshals requests and responses with gogoproto so that custom
+// types, such as sdk.Dec, are supported when querying the node.
+var gogoCodec = grpc.ForceCodec(codec.NewProtoCodec(codectypes.NewInterfaceRegistry()).GRPCCodec())
+
+// errLowerSequenceRejected is the error of the pending transactions that can
+// no longer be committed because a transaction with a lower sequence was
+// rejected.
+var errLowerSequenceRejected = errors.New("a transaction with a lower sequence was rejected")
+
+// TxResponse is the result of a transaction that has been committed to a
+// block.
+type TxResponse struct {
+	// Height is the height of the block that includes the transaction.
+	Height int64
+	// TxHash is the hex encoded hash of the transaction.
+	TxHash string
+	// Code is the result code of delivering the transaction.
+	Code uint32
+	// GasWanted is the gas limit of the transaction.
+	GasWanted int64
+	// GasUsed is the gas consumed when delivering the transaction.
+	GasUsed int64
+	// Commitments are the share commitments of the blobs paid for by the
+	// transaction, in the order the blobs were submitted.
+	Commitments [][]byte
+}
+
+// TxClient signs and submits transactions on behalf of the account of a
+// KeyringSigner. It tracks the sequence of the account so that transactions
+// can be submitted concurrently without waiting for previous transactions to
+// be committed. All methods are safe for concurrent use.
+type TxClient struct {
+	signer  *blobtypes.KeyringSigner
+	conn    *grpc.ClientConn
+	address sdk.AccAddress
+
+	pollTime       time.Duration
+	pendingTimeout time.Duration
+	gasMultiplier  float64
+	gasPrice       sdk.Dec
+
+	// broadcastMtx serializes signing and broadcasting, and thereby the
+	// changes to the sequence of the signer, so that the node receives the
+	// transactions in the order of their sequences.
+	broadcastMtx sync.Mutex
+	// mtx guards the pending transactions. It is never held while calling
+	// the node so that the transactions can be confirmed while others are
+	// being broadcast.
+	mtx sync.Mutex
+	// pending are the transactions that have been broadcast but are not known
+	// to be committed yet, indexed by their sequence. They are kept so that
+	// they can be rebroadcast if the mempool evicts them, which happens when
+	// it rechecks the transactions of an account out of order. They stop
+	// being tracked once they are confirmed or after the pending timeout.
+	pending         map[uint64]*pendingTx
+	lastRebroadcast time.Time
+}
+
+type pendingTx struct {
+	hash        string
+	rawTx       []byte
+	broadcastAt time.Time
+	// err is set if the transaction was rejected when being rebroadcast.
+	err error
+}
+
+// Option configures a TxClient.
+type Option func(*TxClient)
+
+// WithPollTime sets how often the client checks whether a transaction has
+// been committed.
+func WithPollTime(pollTime time.Duration) Option {
+	return func(c *TxClient) {
+		c.pollTime = pollTime
+	}
+}
+
+// WithPendingTimeout sets how long a broadcast transaction is tracked, and
+// rebroadcast if needed, when it isn't confirmed with ConfirmTx.
+func WithPendingTimeout(timeout time.Duration) Option {
+	return func(c *TxClient) {
+		c.pendingTimeout = timeout
+	}
+}
+
+// WithGasMultiplier sets the multiplier applied to the estimated gas of a
+// transaction.
+func WithGasMultiplier(multiplier float64) Option {
+	return func(c *TxClient) {
+		c.gasMultiplier = multiplier
+	}
+}
+
+// WithGasPrice sets the gas price in utia that is used to determine the fee
+// of a transaction.
+func WithGasPrice(gasPrice sdk.Dec) Option {
+	return func(c *TxClient) {
+		c.gasPrice = gasPrice
+	}
+}
+
+// SetupTxClient returns a TxClient for the account of the signer. The account
+// number and sequence of the signer are queried from the node that conn is
+// connected to, so the account must already exist on chain.
+func SetupTxClient(ctx context.Context, signer *blobtypes.KeyringSigner, conn *grpc.ClientConn, opts ...Option) (*TxClient, error) {
+	address, err := signer.GetSignerInfo().GetAddress()
+	if err != nil {
+		return nil, err
+	}
+	if err := signer.QueryAccountNumber(ctx, conn); err != nil {
+		return nil, fmt.Errorf("querying account %s: %w", address, err)
+	}
+
+	c := &TxClient{
+		signer:         signer,
+		conn:           conn,
+		address:        address,
+		pollTime:       DefaultPollTime,
+		pendingTimeout: DefaultPendingTimeout,
+		gasMultiplier:  DefaultGasMultiplier,
+		pending:        make(map[uint64]*pendingTx),
+		gasPrice:       sdk.MustNewDecFromStr(strconv.FormatFloat(appconsts.DefaultMinGasPrice, 'f', -1, 64)),
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c, nil
+}
+
+// Address returns the address of the account that signs transactions.
+func (c *TxClient) Address() sdk.AccAddress {
+	return c.address
+}
+
+// PendingTxs returns the number of broadcast transactions that are tracked
+// until they are confirmed or time out.
+func (c *TxClient) PendingTxs() int {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	c.prunePending()
+	return len(c.pending)
+}
+
+// SubmitPayForBlob signs and submits a PayForBlobs transaction paying for the
+// provided blobs and waits for it to be committed. Unless overridden by opts,
+// the gas limit and fee are estimated by the blob module of the node. An
+// error is returned if the transaction is rejected, isn't committed before
+// ctx is done, or fails to execute, in which case the response is returned
+// along with the error.
+func (c *TxClient) SubmitPayForBlob(ctx context.Context, blobs []*blobtypes.Blob, opts ...blobtypes.TxBuilderOption) (*TxResponse, error) {
+	resp, err := c.BroadcastPayForBlob(ctx, blobs, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return c.ConfirmTx(ctx, resp)
+}
+
+// BroadcastPayForBlob signs and broadcasts a PayForBlobs transaction without
+// waiting for it to be committed. The returned response only has the hash
+// and the share commitments set and can be passed to ConfirmTx.
+func (c *TxClient) BroadcastPayForBlob(ctx context.Context, blobs []*blobtypes.Blob, opts ...blobtypes.TxBuilderOption) (*TxResponse, error) {
+	msg, err := blobtypes.NewMsgPayForBlobs(c.address.String(), blobs...)
+	if err != nil {
+		return nil, err
+	}
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	estimate, err := blobtypes.NewQueryClient(c.conn).EstimateGasForBlobs(ctx, &blobtypes.QueryEstimateGasForBlobsRequest{
+		BlobSizes:     msg.BlobSizes,
+		ShareVersions: msg.ShareVersions,
+		GasPrice:      c.gasPrice,
+	}, gogoCodec)
+	if err != nil {
+		return nil, fmt.Errorf("estimating gas: %w", err)
+	}
+	// the estimated fee already includes the blob base fee so only the fee
+	// for the additional gas is added
+	gasLimit := uint64(float64(estimate.GasLimit) * c.gasMultiplier)
+	fee := estimate.Fee
+	if gasLimit > estimate.GasLimit {
+		fee = fee.AddAmount(c.gasPrice.MulInt64(int64(gasLimit - estimate.GasLimit)).Ceil().TruncateInt())
+	}
+	opts = append([]blobtypes.TxBuilderOption{
+		blobtypes.SetGasLimit(gasLimit),
+		blobtypes.SetFeeAmount(sdk.NewCoins(fee)),
+	}, opts...)
+
+	txHash, err := c.broadcast(ctx, []sdk.Msg{msg}, blobs, opts)
+	if err != nil {
+		return nil, err
+	}
+	return &TxResponse{
+		TxHash:      txHash,
+		Commitments: msg.ShareCommitments,
+	}, nil
+}
+
+// SubmitTx signs and submits a transaction with the provided messages and
+// waits for it to be committed. The blobs, if any, are broadcast along with
+// the transaction, which must then pay for them. The gas limit and fee are
+// set by opts. Errors are returned as for SubmitPayForBlob.
+func (c *TxClient) SubmitTx(ctx context.Context, msgs []sdk.Msg, blobs []*blobtypes.Blob, opts ...blobtypes.TxBuilderOption) (*TxResponse, error) {
+	resp, err := c.BroadcastTx(ctx, msgs, blobs, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return c.ConfirmTx(ctx, resp)
+}
+
+// BroadcastTx signs and broadcasts a transaction with the provided messages,
+// and blobs if any, without waiting for it to be committed. The returned
+// response only has the hash set and can be passed to ConfirmTx.
+func (c *TxClient) BroadcastTx(ctx context.Context, msgs []sdk.Msg, blobs []*blobtypes.Blob, opts ...blobtypes.TxBuilderOption) (*TxResponse, error) {
+	for _, msg := range msgs {
+		if err := msg.ValidateBasic(); err != nil {
+			return nil, err
+		}
+	}
+	txHash, err := c.broadcast(ctx, msgs, blobs, opts)
+	if err != nil {
+		return nil, err
+	}
+	return &TxResponse{TxHash: txHash}, nil
+}
+
+// broadcast signs the messages with the next sequence and broadcasts them
+// along with the blobs. If the transaction is rejected because of a sequence
+// mismatch, the sequence is resynced and the transaction is resigned.
+//
+// If the transaction can't be broadcast because of a transport error, the
+// node may still have received it. The sequence therefore remains advanced
+// and the transaction is tracked as pending: if the node didn't receive it,
+// the next transaction is rejected for its sequence, which resyncs it, and
+// the transaction is rebroadcast while it is being confirmed.
+func (c *TxClient) broadcast(ctx context.Context, msgs []sdk.Msg, blobs []*blobtypes.Blob, opts []blobtypes.TxBuilderOption) (string, error) {
+	c.broadcastMtx.Lock()
+	defer c.broadcastMtx.Unlock()
+
+	for retries := 0; ; retries++ {
+		sequence, tx, err := c.signTx(msgs, blobs, opts)
+		if err != nil {
+			return "", err
+		}
+		txResp, err := c.broadcastRawTx(ctx, tx.rawTx)
+		if err != nil {
+			return "", err
+		}
+		if txResp.Code == abci.CodeTypeOK {
+			return tx.hash, nil
+		}
+
+		// the transaction was rejected so its sequence can be used again
+		c.mtx.Lock()
+		delete(c.pending, sequence)
+		c.mtx.Unlock()
+		c.signer.SetSequence(sequence)
+		if !isWrongSequence(txResp) || retries >= maxSequenceRetries {
+			return "", newBroadcastTxError(txResp)
+		}
+		if err := c.resyncSequence(ctx); err != nil {
+			return "", err
+		}
+	}
+}
+
+// signTx signs the messages with the next sequence of the signer and tracks
+// the transaction as pending under that sequence, which is advanced. It must
+// be called with broadcastMtx held.
+func (c *TxClient) signTx(msgs []sdk.Msg, blobs []*blobtypes.Blob, opts []blobtypes.TxBuilderOption) (uint64, *pendingTx, error) {
+	signerData, err := c.signer.GetSignerData()
+	if err != nil {
+		return 0, nil, err
+	}
+	sequence := signerData.Sequence
+	tx, err := c.signer.BuildSignedTx(c.signer.NewTxBuilder(opts...), msgs...)
+	if err != nil {
+		return 0, nil, err
+	}
+	rawTx, err := c.signer.EncodeTx(tx)
+	if err != nil {
+		return 0, nil, err
+	}
+	if len(blobs) > 0 {
+		rawTx, err = coretypes.MarshalBlobTx(rawTx, blobs...)
+		if err != nil {
+			return 0, nil, err
+		}
+	}
+
+	pending := &pendingTx{
+		hash:        fmt.Sprintf("%X", coretypes.Tx(rawTx).Hash()),
+		rawTx:       rawTx,
+		broadcastAt: time.Now(),
+	}
+	c.mtx.Lock()
+	c.prunePending()
+	c.pending[sequence] = pending
+	c.mtx.Unlock()
+	c.signer.SetSequence(sequence + 1)
+	return sequence, pending, nil
+}
+
+// resyncSequence handles the rejection of a transaction because of a sequence
+// mismatch. The account is queried for the sequence that follows its
+// committed transactions. The pending transactions with lower sequences have
+// been committed and are no longer tracked. The ones that follow without a
+// gap are rebroadcast, in case the mempool evicted them, and the next
+// transaction is signed with the sequence after them. The pending
+// transactions after a gap can't be committed and are marked as failed. It
+// must be called with broadcastMtx held.
+func (c *TxClient) resyncSequence(ctx context.Context) error {
+	if err := c.signer.QueryAccountNumber(ctx, c.conn); err != nil {
+		return fmt.Errorf("querying account %s: %w", c.address, err)
+	}
+	signerData, err := c.signer.GetSignerData()
+	if err != nil {
+		return err
+	}
+	next := signerData.Sequence
+
+	c.mtx.Lock()
+	for sequence := range c.pending {
+		if sequence < next {
+			delete(c.pending, sequence)
+		}
+	}
+	for tx, ok := c.pending[next]; ok && tx.err == nil; tx, ok = c.pending[next] {
+		next++
+	}
+	for sequence, tx := range c.pending {
+		if sequence >= next && tx.err == nil {
+			tx.err = errLowerSequenceRejected
+		}
+	}
+	c.signer.SetSequence(next)
+	c.mtx.Unlock()
+
+	return c.rebroadcastPending(ctx)
+}
+
+// rebroadcastPending rebroadcasts the pending transactions in the order of
+// their sequences. The node ignores transactions that are still in its
+// mempool. Transactions that are rejected for any other reason than a
+// sequence mismatch are marked as failed. A sequence mismatch means that the
+// transaction, and the ones after it, have been committed or will be
+// rebroadcast after the transactions with lower sequences are, so the
+// remaining transactions aren't rebroadcast. It must be called with
+// broadcastMtx held.
+func (c *TxClient) rebroadcastPending(ctx context.Context) error {
+	c.mtx.Lock()
+	c.lastRebroadcast = time.Now()
+	sequences := make([]uint64, 0, len(c.pending))
+	txs := make(map[uint64]*pendingTx, len(c.pending))
+	for sequence, tx := range c.pending {
+		if tx.err == nil {
+			sequences = append(sequences, sequence)
+			txs[sequence] = tx
+		}
+	}
+	c.mtx.Unlock()
+	sort.Slice(sequences, func(i, j int) bool { return sequences[i] < sequences[j] })
+
+	for _, sequence := range sequences {
+		tx := txs[sequence]
+		txResp, err := c.broadcastRawTx(ctx, tx.rawTx)
+		if err != nil {
+			return err
+		}
+		switch {
+		case txResp.Code == abci.CodeTypeOK || isInMempool(txResp):
+		case isWrongSequence(txResp):
+			return nil
+		default:
+			c.mtx.Lock()
+			tx.err = newBroadcastTxError(txResp)
+			c.mtx.Unlock()
+		}
+	}
+	return nil
+}
+
+func (c *TxClient) broadcastRawTx(ctx context.Context, rawTx []byte) (*sdk.TxResponse, error) {
+	resp, err := blobtypes.BroadcastTx(ctx, c.conn, sdktx.BroadcastMode_BROADCAST_MODE_SYNC, rawTx)
+	if err != nil {
+		return nil, err
+	}
+	return resp.TxResponse, nil
+}
+
+// ConfirmTx polls the node until the transaction of resp has been committed
+// and completes resp with the result of the transaction. While waiting, the
+// pending transactions of the client are rebroadcast in case they were
+// evicted from the mempool. An error is returned if ctx is done before the
+// transaction is committed, if it is rejected when being rebroadcast or if it
+// failed to execute.
+func (c *TxClient) ConfirmTx(ctx context.Context, resp *TxResponse) (*TxResponse, error) {
+	txClient := sdktx.NewServiceClient(c.conn)
+
+	ticker := time.NewTicker(c.pollTime)
+	defer ticker.Stop()
+	for {
+		res, err := txClient.GetTx(ctx, &sdktx.GetTxRequest{Hash: resp.TxHash}, gogoCodec)
+		switch {
+		case err == nil:
+			c.removePending(resp.TxHash)
+			resp.Height = res.TxResponse.Height
+			resp.Code = res.TxResponse.Code
+			resp.GasWanted = res.TxResponse.GasWanted
+			resp.GasUsed = res.TxResponse.GasUsed
+			if resp.Code != abci.CodeTypeOK {
+				return resp, &ExecuteTxError{TxHash: resp.TxHash, Height: resp.Height, Code: resp.Code, Codespace: res.TxResponse.Codespace, Log: res.TxResponse.RawLog}
+			}
+			return resp, nil
+		case status.Code(err) != codes.NotFound:
+			return nil, fmt.Errorf("querying tx %s: %w", resp.TxHash, err)
+		}
+
+		if err := c.checkPending(ctx, resp.TxHash); err != nil {
+			return nil, err
+		}
+
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return nil, fmt.Errorf("waiting for tx %s to be committed: %w", resp.TxHash, ctx.Err())
+		}
+	}
+}
+
+// checkPending rebroadcasts the pending transactions unless they have been
+// rebroadcast within the last poll interval or a transaction is being
+// broadcast, and returns the error of the transaction with hash if it has
+// been rejected.
+func (c *TxClient) checkPending(ctx context.Context, hash string) error {
+	c.mtx.Lock()
+	c.prunePending()
+	rebroadcast := time.Since(c.lastRebroadcast) >= c.pollTime
+	c.mtx.Unlock()
+
+	if rebroadcast && c.broadcastMtx.TryLock() {
+		err := c.rebroadcastPending(ctx)
+		c.broadcastMtx.Unlock()
+		if err != nil {
+			return err
+		}
+	}
+
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	for sequence, tx := range c.pending {
+		if tx.hash == hash && tx.err != nil {
+			delete(c.pending, sequence)
+			return tx.err
+		}
+	}
+	return nil
+}
+
+// prunePending stops tracking the pending transactions that were broadcast
+// more than the pending timeout ago. They have either been committed without
+// being confirmed or were dropped by the node. It must be called with mtx
+// held.
+func (c *TxClient) prunePending() {
+	for sequence, tx := range c.pending {
+		if time.Since(tx.broadcastAt) > c.pendingTimeout {
+			delete(c.pending, sequence)
+		}
+	}
+}
+
+func (c *TxClient) removePending(hash string) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	for sequence, tx := range c.pending {
+		if tx.hash == hash {
+			delete(c.pending, sequence)
+			return
+		}
+	}
+}
+
+func isWrongSequence(resp *sdk.TxResponse) bool {
+	return resp.Codespace == sdkerrors.ErrWrongSequence.Codespace() && resp.Code == sdkerrors.ErrWrongSequence.ABCICode()
+}
+
+func isInMempool(resp *sdk.TxResponse) bool {
+	return resp.Codespace == sdkerrors.ErrTxInMempoolCache.Codespace() && resp.Code == sdkerrors.ErrTxInMempoolCache.ABCICode()
+}
+
+// BroadcastTxError is returned when a transaction is rejected by the node
+// that it was broadcast to.
+type BroadcastTxError struct {
+	TxHash    string
+	Code      uint32
+	Codespace string
+	Log       string
+}
+
+func newBroadcastTxError(resp *sdk.TxResponse) *BroadcastTxError {
+	return &BroadcastTxError{TxHash: resp.TxHash, Code: resp.Code, Codespace: resp.Codespace, Log: resp.RawLog}
+}
+
+func (e *BroadcastTxError) Error() string {
+	return fmt.Sprintf("tx %s rejected with code %d (%s): %s", e.TxHash, e.Code, e.Codespace, e.Log)
+}
+
+// ExecuteTxError is returned when a committed transaction failed to execute.
+type ExecuteTxError struct {
+	TxHash    string
+	Height    int64
+	Code      uint32
+	Codespace string
+	Log       string
+}
+
+func (e *ExecuteTxError) Error() string {
+	return fmt.Sprintf("tx %s failed to execute at height %d with code %d (%s): %s", e.TxHash, e.Height, e.Code, e.Codespace, e.Log)
+}
@@ -0,0 +1,187 @@
+package user_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/celestiaorg/celestia-app/app"
+	"github.com/celestiaorg/celestia-app/pkg/appconsts"
+	appns "github.com/celestiaorg/celestia-app/pkg/namespace"
+	"github.com/celestiaorg/celestia-app/pkg/user"
+	"github.com/celestiaorg/celestia-app/test/util/testnode"
+	blobtypes "github.com/celestiaorg/celestia-app/x/blob/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmrand "github.com/tendermint/tendermint/libs/rand"
+)
+
+func TestTxClientTestSuite(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping tx client integration test in short mode.")
+	}
+	suite.Run(t, new(TxClientTestSuite))
+}
+
+type TxClientTestSuite struct {
+	suite.Suite
+
+	accounts []string
+	cctx     testnode.Context
+
+	mtx            sync.Mutex
+	accountCounter int
+}
+
+func (s *TxClientTestSuite) SetupSuite() {
+	s.accounts, s.cctx = testnode.DefaultNetwork(s.T())
+}
+
+func (s *TxClientTestSuite) newTxClient() *user.TxClient {
+	s.mtx.Lock()
+	account := s.accounts[s.accountCounter]
+	s.accountCounter++
+	s.mtx.Unlock()
+
+	signer := blobtypes.NewKeyringSigner(s.cctx.Keyring, account, s.cctx.ChainID)
+	client, err := user.SetupTxClient(s.cctx.GoContext(), signer, s.cctx.GRPCClient, user.WithPollTime(100*time.Millisecond))
+	s.Require().NoError(err)
+	return client
+}
+
+func newBlobs(t *testing.T, sizes ...int) []*blobtypes.Blob {
+	blobs := make([]*blobtypes.Blob, len(sizes))
+	for i, size := range sizes {
+		blob, err := blobtypes.NewBlob(appns.RandomBlobNamespace(), tmrand.Bytes(size), appconsts.ShareVersionZero)
+		require.NoError(t, err)
+		blobs[i] = blob
+	}
+	return blobs
+}
+
+func (s *TxClientTestSuite) TestSubmitPayForBlob() {
+	t := s.T()
+	client := s.newTxClient()
+	blobs := newBlobs(t, 100, 2000)
+
+	ctx, cancel := context.WithTimeout(s.cctx.GoContext(), time.Minute)
+	defer cancel()
+	resp, err := client.SubmitPayForBlob(ctx, blobs)
+	require.NoError(t, err)
+	require.Equal(t, abci.CodeTypeOK, resp.Code)
+	require.Greater(t, resp.Height, int64(0))
+	require.LessOrEqual(t, resp.GasUsed, resp.GasWanted)
+
+	wantCommitments, err := blobtypes.CreateCommitments(blobs)
+	require.NoError(t, err)
+	require.Equal(t, wantCommitments, resp.Commitments)
+}
+
+func (s *TxClientTestSuite) TestSubmitPayForBlobConcurrently() {
+	t := s.T()
+	client := s.newTxClient()
+
+	ctx, cancel := context.WithTimeout(s.cctx.GoContext(), 2*time.Minute)
+	defer cancel()
+
+	const numTxs = 10
+	var wg sync.WaitGroup
+	errs := make([]error, numTxs)
+	for i := 0; i < numTxs; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			_, errs[i] = client.SubmitPayForBlob(ctx, newBlobs(t, 500))
+		}(i)
+	}
+	wg.Wait()
+	for _, err := range errs {
+		require.NoError(t, err)
+	}
+}
+
+func (s *TxClientTestSuite) TestSubmitTx() {
+	t := s.T()
+	client := s.newTxClient()
+
+	ctx, cancel := context.WithTimeout(s.cctx.GoContext(), time.Minute)
+	defer cancel()
+
+	msg := banktypes.NewMsgSend(client.Address(), client.Address(), sdk.NewCoins(sdk.NewInt64Coin(app.BondDenom, 1)))
+	resp, err := client.SubmitTx(ctx, []sdk.Msg{msg}, nil,
+		blobtypes.SetGasLimit(100000),
+		blobtypes.SetFeeAmount(sdk.NewCoins(sdk.NewInt64Coin(app.BondDenom, 10000))))
+	require.NoError(t, err)
+	require.Equal(t, abci.CodeTypeOK, resp.Code)
+	require.Greater(t, resp.Height, int64(0))
+	require.Equal(t, 0, client.PendingTxs())
+}
+
+func (s *TxClientTestSuite) TestSubmitPayForBlobResyncsSequence() {
+	t := s.T()
+	s.mtx.Lock()
+	account := s.accounts[s.accountCounter]
+	s.accountCounter++
+	s.mtx.Unlock()
+
+	signer := blobtypes.NewKeyringSigner(s.cctx.Keyring, account, s.cctx.ChainID)
+	client, err := user.SetupTxClient(s.cctx.GoContext(), signer, s.cctx.GRPCClient, user.WithPollTime(100*time.Millisecond))
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(s.cctx.GoContext(), time.Minute)
+	defer cancel()
+
+	// a sequence that is too high and one that is too low are both resynced
+	for _, sequence := range []uint64{10, 0} {
+		signer.SetSequence(sequence)
+		_, err = client.SubmitPayForBlob(ctx, newBlobs(t, 100))
+		require.NoError(t, err)
+	}
+}
+
+func (s *TxClientTestSuite) TestSubmitPayForBlobRejected() {
+	t := s.T()
+	client := s.newTxClient()
+
+	ctx, cancel := context.WithTimeout(s.cctx.GoContext(), time.Minute)
+	defer cancel()
+
+	// the gas limit is too low for the blob to be paid for
+	_, err := client.SubmitPayForBlob(ctx, newBlobs(t, 100), blobtypes.SetGasLimit(1))
+	var broadcastErr *user.BroadcastTxError
+	require.ErrorAs(t, err, &broadcastErr)
+}
+
+func (s *TxClientTestSuite) TestPendingTxsTimeout() {
+	t := s.T()
+	s.mtx.Lock()
+	account := s.accounts[s.accountCounter]
+	s.accountCounter++
+	s.mtx.Unlock()
+
+	signer := blobtypes.NewKeyringSigner(s.cctx.Keyring, account, s.cctx.ChainID)
+	client, err := user.SetupTxClient(s.cctx.GoContext(), signer, s.cctx.GRPCClient, user.WithPendingTimeout(time.Second))
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(s.cctx.GoContext(), time.Minute)
+	defer cancel()
+
+	// transactions that are never confirmed stop being tracked after the
+	// pending timeout
+	_, err = client.BroadcastPayForBlob(ctx, newBlobs(t, 100))
+	require.NoError(t, err)
+	require.Equal(t, 1, client.PendingTxs())
+	time.Sleep(2 * time.Second)
+	require.Equal(t, 0, client.PendingTxs())
+
+	// confirmed transactions stop being tracked immediately
+	resp, err := client.BroadcastPayForBlob(ctx, newBlobs(t, 100))
+	require.NoError(t, err)
+	_, err = client.ConfirmTx(ctx, resp)
+	require.NoError(t, err)
+	require.Equal(t, 0, client.PendingTxs())
+}
@@ -35,6 +35,10 @@ type ErasuredNamespacedMerkleTree struct {
 	// leaf belongs to, along with keeping track of how many leaves have been
 	// added to the tree so far.
 	shareIndex uint64
+	// release is called once the root of the tree has been computed to free
+	// the slot that the tree occupies in its constructor's worker pool. It is
+	// nil if the tree was created by an unbounded constructor.
+	release func()
 }
 
 // NewErasuredNamespacedMerkleTree creates a new ErasuredNamespacedMerkleTree
@@ -54,6 +58,9 @@ func NewErasuredNamespacedMerkleTree(squareSize uint64, axisIndex uint, options
 type constructor struct {
 	squareSize uint64
 	opts       []nmt.Option
+	// sem bounds the number of trees that are pushed to and hashed
+	// concurrently. It is nil if the number of trees is unbounded.
+	sem chan struct{}
 }
 
 // NewConstructor creates a tree constructor function as required by rsmt2d to
@@ -66,11 +73,35 @@ func NewConstructor(squareSize uint64, opts ...nmt.Option) rsmt2d.TreeConstructo
 	}.NewTree
 }
 
+// NewBoundedConstructor creates a tree constructor function like
+// NewConstructor, except that at most workers trees are in use at any given
+// time. rsmt2d computes the row and column roots of an extended data square
+// with one goroutine per tree, so for large squares this bounds the number of
+// trees that are hashed in parallel and held in memory. Creating a tree blocks
+// until a slot is available and the slot is freed once the tree's root has been
+// computed, so Root must be called on every tree created by the function. The
+// roots are identical to the ones of trees created by NewConstructor. If
+// workers is not positive, the number of trees is unbounded.
+func NewBoundedConstructor(squareSize uint64, workers int, opts ...nmt.Option) rsmt2d.TreeConstructorFn {
+	c := constructor{
+		squareSize: squareSize,
+		opts:       opts,
+	}
+	if workers > 0 {
+		c.sem = make(chan struct{}, workers)
+	}
+	return c.NewTree
+}
+
 // NewTree creates a new rsmt2d.Tree using the
 // wrapper.ErasuredNamespacedMerkleTree with predefined square size and
 // nmt.Options
 func (c constructor) NewTree(_ rsmt2d.Axis, axisIndex uint) rsmt2d.Tree {
 	newTree := NewErasuredNamespacedMerkleTree(c.squareSize, axisIndex, c.opts...)
+	if c.sem != nil {
+		c.sem <- struct{}{}
+		newTree.release = func() { <-c.sem }
+	}
 	return &newTree
 }
 
@@ -105,6 +136,12 @@ func (w *ErasuredNamespacedMerkleTree) Push(data []byte) error {
 // Root fulfills the rsmt.Tree interface by generating and returning the
 // underlying NamespaceMerkleTree Root.
 func (w *ErasuredNamespacedMerkleTree) Root() ([]byte, error) {
+	if w.release != nil {
+		defer func() {
+			w.release()
+			w.release = nil
+		}()
+	}
 	root, err := w.tree.Root()
 	if err != nil {
 		return nil, err
@@ -117,6 +154,12 @@ func (w *ErasuredNamespacedMerkleTree) ProveRange(start, end int) (nmt.Proof, er
 	return w.tree.ProveRange(start, end)
 }
 
+// ProveNamespace returns a Merkle range proof for the leaves of the namespace.
+// If the namespace is not present in the tree, an absence proof is returned.
+func (w *ErasuredNamespacedMerkleTree) ProveNamespace(ns appns.Namespace) (nmt.Proof, error) {
+	return w.tree.ProveNamespace(ns.Bytes())
+}
+
 // incrementShareIndex increments the share index by one.
 func (w *ErasuredNamespacedMerkleTree) incrementShareIndex() {
 	w.shareIndex++
@@ -5,6 +5,7 @@ import (
 	"crypto/sha256"
 	"sort"
 	"testing"
+	"time"
 
 	"github.com/celestiaorg/celestia-app/pkg/appconsts"
 	"github.com/celestiaorg/celestia-app/pkg/namespace"
@@ -13,6 +14,7 @@ import (
 	nmtnamespace "github.com/celestiaorg/nmt/namespace"
 	"github.com/celestiaorg/rsmt2d"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	tmrand "github.com/tendermint/tendermint/libs/rand"
 )
 
@@ -136,6 +138,44 @@ func TestComputeExtendedDataSquare(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestBoundedConstructor(t *testing.T) {
+	squareSize := 8
+	data := generateRandNamespacedRawData(squareSize * squareSize)
+	want, err := rsmt2d.ComputeExtendedDataSquare(data, appconsts.DefaultCodec(), NewConstructor(uint64(squareSize)))
+	require.NoError(t, err)
+
+	for _, workers := range []int{0, 1, 3, 64} {
+		eds, err := rsmt2d.ComputeExtendedDataSquare(data, appconsts.DefaultCodec(), NewBoundedConstructor(uint64(squareSize), workers))
+		require.NoError(t, err)
+		assert.Equal(t, want.RowRoots(), eds.RowRoots(), workers)
+		assert.Equal(t, want.ColRoots(), eds.ColRoots(), workers)
+	}
+}
+
+func TestBoundedConstructorBlocksUntilRoot(t *testing.T) {
+	newTree := NewBoundedConstructor(4, 2)
+	first := newTree(rsmt2d.Row, 0)
+	newTree(rsmt2d.Row, 1)
+
+	created := make(chan rsmt2d.Tree)
+	go func() {
+		created <- newTree(rsmt2d.Row, 2)
+	}()
+	select {
+	case <-created:
+		t.Fatal("tree was created while all slots were in use")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	_, err := first.Root()
+	require.NoError(t, err)
+	select {
+	case <-created:
+	case <-time.After(time.Second):
+		t.Fatal("tree wasn't created after a slot was freed")
+	}
+}
+
 // generateErasuredData generates random data and then erasure codes it. It
 // returns a slice that is twice as long as numLeaves because it returns the
 // original data + erasured data.
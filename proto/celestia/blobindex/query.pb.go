@@ -0,0 +1,1424 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: celestia/blobindex/query.proto
+
+package blobindex
+
+import (
+	context "context"
+	fmt "fmt"
+	query "github.com/cosmos/cosmos-sdk/types/query"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	grpc1 "github.com/gogo/protobuf/grpc"
+	proto "github.com/gogo/protobuf/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// QueryBlobsByNamespaceRequest is the request type for the
+// Query/BlobsByNamespace RPC method.
+type QueryBlobsByNamespaceRequest struct {
+	// namespace is the namespace version followed by the namespace ID
+	Namespace []byte `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// min_height is the lowest height, inclusive, to return blobs for
+	MinHeight int64 `protobuf:"varint,2,opt,name=min_height,json=minHeight,proto3" json:"min_height,omitempty"`
+	// max_height is the highest height, inclusive, to return blobs for. There
+	// is no upper bound if it is zero.
+	MaxHeight  int64              `protobuf:"varint,3,opt,name=max_height,json=maxHeight,proto3" json:"max_height,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,4,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryBlobsByNamespaceRequest) Reset()         { *m = QueryBlobsByNamespaceRequest{} }
+func (m *QueryBlobsByNamespaceRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBlobsByNamespaceRequest) ProtoMessage()    {}
+func (*QueryBlobsByNamespaceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d41a580114054bcd, []int{0}
+}
+func (m *QueryBlobsByNamespaceRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBlobsByNamespaceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBlobsByNamespaceRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBlobsByNamespaceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBlobsByNamespaceRequest.Merge(m, src)
+}
+func (m *QueryBlobsByNamespaceRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBlobsByNamespaceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBlobsByNamespaceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBlobsByNamespaceRequest proto.InternalMessageInfo
+
+func (m *QueryBlobsByNamespaceRequest) GetNamespace() []byte {
+	if m != nil {
+		return m.Namespace
+	}
+	return nil
+}
+
+func (m *QueryBlobsByNamespaceRequest) GetMinHeight() int64 {
+	if m != nil {
+		return m.MinHeight
+	}
+	return 0
+}
+
+func (m *QueryBlobsByNamespaceRequest) GetMaxHeight() int64 {
+	if m != nil {
+		return m.MaxHeight
+	}
+	return 0
+}
+
+func (m *QueryBlobsByNamespaceRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryBlobsByNamespaceResponse is the response type for the
+// Query/BlobsByNamespace RPC method.
+type QueryBlobsByNamespaceResponse struct {
+	Blobs      []IndexedBlob       `protobuf:"bytes,1,rep,name=blobs,proto3" json:"blobs"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// unindexed_heights are the ranges of heights within the requested range,
+	// up to last_indexed_height, whose blocks weren't indexed by the node. The
+	// blobs of these heights are missing from the response.
+	UnindexedHeights []HeightRange `protobuf:"bytes,3,rep,name=unindexed_heights,json=unindexedHeights,proto3" json:"unindexed_heights"`
+	// last_indexed_height is the highest height indexed by the node
+	LastIndexedHeight int64 `protobuf:"varint,4,opt,name=last_indexed_height,json=lastIndexedHeight,proto3" json:"last_indexed_height,omitempty"`
+}
+
+func (m *QueryBlobsByNamespaceResponse) Reset()         { *m = QueryBlobsByNamespaceResponse{} }
+func (m *QueryBlobsByNamespaceResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBlobsByNamespaceResponse) ProtoMessage()    {}
+func (*QueryBlobsByNamespaceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d41a580114054bcd, []int{1}
+}
+func (m *QueryBlobsByNamespaceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBlobsByNamespaceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBlobsByNamespaceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBlobsByNamespaceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBlobsByNamespaceResponse.Merge(m, src)
+}
+func (m *QueryBlobsByNamespaceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBlobsByNamespaceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBlobsByNamespaceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBlobsByNamespaceResponse proto.InternalMessageInfo
+
+func (m *QueryBlobsByNamespaceResponse) GetBlobs() []IndexedBlob {
+	if m != nil {
+		return m.Blobs
+	}
+	return nil
+}
+
+func (m *QueryBlobsByNamespaceResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+func (m *QueryBlobsByNamespaceResponse) GetUnindexedHeights() []HeightRange {
+	if m != nil {
+		return m.UnindexedHeights
+	}
+	return nil
+}
+
+func (m *QueryBlobsByNamespaceResponse) GetLastIndexedHeight() int64 {
+	if m != nil {
+		return m.LastIndexedHeight
+	}
+	return 0
+}
+
+// HeightRange is a range of heights, both inclusive.
+type HeightRange struct {
+	Start int64 `protobuf:"varint,1,opt,name=start,proto3" json:"start,omitempty"`
+	End   int64 `protobuf:"varint,2,opt,name=end,proto3" json:"end,omitempty"`
+}
+
+func (m *HeightRange) Reset()         { *m = HeightRange{} }
+func (m *HeightRange) String() string { return proto.CompactTextString(m) }
+func (*HeightRange) ProtoMessage()    {}
+func (*HeightRange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d41a580114054bcd, []int{2}
+}
+func (m *HeightRange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HeightRange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HeightRange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *HeightRange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HeightRange.Merge(m, src)
+}
+func (m *HeightRange) XXX_Size() int {
+	return m.Size()
+}
+func (m *HeightRange) XXX_DiscardUnknown() {
+	xxx_messageInfo_HeightRange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HeightRange proto.InternalMessageInfo
+
+func (m *HeightRange) GetStart() int64 {
+	if m != nil {
+		return m.Start
+	}
+	return 0
+}
+
+func (m *HeightRange) GetEnd() int64 {
+	if m != nil {
+		return m.End
+	}
+	return 0
+}
+
+// IndexedBlob describes where a blob was placed in the data square of a
+// block.
+type IndexedBlob struct {
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// tx_hash is the hash of the PFB transaction that paid for the blob
+	TxHash []byte `protobuf:"bytes,2,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+	// blob_index is the index of the blob in the PFB
+	BlobIndex uint32 `protobuf:"varint,3,opt,name=blob_index,json=blobIndex,proto3" json:"blob_index,omitempty"`
+	// start is the index of the first share of the blob in the data square
+	Start uint32 `protobuf:"varint,4,opt,name=start,proto3" json:"start,omitempty"`
+	// end is the index of the share after the last share of the blob
+	End uint32 `protobuf:"varint,5,opt,name=end,proto3" json:"end,omitempty"`
+}
+
+func (m *IndexedBlob) Reset()         { *m = IndexedBlob{} }
+func (m *IndexedBlob) String() string { return proto.CompactTextString(m) }
+func (*IndexedBlob) ProtoMessage()    {}
+func (*IndexedBlob) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d41a580114054bcd, []int{3}
+}
+func (m *IndexedBlob) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IndexedBlob) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IndexedBlob.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *IndexedBlob) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IndexedBlob.Merge(m, src)
+}
+func (m *IndexedBlob) XXX_Size() int {
+	return m.Size()
+}
+func (m *IndexedBlob) XXX_DiscardUnknown() {
+	xxx_messageInfo_IndexedBlob.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IndexedBlob proto.InternalMessageInfo
+
+func (m *IndexedBlob) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *IndexedBlob) GetTxHash() []byte {
+	if m != nil {
+		return m.TxHash
+	}
+	return nil
+}
+
+func (m *IndexedBlob) GetBlobIndex() uint32 {
+	if m != nil {
+		return m.BlobIndex
+	}
+	return 0
+}
+
+func (m *IndexedBlob) GetStart() uint32 {
+	if m != nil {
+		return m.Start
+	}
+	return 0
+}
+
+func (m *IndexedBlob) GetEnd() uint32 {
+	if m != nil {
+		return m.End
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*QueryBlobsByNamespaceRequest)(nil), "celestia.blobindex.QueryBlobsByNamespaceRequest")
+	proto.RegisterType((*QueryBlobsByNamespaceResponse)(nil), "celestia.blobindex.QueryBlobsByNamespaceResponse")
+	proto.RegisterType((*HeightRange)(nil), "celestia.blobindex.HeightRange")
+	proto.RegisterType((*IndexedBlob)(nil), "celestia.blobindex.IndexedBlob")
+}
+
+func init() { proto.RegisterFile("celestia/blobindex/query.proto", fileDescriptor_d41a580114054bcd) }
+
+var fileDescriptor_d41a580114054bcd = []byte{
+	// 562 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0x41, 0x8f, 0x12, 0x31,
+	0x14, 0xa6, 0x0c, 0x60, 0x28, 0x6b, 0xc2, 0xd6, 0x8d, 0x12, 0xc2, 0xce, 0x12, 0x0e, 0x4a, 0x4c,
+	0x9c, 0x0a, 0x66, 0xe3, 0x41, 0xbd, 0x70, 0xd0, 0xdd, 0x8b, 0xd1, 0x1e, 0x3c, 0x78, 0x21, 0x1d,
+	0x68, 0x66, 0x9a, 0x40, 0x3b, 0x4b, 0xcb, 0x06, 0x62, 0xbc, 0x98, 0x78, 0x37, 0xf1, 0x57, 0xf8,
+	0x07, 0x3c, 0x1b, 0x4f, 0x7b, 0xdc, 0xc4, 0x8b, 0x27, 0x63, 0xc0, 0x1f, 0x62, 0xda, 0x0e, 0x03,
+	0xb8, 0xbb, 0x1a, 0x2f, 0x93, 0xd7, 0xf7, 0xcd, 0xf7, 0xde, 0xf7, 0xbe, 0xd7, 0x42, 0x7f, 0xc0,
+	0x46, 0x4c, 0x69, 0x4e, 0x71, 0x38, 0x92, 0x21, 0x17, 0x43, 0x36, 0xc3, 0x27, 0x53, 0x36, 0x99,
+	0x07, 0xc9, 0x44, 0x6a, 0x89, 0xd0, 0x0a, 0x0f, 0x32, 0xbc, 0x7e, 0x77, 0x20, 0xd5, 0x58, 0x2a,
+	0x1c, 0x52, 0xc5, 0xdc, 0xcf, 0xf8, 0xb4, 0x13, 0x32, 0x4d, 0x3b, 0x38, 0xa1, 0x11, 0x17, 0x54,
+	0x73, 0x29, 0x1c, 0xbf, 0xbe, 0x17, 0xc9, 0x48, 0xda, 0x10, 0x9b, 0x28, 0xcd, 0x36, 0x22, 0x29,
+	0xa3, 0x11, 0xc3, 0x34, 0xe1, 0x98, 0x0a, 0x21, 0xb5, 0xa5, 0x28, 0x87, 0xb6, 0xbe, 0x02, 0xd8,
+	0x78, 0x69, 0xca, 0xf6, 0x46, 0x32, 0x54, 0xbd, 0xf9, 0x73, 0x3a, 0x66, 0x2a, 0xa1, 0x03, 0x46,
+	0xd8, 0xc9, 0x94, 0x29, 0x8d, 0x1a, 0xb0, 0x2c, 0x56, 0xb9, 0x1a, 0x68, 0x82, 0xf6, 0x0e, 0x59,
+	0x27, 0xd0, 0x3e, 0x84, 0x63, 0x2e, 0xfa, 0x31, 0xe3, 0x51, 0xac, 0x6b, 0xf9, 0x26, 0x68, 0x7b,
+	0xa4, 0x3c, 0xe6, 0xe2, 0xc8, 0x26, 0x2c, 0x4c, 0x67, 0x2b, 0xd8, 0x4b, 0x61, 0x3a, 0x4b, 0xe1,
+	0xa7, 0x10, 0xae, 0x87, 0xa8, 0x15, 0x9a, 0xa0, 0x5d, 0xe9, 0xde, 0x0e, 0xdc, 0xc4, 0x81, 0x99,
+	0x38, 0x70, 0xf6, 0xa4, 0x13, 0x07, 0x2f, 0x68, 0xb4, 0xd2, 0x45, 0x36, 0x98, 0xad, 0x4f, 0x79,
+	0xb8, 0x7f, 0xc5, 0x10, 0x2a, 0x91, 0x42, 0x31, 0xf4, 0x08, 0x16, 0x8d, 0xa7, 0xaa, 0x06, 0x9a,
+	0x5e, 0xbb, 0xd2, 0x3d, 0x08, 0x2e, 0x5a, 0x1d, 0x1c, 0x9b, 0x2f, 0x1b, 0x9a, 0x1a, 0xbd, 0xc2,
+	0xd9, 0x8f, 0x83, 0x1c, 0x71, 0x1c, 0xf4, 0x6c, 0x4b, 0x66, 0xde, 0xca, 0xbc, 0xf3, 0x4f, 0x99,
+	0xae, 0xf3, 0xa6, 0x4e, 0x44, 0xe0, 0xee, 0x54, 0x70, 0xd7, 0x26, 0x35, 0x45, 0xd5, 0xbc, 0xab,
+	0x15, 0x39, 0x9b, 0x08, 0x15, 0x11, 0x4b, 0x15, 0x55, 0x33, 0xbe, 0xc3, 0x14, 0x0a, 0xe0, 0x8d,
+	0x11, 0x55, 0xba, 0xbf, 0x5d, 0xd6, 0x9a, 0xe9, 0x91, 0x5d, 0x03, 0x1d, 0x6f, 0x12, 0x5a, 0x87,
+	0xb0, 0xb2, 0x51, 0x16, 0xed, 0xc1, 0xa2, 0xd2, 0x74, 0xa2, 0xed, 0x6a, 0x3d, 0xe2, 0x0e, 0xa8,
+	0x0a, 0x3d, 0x26, 0x86, 0xe9, 0x3e, 0x4d, 0xd8, 0x7a, 0x0f, 0x60, 0x65, 0xc3, 0x20, 0x74, 0x13,
+	0x96, 0xd2, 0x4e, 0x8e, 0x98, 0x9e, 0xd0, 0x2d, 0x78, 0x4d, 0xcf, 0xfa, 0x31, 0x55, 0xb1, 0x65,
+	0xef, 0x90, 0x92, 0x9e, 0x1d, 0x51, 0x15, 0x9b, 0xab, 0x60, 0x06, 0x73, 0x3a, 0xed, 0x55, 0xb8,
+	0x4e, 0xca, 0x26, 0x63, 0xab, 0xae, 0x75, 0x14, 0x2c, 0xb2, 0xad, 0xa3, 0x68, 0x73, 0x26, 0xec,
+	0x7e, 0x01, 0xb0, 0x68, 0x57, 0x8d, 0x3e, 0x03, 0x58, 0xfd, 0x73, 0xdf, 0xe8, 0xfe, 0x65, 0x36,
+	0xfe, 0xed, 0x7e, 0xd7, 0x3b, 0xff, 0xc1, 0x70, 0x2b, 0x6d, 0x3d, 0x79, 0xf7, 0xed, 0xd7, 0xc7,
+	0xfc, 0x43, 0x74, 0x88, 0x2f, 0x79, 0xd0, 0xa7, 0x1d, 0x7b, 0x50, 0xfd, 0x70, 0xde, 0xcf, 0xde,
+	0x09, 0x7e, 0x93, 0x85, 0x6f, 0x7b, 0xaf, 0xce, 0x16, 0x3e, 0x38, 0x5f, 0xf8, 0xe0, 0xe7, 0xc2,
+	0x07, 0x1f, 0x96, 0x7e, 0xee, 0x7c, 0xe9, 0xe7, 0xbe, 0x2f, 0xfd, 0xdc, 0xeb, 0xc7, 0x11, 0xd7,
+	0xf1, 0x34, 0x0c, 0x06, 0x72, 0x9c, 0x95, 0x96, 0x93, 0x28, 0x8b, 0xef, 0xd1, 0x24, 0xc1, 0xee,
+	0x81, 0x5f, 0xec, 0x1c, 0x96, 0x2c, 0xf2, 0xe0, 0xf7, 0x00, 0xb1, 0x71, 0x7f, 0x1d, 0x67, 0x04,
+	0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// BlobsByNamespace returns the blobs of the namespace that were included in
+	// blocks within the given height range, ordered by height and by their
+	// position in the block.
+	BlobsByNamespace(ctx context.Context, in *QueryBlobsByNamespaceRequest, opts ...grpc.CallOption) (*QueryBlobsByNamespaceResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) BlobsByNamespace(ctx context.Context, in *QueryBlobsByNamespaceRequest, opts ...grpc.CallOption) (*QueryBlobsByNamespaceResponse, error) {
+	out := new(QueryBlobsByNamespaceResponse)
+	err := c.cc.Invoke(ctx, "/celestia.blobindex.Query/BlobsByNamespace", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// BlobsByNamespace returns the blobs of the namespace that were included in
+	// blocks within the given height range, ordered by height and by their
+	// position in the block.
+	BlobsByNamespace(context.Context, *QueryBlobsByNamespaceRequest) (*QueryBlobsByNamespaceResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) BlobsByNamespace(ctx context.Context, req *QueryBlobsByNamespaceRequest) (*QueryBlobsByNamespaceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlobsByNamespace not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_BlobsByNamespace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBlobsByNamespaceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BlobsByNamespace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celestia.blobindex.Query/BlobsByNamespace",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BlobsByNamespace(ctx, req.(*QueryBlobsByNamespaceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "celestia.blobindex.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "BlobsByNamespace",
+			Handler:    _Query_BlobsByNamespace_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "celestia/blobindex/query.proto",
+}
+
+func (m *QueryBlobsByNamespaceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBlobsByNamespaceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBlobsByNamespaceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.MaxHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MaxHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MinHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MinHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBlobsByNamespaceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBlobsByNamespaceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBlobsByNamespaceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LastIndexedHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.LastIndexedHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.UnindexedHeights) > 0 {
+		for iNdEx := len(m.UnindexedHeights) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UnindexedHeights[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Blobs) > 0 {
+		for iNdEx := len(m.Blobs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Blobs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *HeightRange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HeightRange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HeightRange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.End != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.End))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Start != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Start))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *IndexedBlob) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IndexedBlob) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IndexedBlob) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.End != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.End))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Start != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Start))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.BlobIndex != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BlobIndex))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.TxHash) > 0 {
+		i -= len(m.TxHash)
+		copy(dAtA[i:], m.TxHash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TxHash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryBlobsByNamespaceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.MinHeight != 0 {
+		n += 1 + sovQuery(uint64(m.MinHeight))
+	}
+	if m.MaxHeight != 0 {
+		n += 1 + sovQuery(uint64(m.MaxHeight))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBlobsByNamespaceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Blobs) > 0 {
+		for _, e := range m.Blobs {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.UnindexedHeights) > 0 {
+		for _, e := range m.UnindexedHeights {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.LastIndexedHeight != 0 {
+		n += 1 + sovQuery(uint64(m.LastIndexedHeight))
+	}
+	return n
+}
+
+func (m *HeightRange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Start != 0 {
+		n += 1 + sovQuery(uint64(m.Start))
+	}
+	if m.End != 0 {
+		n += 1 + sovQuery(uint64(m.End))
+	}
+	return n
+}
+
+func (m *IndexedBlob) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	l = len(m.TxHash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.BlobIndex != 0 {
+		n += 1 + sovQuery(uint64(m.BlobIndex))
+	}
+	if m.Start != 0 {
+		n += 1 + sovQuery(uint64(m.Start))
+	}
+	if m.End != 0 {
+		n += 1 + sovQuery(uint64(m.End))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryBlobsByNamespaceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBlobsByNamespaceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBlobsByNamespaceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = append(m.Namespace[:0], dAtA[iNdEx:postIndex]...)
+			if m.Namespace == nil {
+				m.Namespace = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinHeight", wireType)
+			}
+			m.MinHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxHeight", wireType)
+			}
+			m.MaxHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBlobsByNamespaceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBlobsByNamespaceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBlobsByNamespaceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Blobs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Blobs = append(m.Blobs, IndexedBlob{})
+			if err := m.Blobs[len(m.Blobs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnindexedHeights", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UnindexedHeights = append(m.UnindexedHeights, HeightRange{})
+			if err := m.UnindexedHeights[len(m.UnindexedHeights)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastIndexedHeight", wireType)
+			}
+			m.LastIndexedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastIndexedHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HeightRange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HeightRange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HeightRange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
+			}
+			m.Start = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Start |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field End", wireType)
+			}
+			m.End = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.End |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IndexedBlob) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IndexedBlob: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IndexedBlob: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxHash = append(m.TxHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.TxHash == nil {
+				m.TxHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlobIndex", wireType)
+			}
+			m.BlobIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlobIndex |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
+			}
+			m.Start = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Start |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field End", wireType)
+			}
+			m.End = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.End |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipQuery(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthQuery
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupQuery
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthQuery
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthQuery        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowQuery          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupQuery = fmt.Errorf("proto: unexpected end of group")
+)
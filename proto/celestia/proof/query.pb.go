@@ -0,0 +1,769 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: celestia/proof/query.proto
+
+package proof
+
+import (
+	context "context"
+	fmt "fmt"
+	grpc1 "github.com/gogo/protobuf/grpc"
+	proto "github.com/gogo/protobuf/proto"
+	types "github.com/tendermint/tendermint/proto/tendermint/types"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// QueryNamespaceDataRequest is the request type for the Query/NamespaceData
+// RPC method.
+type QueryNamespaceDataRequest struct {
+	// height of the block
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// namespace is the namespace version followed by the namespace ID
+	Namespace []byte `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+}
+
+func (m *QueryNamespaceDataRequest) Reset()         { *m = QueryNamespaceDataRequest{} }
+func (m *QueryNamespaceDataRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryNamespaceDataRequest) ProtoMessage()    {}
+func (*QueryNamespaceDataRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8ee9337b4396ffef, []int{0}
+}
+func (m *QueryNamespaceDataRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryNamespaceDataRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryNamespaceDataRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryNamespaceDataRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryNamespaceDataRequest.Merge(m, src)
+}
+func (m *QueryNamespaceDataRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryNamespaceDataRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryNamespaceDataRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryNamespaceDataRequest proto.InternalMessageInfo
+
+func (m *QueryNamespaceDataRequest) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *QueryNamespaceDataRequest) GetNamespace() []byte {
+	if m != nil {
+		return m.Namespace
+	}
+	return nil
+}
+
+// QueryNamespaceDataResponse is the response type for the Query/NamespaceData
+// RPC method.
+type QueryNamespaceDataResponse struct {
+	// proof contains the shares of the namespace along with the NMT proofs of
+	// the rows they occupy and the Merkle proofs of those rows to the data root.
+	// If the namespace is absent, the proof contains no shares and a single NMT
+	// absence proof for the row where the namespace would have been located.
+	Proof *types.ShareProof `protobuf:"bytes,1,opt,name=proof,proto3" json:"proof,omitempty"`
+	// blobs are the blobs parsed from the shares of the namespace. It is empty
+	// for reserved namespaces.
+	Blobs []*types.Blob `protobuf:"bytes,2,rep,name=blobs,proto3" json:"blobs,omitempty"`
+	// data_root is the data root of the block at the given height
+	DataRoot []byte `protobuf:"bytes,3,opt,name=data_root,json=dataRoot,proto3" json:"data_root,omitempty"`
+}
+
+func (m *QueryNamespaceDataResponse) Reset()         { *m = QueryNamespaceDataResponse{} }
+func (m *QueryNamespaceDataResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryNamespaceDataResponse) ProtoMessage()    {}
+func (*QueryNamespaceDataResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8ee9337b4396ffef, []int{1}
+}
+func (m *QueryNamespaceDataResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryNamespaceDataResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryNamespaceDataResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryNamespaceDataResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryNamespaceDataResponse.Merge(m, src)
+}
+func (m *QueryNamespaceDataResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryNamespaceDataResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryNamespaceDataResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryNamespaceDataResponse proto.InternalMessageInfo
+
+func (m *QueryNamespaceDataResponse) GetProof() *types.ShareProof {
+	if m != nil {
+		return m.Proof
+	}
+	return nil
+}
+
+func (m *QueryNamespaceDataResponse) GetBlobs() []*types.Blob {
+	if m != nil {
+		return m.Blobs
+	}
+	return nil
+}
+
+func (m *QueryNamespaceDataResponse) GetDataRoot() []byte {
+	if m != nil {
+		return m.DataRoot
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*QueryNamespaceDataRequest)(nil), "celestia.proof.QueryNamespaceDataRequest")
+	proto.RegisterType((*QueryNamespaceDataResponse)(nil), "celestia.proof.QueryNamespaceDataResponse")
+}
+
+func init() { proto.RegisterFile("celestia/proof/query.proto", fileDescriptor_8ee9337b4396ffef) }
+
+var fileDescriptor_8ee9337b4396ffef = []byte{
+	// 377 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x91, 0x41, 0x6b, 0xe2, 0x40,
+	0x1c, 0xc5, 0x1d, 0x45, 0x59, 0xc7, 0xdd, 0x3d, 0xcc, 0x41, 0xb2, 0xd9, 0x10, 0xc4, 0x93, 0xbb,
+	0xec, 0x66, 0xd8, 0x2c, 0x14, 0x4f, 0x3d, 0x48, 0xcf, 0xa5, 0xa6, 0xb7, 0x5e, 0x64, 0xa2, 0xd3,
+	0x24, 0x10, 0xf3, 0x1f, 0x33, 0x63, 0x41, 0xc4, 0x4b, 0x3f, 0x41, 0xa1, 0xc7, 0xde, 0x7b, 0xee,
+	0xc7, 0xe8, 0x51, 0xe8, 0xa5, 0xc7, 0xa2, 0xfd, 0x20, 0x25, 0x99, 0x1a, 0xd1, 0xb6, 0xd0, 0x4b,
+	0x48, 0xfe, 0xef, 0xe5, 0xcd, 0x6f, 0xde, 0x1f, 0x9b, 0x43, 0x1e, 0x73, 0xa9, 0x22, 0x46, 0x45,
+	0x0a, 0x70, 0x4e, 0x27, 0x53, 0x9e, 0xce, 0x1c, 0x91, 0x82, 0x02, 0xf2, 0x7d, 0xa3, 0x39, 0xb9,
+	0x66, 0x5a, 0x01, 0x40, 0x10, 0x73, 0xca, 0x44, 0x44, 0x59, 0x92, 0x80, 0x62, 0x2a, 0x82, 0x44,
+	0x6a, 0xb7, 0x69, 0x29, 0x9e, 0x8c, 0x78, 0x3a, 0x8e, 0x12, 0x45, 0xd5, 0x4c, 0x70, 0xa9, 0x9f,
+	0x5a, 0x6d, 0xf7, 0xf1, 0x8f, 0x7e, 0x16, 0x7d, 0xcc, 0xc6, 0x5c, 0x0a, 0x36, 0xe4, 0x47, 0x4c,
+	0x31, 0x8f, 0x4f, 0xa6, 0x5c, 0x2a, 0xd2, 0xc4, 0xb5, 0x90, 0x47, 0x41, 0xa8, 0x0c, 0xd4, 0x42,
+	0x9d, 0x8a, 0xf7, 0xfa, 0x45, 0x2c, 0x5c, 0x4f, 0x36, 0x7e, 0xa3, 0xdc, 0x42, 0x9d, 0xaf, 0xde,
+	0x76, 0xd0, 0xbe, 0x41, 0xd8, 0x7c, 0x2f, 0x53, 0x0a, 0x48, 0x24, 0x27, 0x2e, 0xae, 0xe6, 0xd8,
+	0x79, 0x66, 0xc3, 0xb5, 0x9c, 0x2d, 0x9f, 0xa3, 0xc9, 0x4e, 0x43, 0x96, 0xf2, 0x93, 0xcc, 0xe3,
+	0x69, 0x2b, 0xf9, 0x83, 0xab, 0x7e, 0x0c, 0xbe, 0x34, 0xca, 0xad, 0x4a, 0xa7, 0xe1, 0x36, 0xdf,
+	0xfe, 0xd3, 0x8b, 0xc1, 0xf7, 0xb4, 0x89, 0xfc, 0xc4, 0xf5, 0x11, 0x53, 0x6c, 0x90, 0x02, 0x28,
+	0xa3, 0x92, 0xe3, 0x7d, 0xc9, 0x06, 0x1e, 0x80, 0x72, 0xef, 0x10, 0xae, 0xe6, 0x74, 0xe4, 0x16,
+	0xe1, 0x6f, 0x3b, 0x88, 0xe4, 0x97, 0xb3, 0xdb, 0xac, 0xf3, 0x61, 0x35, 0xe6, 0xef, 0xcf, 0x58,
+	0xf5, 0x8d, 0xdb, 0x87, 0x97, 0x0f, 0xcf, 0xd7, 0xe5, 0x2e, 0x39, 0xa0, 0x7b, 0x4b, 0xbd, 0xf8,
+	0x47, 0x8b, 0xda, 0x06, 0x19, 0x21, 0x9d, 0xeb, 0x86, 0x17, 0x74, 0x5e, 0x08, 0x8b, 0x9e, 0x77,
+	0xbf, 0xb2, 0xd1, 0x72, 0x65, 0xa3, 0xa7, 0x95, 0x8d, 0xae, 0xd6, 0x76, 0x69, 0xb9, 0xb6, 0x4b,
+	0x8f, 0x6b, 0xbb, 0x74, 0xd6, 0x0d, 0x22, 0x15, 0x4e, 0x7d, 0x67, 0x08, 0xe3, 0x22, 0x1b, 0xd2,
+	0xa0, 0x78, 0xff, 0xcb, 0x84, 0xa0, 0xf9, 0xa2, 0xf7, 0x8e, 0xf6, 0x6b, 0xf9, 0xf4, 0xff, 0xcb,
+	0x00, 0x5b, 0x01, 0xc2, 0x02, 0x68, 0x02, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// NamespaceData reconstructs the data square of the block at the given
+	// height and returns all of the shares of the namespace, the blobs parsed
+	// from them and the proofs of their inclusion in the data root. If the
+	// namespace is not present in the square, an NMT absence proof is returned
+	// instead.
+	NamespaceData(ctx context.Context, in *QueryNamespaceDataRequest, opts ...grpc.CallOption) (*QueryNamespaceDataResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) NamespaceData(ctx context.Context, in *QueryNamespaceDataRequest, opts ...grpc.CallOption) (*QueryNamespaceDataResponse, error) {
+	out := new(QueryNamespaceDataResponse)
+	err := c.cc.Invoke(ctx, "/celestia.proof.Query/NamespaceData", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// NamespaceData reconstructs the data square of the block at the given
+	// height and returns all of the shares of the namespace, the blobs parsed
+	// from them and the proofs of their inclusion in the data root. If the
+	// namespace is not present in the square, an NMT absence proof is returned
+	// instead.
+	NamespaceData(context.Context, *QueryNamespaceDataRequest) (*QueryNamespaceDataResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) NamespaceData(ctx context.Context, req *QueryNamespaceDataRequest) (*QueryNamespaceDataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NamespaceData not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_NamespaceData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryNamespaceDataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).NamespaceData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celestia.proof.Query/NamespaceData",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).NamespaceData(ctx, req.(*QueryNamespaceDataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "celestia.proof.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "NamespaceData",
+			Handler:    _Query_NamespaceData_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "celestia/proof/query.proto",
+}
+
+func (m *QueryNamespaceDataRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNamespaceDataRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNamespaceDataRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryNamespaceDataResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNamespaceDataResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNamespaceDataResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DataRoot) > 0 {
+		i -= len(m.DataRoot)
+		copy(dAtA[i:], m.DataRoot)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DataRoot)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Blobs) > 0 {
+		for iNdEx := len(m.Blobs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Blobs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Proof != nil {
+		{
+			size, err := m.Proof.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryNamespaceDataRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryNamespaceDataResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Proof != nil {
+		l = m.Proof.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Blobs) > 0 {
+		for _, e := range m.Blobs {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = len(m.DataRoot)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryNamespaceDataRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryNamespaceDataRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryNamespaceDataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = append(m.Namespace[:0], dAtA[iNdEx:postIndex]...)
+			if m.Namespace == nil {
+				m.Namespace = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryNamespaceDataResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryNamespaceDataResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryNamespaceDataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Proof == nil {
+				m.Proof = &types.ShareProof{}
+			}
+			if err := m.Proof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Blobs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Blobs = append(m.Blobs, &types.Blob{})
+			if err := m.Blobs[len(m.Blobs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DataRoot = append(m.DataRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.DataRoot == nil {
+				m.DataRoot = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipQuery(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthQuery
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupQuery
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthQuery
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthQuery        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowQuery          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupQuery = fmt.Errorf("proto: unexpected end of group")
+)
@@ -8,14 +8,12 @@ import (
 
 	"github.com/celestiaorg/celestia-app/app"
 	"github.com/celestiaorg/celestia-app/pkg/appconsts"
-	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/celestiaorg/celestia-app/pkg/user"
+	blob "github.com/celestiaorg/celestia-app/x/blob/types"
 	"github.com/cosmos/cosmos-sdk/crypto/hd"
 	"github.com/cosmos/cosmos-sdk/crypto/keyring"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	"github.com/cosmos/cosmos-sdk/types"
-	"github.com/cosmos/cosmos-sdk/types/tx/signing"
-	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
-	auth "github.com/cosmos/cosmos-sdk/x/auth/types"
 	bank "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/rs/zerolog/log"
 )
@@ -34,12 +32,14 @@ type AccountManager struct {
 	accounts      map[string]*Account
 }
 
+// Account is an account used by txsim. Its transactions are signed and
+// submitted by its client, which tracks its sequence.
 type Account struct {
-	Address       types.AccAddress
-	PubKey        cryptotypes.PubKey
-	Sequence      uint64
-	AccountNumber uint64
-	Balance       int64
+	Address types.AccAddress
+	PubKey  cryptotypes.PubKey
+	Balance int64
+
+	client *user.TxClient
 }
 
 func NewAccountManager(ctx context.Context, keys keyring.Keyring, txClient *TxClient, queryClient *QueryClient) (*AccountManager, error) {
@@ -100,9 +100,9 @@ func (am *AccountManager) setupMasterAccount(ctx context.Context) error {
 
 		// the master account is the account with the highest balance
 		if am.masterAccount == nil || balance > am.masterAccount.Balance {
-			accountNumber, sequence, err := am.getAccountDetails(ctx, address)
+			client, err := am.newClient(ctx, record)
 			if err != nil {
-				log.Err(err).Str("account", record.Name).Msg("error getting initial account details")
+				log.Err(err).Str("account", record.Name).Msg("error setting up client for account")
 				continue
 			}
 			pk, err := record.GetPubKey()
@@ -110,11 +110,10 @@ func (am *AccountManager) setupMasterAccount(ctx context.Context) error {
 				return fmt.Errorf("error getting public key for account %s: %w", record.Name, err)
 			}
 			am.masterAccount = &Account{
-				Address:       address,
-				PubKey:        pk,
-				Sequence:      sequence,
-				AccountNumber: accountNumber,
-				Balance:       balance,
+				Address: address,
+				PubKey:  pk,
+				Balance: balance,
+				client:  client,
 			}
 		}
 	}
@@ -170,27 +169,26 @@ func (am *AccountManager) Submit(ctx context.Context, op Operation) error {
 		}
 	}
 
-	// create the tx builder and add the messages
-	builder := am.tx.Tx()
-	err := builder.SetMsgs(op.Msgs...)
+	account, err := am.signer(op.Msgs)
 	if err != nil {
-		return fmt.Errorf("error setting messages: %w", err)
+		return err
 	}
 
+	var opts []blob.TxBuilderOption
 	if op.GasLimit == 0 {
-		builder.SetGasLimit(DefaultGasLimit)
-		builder.SetFeeAmount(types.NewCoins(types.NewInt64Coin(app.BondDenom, int64(defaultFee))))
+		opts = []blob.TxBuilderOption{
+			blob.SetGasLimit(DefaultGasLimit),
+			blob.SetFeeAmount(types.NewCoins(types.NewInt64Coin(app.BondDenom, int64(defaultFee)))),
+		}
 	} else {
-		builder.SetGasLimit(op.GasLimit)
+		gasPrice := appconsts.DefaultMinGasPrice
 		if op.GasPrice > 0 {
-			builder.SetFeeAmount(types.NewCoins(types.NewInt64Coin(app.BondDenom, int64(float64(op.GasLimit)*op.GasPrice))))
-		} else {
-			builder.SetFeeAmount(types.NewCoins(types.NewInt64Coin(app.BondDenom, int64(float64(op.GasLimit)*appconsts.DefaultMinGasPrice))))
+			gasPrice = op.GasPrice
+		}
+		opts = []blob.TxBuilderOption{
+			blob.SetGasLimit(op.GasLimit),
+			blob.SetFeeAmount(types.NewCoins(types.NewInt64Coin(app.BondDenom, int64(float64(op.GasLimit)*gasPrice)))),
 		}
-	}
-
-	if err := am.signTransaction(builder); err != nil {
-		return err
 	}
 
 	// If the sequence specified a delay, then wait for those blocks to be produced
@@ -200,20 +198,15 @@ func (am *AccountManager) Submit(ctx context.Context, op Operation) error {
 		}
 	}
 
-	// broadcast the transaction
-	resp, err := am.tx.Broadcast(ctx, builder, op.Blobs)
+	// sign, broadcast and wait for the transaction to be committed
+	resp, err := account.client.SubmitTx(ctx, op.Msgs, op.Blobs, opts...)
 	if err != nil {
-		return fmt.Errorf("error broadcasting transaction: %w", err)
+		return fmt.Errorf("error submitting transaction: %w", err)
 	}
 
-	signers := builder.GetTx().GetSigners()
-
-	// increment the sequence number for all the signers
-	am.incrementSignerSequences(signers)
-
 	log.Info().
 		Int64("height", resp.Height).
-		Str("signers", addrsToString(signers)).
+		Str("signer", account.Address.String()).
 		Str("msgs", msgsToString(op.Msgs)).
 		Msg("tx committed")
 
@@ -243,11 +236,15 @@ func (am *AccountManager) GenerateAccounts(ctx context.Context) error {
 		return fmt.Errorf("error funding accounts: %w", err)
 	}
 
-	// check that the account now exists
+	// set up a client for each account, which checks that it now exists
 	for _, acc := range am.pending {
-		acc.AccountNumber, acc.Sequence, err = am.getAccountDetails(ctx, acc.Address)
+		record, err := am.keys.KeyByAddress(acc.Address)
+		if err != nil {
+			return fmt.Errorf("error getting key for account %s: %w", acc.Address, err)
+		}
+		acc.client, err = am.newClient(ctx, record)
 		if err != nil {
-			return fmt.Errorf("getting account %s: %w", acc.Address, err)
+			return fmt.Errorf("setting up client for account %s: %w", acc.Address, err)
 		}
 
 		// set the account
@@ -256,8 +253,6 @@ func (am *AccountManager) GenerateAccounts(ctx context.Context) error {
 			Str("address", acc.Address.String()).
 			Int64("balance", acc.Balance).
 			Str("pubkey", acc.PubKey.String()).
-			Uint64("account number", acc.AccountNumber).
-			Uint64("sequence", acc.Sequence).
 			Msg("initialized account")
 	}
 
@@ -272,97 +267,38 @@ func (am *AccountManager) GenerateAccounts(ctx context.Context) error {
 	return nil
 }
 
-func (am *AccountManager) signTransaction(builder client.TxBuilder) error {
-	signers := builder.GetTx().GetSigners()
-	for _, signer := range signers {
-		_, ok := am.accounts[signer.String()]
-		if !ok {
-			return fmt.Errorf("account %s not found", signer.String())
-		}
-	}
-
-	// To ensure we have the correct bytes to sign over we produce
-	// a dry run of the signing data
-	draftsigV2 := make([]signing.SignatureV2, len(signers))
-	index := 0
-	for _, signer := range signers {
-		acc := am.accounts[signer.String()]
-		record, err := am.keys.KeyByAddress(signer)
-		if err != nil {
-			return fmt.Errorf("error getting key for account %s: %w", signer.String(), err)
-		}
-		pk, _ := record.GetPubKey()
-		if !pk.Equals(acc.PubKey) {
-			return fmt.Errorf("public key (%s != %s) mismatch for account %s", pk.String(), acc.PubKey.String(), signer.String())
-		}
-		draftsigV2[index] = signing.SignatureV2{
-			PubKey: acc.PubKey,
-			Data: &signing.SingleSignatureData{
-				SignMode:  signing.SignMode_SIGN_MODE_DIRECT,
-				Signature: nil,
-			},
-			Sequence: acc.Sequence,
+// signer returns the account that signs the messages. Transactions must be
+// signed by a single account that is managed by the account manager.
+func (am *AccountManager) signer(msgs []types.Msg) (*Account, error) {
+	var signer types.AccAddress
+	for _, msg := range msgs {
+		for _, addr := range msg.GetSigners() {
+			if signer == nil {
+				signer = addr
+			} else if !signer.Equals(addr) {
+				return nil, fmt.Errorf("transactions with multiple signers (%s, %s) are not supported", signer, addr)
+			}
 		}
-		index++
 	}
-
-	err := builder.SetSignatures(draftsigV2...)
-	if err != nil {
-		return fmt.Errorf("error setting draft signatures: %w", err)
-	}
-
-	// now we can use the data to produce the signature from each signer
-	index = 0
-	sigV2 := make([]signing.SignatureV2, len(signers))
-	for _, signer := range signers {
-		acc := am.accounts[signer.String()]
-		signature, err := am.createSignature(acc, builder)
-		if err != nil {
-			return fmt.Errorf("error creating signature: %w", err)
-		}
-		sigV2[index] = signing.SignatureV2{
-			PubKey: acc.PubKey,
-			Data: &signing.SingleSignatureData{
-				SignMode:  signing.SignMode_SIGN_MODE_DIRECT,
-				Signature: signature,
-			},
-			Sequence: acc.Sequence,
-		}
-		index++
+	if signer == nil {
+		return nil, fmt.Errorf("transaction has no signer")
 	}
 
-	err = builder.SetSignatures(sigV2...)
-	if err != nil {
-		return fmt.Errorf("error setting signatures: %w", err)
+	am.mtx.Lock()
+	defer am.mtx.Unlock()
+	account, ok := am.accounts[signer.String()]
+	if !ok {
+		return nil, fmt.Errorf("account %s not found", signer.String())
 	}
-
-	return nil
+	return account, nil
 }
 
-func (am *AccountManager) createSignature(account *Account, builder client.TxBuilder) ([]byte, error) {
-	signerData := authsigning.SignerData{
-		Address:       account.Address.String(),
-		ChainID:       am.tx.ChainID(),
-		AccountNumber: account.AccountNumber,
-		Sequence:      account.Sequence,
-		PubKey:        account.PubKey,
-	}
-
-	bytesToSign, err := am.tx.encCfg.TxConfig.SignModeHandler().GetSignBytes(
-		signing.SignMode_SIGN_MODE_DIRECT,
-		signerData,
-		builder.GetTx(),
-	)
-	if err != nil {
-		return nil, fmt.Errorf("error getting sign bytes: %w", err)
-	}
-
-	signature, _, err := am.keys.SignByAddress(account.Address, bytesToSign)
-	if err != nil {
-		return nil, fmt.Errorf("error signing bytes: %w", err)
-	}
-
-	return signature, nil
+// newClient returns a client that signs transactions with the key of the
+// record. The account must exist on chain.
+func (am *AccountManager) newClient(ctx context.Context, record *keyring.Record) (*user.TxClient, error) {
+	signer := blob.NewKeyringSigner(am.keys, record.Name, am.tx.ChainID())
+	signer.SetEncodingConfig(am.tx.encCfg)
+	return user.SetupTxClient(ctx, signer, am.query.grpcConn(), user.WithPollTime(am.tx.pollTime))
 }
 
 func (am *AccountManager) updateAccount(ctx context.Context, account *Account) error {
@@ -370,16 +306,10 @@ func (am *AccountManager) updateAccount(ctx context.Context, account *Account) e
 	if err != nil {
 		return fmt.Errorf("getting account balance: %w", err)
 	}
-	newAccountNumber, newSequence, err := am.getAccountDetails(ctx, account.Address)
-	if err != nil {
-		return fmt.Errorf("getting account details: %w", err)
-	}
 
 	am.mtx.Lock()
 	defer am.mtx.Unlock()
 	account.Balance = newBalance
-	account.AccountNumber = newAccountNumber
-	account.Sequence = newSequence
 	return nil
 }
 
@@ -395,46 +325,12 @@ func (am *AccountManager) getBalance(ctx context.Context, address types.AccAddre
 	return balanceResp.GetBalance().Amount.Int64(), nil
 }
 
-// getAccountDetails returns the account number and sequence for the given address
-func (am *AccountManager) getAccountDetails(ctx context.Context, address types.AccAddress) (uint64, uint64, error) {
-	accountResp, err := am.query.Auth().Account(ctx, &auth.QueryAccountRequest{
-		Address: address.String(),
-	})
-	if err != nil {
-		return 0, 0, fmt.Errorf("error getting account state for %s: %w", address.String(), err)
-	}
-
-	var acc auth.AccountI
-	err = am.tx.encCfg.InterfaceRegistry.UnpackAny(accountResp.Account, &acc)
-	if err != nil {
-		return 0, 0, fmt.Errorf("error unpacking account: %w", err)
-	}
-
-	return acc.GetAccountNumber(), acc.GetSequence(), nil
-}
-
-func (am *AccountManager) incrementSignerSequences(signers []types.AccAddress) {
-	am.mtx.Lock()
-	defer am.mtx.Unlock()
-	for _, signer := range signers {
-		am.accounts[signer.String()].Sequence++
-	}
-}
-
 func (am *AccountManager) nextAccountName() string {
 	return accountName(len(am.pending) + len(am.accounts))
 }
 
 func accountName(n int) string { return fmt.Sprintf("tx-sim-%d", n) }
 
-func addrsToString(addrs []types.AccAddress) string {
-	addrsStr := make([]string, len(addrs))
-	for i, addr := range addrs {
-		addrsStr[i] = addr.String()
-	}
-	return strings.Join(addrsStr, ",")
-}
-
 func msgsToString(msgs []types.Msg) string {
 	msgsStr := make([]string, len(msgs))
 	for i, msg := range msgs {
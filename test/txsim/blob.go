@@ -5,7 +5,6 @@ import (
 	"fmt"
 	"math/rand"
 
-	"github.com/celestiaorg/celestia-app/pkg/appconsts"
 	ns "github.com/celestiaorg/celestia-app/pkg/namespace"
 	"github.com/celestiaorg/celestia-app/test/util/blobfactory"
 	blob "github.com/celestiaorg/celestia-app/x/blob/types"
@@ -86,10 +85,21 @@ func (s *BlobSequence) Next(_ context.Context, _ grpc.ClientConn, rand *rand.Ran
 	return Operation{
 		Msgs:     []types.Msg{msg},
 		Blobs:    blobs,
-		GasLimit: EstimateGas(sizes),
+		GasLimit: estimateGas(msg.BlobSizes, msg.ShareVersions),
 	}, nil
 }
 
+// gasEstimateTolerance is the factor by which the gas limit of a PFB exceeds
+// the default gas estimate of its blobs, so that the PFB doesn't run out of
+// gas if the estimate is slightly low.
+const gasEstimateTolerance = 1.1
+
+// estimateGas estimates the gas required to pay for a set of blobs in a PFB,
+// including a tolerance.
+func estimateGas(blobSizes, shareVersions []uint32) uint64 {
+	return uint64(float64(blob.DefaultEstimateGas(blobSizes, shareVersions)) * gasEstimateTolerance)
+}
+
 type Range struct {
 	Min int
 	Max int
@@ -106,19 +116,3 @@ func (r Range) Rand(rand *rand.Rand) int {
 	}
 	return rand.Intn(r.Max-r.Min) + r.Min
 }
-
-const (
-	perByteGasTolerance = 2
-	pfbGasFixedCost     = 80000
-)
-
-// EstimateGas estimates the gas required to pay for a set of blobs in a PFB.
-func EstimateGas(blobSizes []int) uint64 {
-	totalByteCount := 0
-	for _, size := range blobSizes {
-		totalByteCount += size
-	}
-	variableGasAmount := (appconsts.DefaultGasPerBlobByte + perByteGasTolerance) * totalByteCount
-
-	return uint64(variableGasAmount + pfbGasFixedCost)
-}
@@ -4,19 +4,14 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"strings"
 	"sync"
 	"time"
 
 	"github.com/celestiaorg/celestia-app/app/encoding"
-	blob "github.com/celestiaorg/celestia-app/x/blob/types"
-	sdkclient "github.com/cosmos/cosmos-sdk/client"
 	auth "github.com/cosmos/cosmos-sdk/x/auth/types"
 	bank "github.com/cosmos/cosmos-sdk/x/bank/types"
 	protogrpc "github.com/gogo/protobuf/grpc"
 	"github.com/tendermint/tendermint/rpc/client/http"
-	coretypes "github.com/tendermint/tendermint/rpc/core/types"
-	"github.com/tendermint/tendermint/types"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
 )
@@ -24,18 +19,11 @@ import (
 const (
 	// how often to poll the network for the latest height
 	DefaultPollTime = 3 * time.Second
-
-	// how many times to wait for a transaction to be committed before
-	// concluding that it has failed
-	maxRetries = 10
-
-	rpcContextTimeout = 10 * time.Second
 )
 
-var errTimedOutWaitingForTx = errors.New("timed out waiting for tx to be committed")
-
-// TxClient is a client for submitting transactions to one of several nodes. It uses a round-robin
-// algorithm for multiplexing requests across multiple clients.
+// TxClient is a client for following the height of the network through one of several nodes. It uses
+// a round-robin algorithm for multiplexing requests across multiple clients. Transactions are
+// submitted by the AccountManager through a user.TxClient for each account.
 type TxClient struct {
 	rpcClients []*http.HTTP
 	encCfg     encoding.Config
@@ -93,11 +81,6 @@ func NewTxClient(ctx context.Context, encCfg encoding.Config, pollTime time.Dura
 	}, nil
 }
 
-func (tc *TxClient) Tx() sdkclient.TxBuilder {
-	builder := tc.encCfg.TxConfig.NewTxBuilder()
-	return builder
-}
-
 func (tc *TxClient) ChainID() string {
 	return tc.chainID
 }
@@ -171,35 +154,6 @@ func (tc *TxClient) WaitForHeight(ctx context.Context, height int64) error {
 	}
 }
 
-func (tc *TxClient) WaitForTx(ctx context.Context, txID []byte) (*coretypes.ResultTx, error) {
-	for i := 0; i < maxRetries; i++ {
-		subctx, cancel := context.WithTimeout(ctx, rpcContextTimeout)
-		defer cancel()
-
-		resp, err := tc.Client().Tx(subctx, txID, false)
-		if err != nil {
-			// sub context timed out but the parent hasn't (we retry)
-			if subctx.Err() != nil && ctx.Err() == nil {
-				continue
-			}
-
-			// tx still no longer exists
-			if strings.Contains(err.Error(), "not found") {
-				time.Sleep(tc.pollTime)
-				continue
-			}
-			return nil, err
-		}
-
-		if resp.TxResult.Code != 0 {
-			return nil, fmt.Errorf("non zero code delivering tx (%d): %s", resp.TxResult.Code, resp.TxResult.Log)
-		}
-
-		return resp, nil
-	}
-	return nil, errTimedOutWaitingForTx
-}
-
 // Client multiplexes the RPC clients
 func (tc *TxClient) Client() *http.HTTP {
 	tc.mtx.Lock()
@@ -208,47 +162,6 @@ func (tc *TxClient) Client() *http.HTTP {
 	return tc.rpcClients[tc.index]
 }
 
-// Broadcast encodes and broadcasts a transaction to the network. If CheckTx fails,
-// the error will be returned. The method does not wait for the transaction to be
-// included in a block.
-func (tc *TxClient) Broadcast(ctx context.Context, txBuilder sdkclient.TxBuilder, blobs []*blob.Blob) (*coretypes.ResultTx, error) {
-	tx, err := tc.encCfg.TxConfig.TxEncoder()(txBuilder.GetTx())
-	if err != nil {
-		return nil, fmt.Errorf("error encoding tx: %w", err)
-	}
-
-	// If blobs exist, these are bundled into the existing tx.
-	if len(blobs) > 0 {
-		txWithBlobs, err := types.MarshalBlobTx(tx, blobs...)
-		if err != nil {
-			return nil, err
-		}
-		tx = txWithBlobs
-	}
-
-	for {
-		if ctx.Err() != nil {
-			return nil, ctx.Err()
-		}
-		subctx, cancel := context.WithTimeout(ctx, rpcContextTimeout)
-		defer cancel()
-
-		resp, err := tc.Client().BroadcastTxSync(subctx, tx)
-		if err != nil {
-			if subctx.Err() != nil {
-				continue
-			}
-			return nil, err
-		}
-
-		if resp.Code != 0 {
-			return nil, fmt.Errorf("non zero code checking tx (%d): %s", resp.Code, resp.Log)
-		}
-
-		return tc.WaitForTx(ctx, resp.Hash)
-	}
-}
-
 // next iterates the index of the RPC clients. It is not thread safe and should be called within a mutex.
 func (tc *TxClient) next() {
 	tc.index = (tc.index + 1) % len(tc.rpcClients)
@@ -284,6 +197,12 @@ func (qc *QueryClient) next() {
 }
 
 func (qc *QueryClient) Conn() protogrpc.ClientConn {
+	return qc.grpcConn()
+}
+
+// grpcConn returns the next connection as a *grpc.ClientConn, which is
+// required to set up a user.TxClient.
+func (qc *QueryClient) grpcConn() *grpc.ClientConn {
 	qc.mtx.Lock()
 	defer qc.mtx.Unlock()
 	defer qc.next()
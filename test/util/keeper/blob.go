@@ -3,6 +3,7 @@ package keeper
 import (
 	"testing"
 
+	"github.com/celestiaorg/celestia-app/pkg/appconsts"
 	testutil "github.com/celestiaorg/celestia-app/test/util"
 	"github.com/celestiaorg/celestia-app/x/blob/keeper"
 	"github.com/celestiaorg/celestia-app/x/blob/types"
@@ -11,14 +12,22 @@ import (
 	"github.com/cosmos/cosmos-sdk/store"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	typesparams "github.com/cosmos/cosmos-sdk/x/params/types"
 	"github.com/stretchr/testify/require"
 	"github.com/tendermint/tendermint/libs/log"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	tmversion "github.com/tendermint/tendermint/proto/tendermint/version"
 	tmdb "github.com/tendermint/tm-db"
 )
 
 func BlobKeeper(t testing.TB) (*keeper.Keeper, sdk.Context) {
+	return BlobKeeperWithAppVersion(t, appconsts.LatestVersion)
+}
+
+// BlobKeeperWithAppVersion returns a blob keeper and a context of a block of
+// the provided app version.
+func BlobKeeperWithAppVersion(t testing.TB, appVersion uint64) (*keeper.Keeper, sdk.Context) {
 	storeKey := sdk.NewKVStoreKey(types.StoreKey)
 	memStoreKey := storetypes.NewMemoryStoreKey(types.MemStoreKey)
 
@@ -42,12 +51,21 @@ func BlobKeeper(t testing.TB) (*keeper.Keeper, sdk.Context) {
 		storeKey,
 		memStoreKey,
 		paramsSubspace,
+		mockAccountKeeper{},
 	)
 
-	ctx := sdk.NewContext(stateStore, tmproto.Header{}, false, log.NewNopLogger())
+	header := tmproto.Header{Version: tmversion.Consensus{App: appVersion}}
+	ctx := sdk.NewContext(stateStore, header, false, log.NewNopLogger())
 
 	// Initialize params
 	k.SetParams(ctx, types.DefaultParams())
 
 	return k, ctx
 }
+
+// mockAccountKeeper returns the default auth params.
+type mockAccountKeeper struct{}
+
+func (mockAccountKeeper) GetParams(sdk.Context) authtypes.Params {
+	return authtypes.DefaultParams()
+}
@@ -7,22 +7,27 @@ import (
 	"strings"
 	"time"
 
+	"github.com/celestiaorg/celestia-app/app"
 	"github.com/celestiaorg/celestia-app/pkg/appconsts"
 	"github.com/celestiaorg/celestia-app/pkg/namespace"
 	appns "github.com/celestiaorg/celestia-app/pkg/namespace"
 	"github.com/celestiaorg/celestia-app/pkg/shares"
+	"github.com/celestiaorg/celestia-app/pkg/user"
 	"github.com/celestiaorg/celestia-app/x/blob/types"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	abci "github.com/tendermint/tendermint/abci/types"
 	tmrand "github.com/tendermint/tendermint/libs/rand"
 	rpctypes "github.com/tendermint/tendermint/rpc/core/types"
-	coretypes "github.com/tendermint/tendermint/types"
 )
 
 const (
 	DefaultTimeout = 10 * time.Second
+
+	// DefaultBlobFee is the fee in utia paid by the PFBs posted by
+	// Context.PostData. It comfortably covers the blob base fee of a
+	// transaction that fills the largest square.
+	DefaultBlobFee = 1000000
 )
 
 type Context struct {
@@ -139,78 +144,48 @@ func (c *Context) WaitForTx(hashHexStr string, blocks int) (*rpctypes.ResultTx,
 }
 
 // PostData will create and submit PFB transaction containing the namespace and
-// blobData. This function blocks until the PFB has been included in a block and
-// returns an error if the transaction is invalid or is rejected by the mempool.
+// blobData. If the broadcast mode is block, this function blocks until the PFB
+// has been included in a block. Otherwise, it returns once the PFB has been
+// accepted by the mempool, i.e. async is handled like sync. It returns an
+// error if the transaction is invalid or is rejected by the mempool.
 func (c *Context) PostData(account, broadcastMode string, ns appns.Namespace, blobData []byte) (*sdk.TxResponse, error) {
 	opts := []types.TxBuilderOption{
 		types.SetGasLimit(100000000000000),
+		types.SetFeeAmount(sdk.NewCoins(sdk.NewCoin(app.BondDenom, sdk.NewInt(DefaultBlobFee)))),
 	}
 
 	// use the key for accounts[i] to create a singer used for a single PFB
 	signer := types.NewKeyringSigner(c.Keyring, account, c.ChainID)
-
-	rec := signer.GetSignerInfo()
-	addr, err := rec.GetAddress()
-	if err != nil {
-		return nil, err
-	}
-
-	acc, seq, err := c.AccountRetriever.GetAccountNumberSequence(c.Context, addr)
+	txClient, err := user.SetupTxClient(c.GoContext(), signer, c.GRPCClient)
 	if err != nil {
 		return nil, err
 	}
 
-	signer.SetAccountNumber(acc)
-	signer.SetSequence(seq)
-
 	blob, err := types.NewBlob(ns, blobData, appconsts.ShareVersionZero)
 	if err != nil {
 		return nil, err
 	}
 
-	msg, err := types.NewMsgPayForBlobs(
-		addr.String(),
-		blob,
-	)
-	if err != nil {
-		return nil, err
-	}
-
-	builder := signer.NewTxBuilder(opts...)
-	stx, err := signer.BuildSignedTx(builder, msg)
-	if err != nil {
-		return nil, err
-	}
-
-	rawTx, err := signer.EncodeTx(stx)
-	if err != nil {
-		return nil, err
-	}
-
-	blobTx, err := coretypes.MarshalBlobTx(rawTx, blob)
-	if err != nil {
-		return nil, err
-	}
-
-	var res *sdk.TxResponse
+	var resp *user.TxResponse
 	switch broadcastMode {
-	case flags.BroadcastSync:
-		res, err = c.BroadcastTxSync(blobTx)
-	case flags.BroadcastAsync:
-		res, err = c.BroadcastTxAsync(blobTx)
+	case flags.BroadcastSync, flags.BroadcastAsync:
+		resp, err = txClient.BroadcastPayForBlob(c.GoContext(), []*types.Blob{blob}, opts...)
 	case flags.BroadcastBlock:
-		res, err = c.BroadcastTxCommit(blobTx)
+		resp, err = txClient.SubmitPayForBlob(c.GoContext(), []*types.Blob{blob}, opts...)
 	default:
 		return nil, fmt.Errorf("unsupported broadcast mode %s; supported modes: sync, async, block", c.BroadcastMode)
 	}
 	if err != nil {
 		return nil, err
 	}
-	if res.Code != abci.CodeTypeOK {
-		return res, fmt.Errorf("failure to broadcast tx (%d): %s", res.Code, res.RawLog)
-	}
 
-	return res, nil
+	return &sdk.TxResponse{
+		Height:    resp.Height,
+		TxHash:    resp.TxHash,
+		Code:      resp.Code,
+		GasWanted: resp.GasWanted,
+		GasUsed:   resp.GasUsed,
+	}, nil
 }
 
 // FillBlock creates and submits a single transaction that is large enough to
@@ -0,0 +1,98 @@
+package ante
+
+import (
+	"cosmossdk.io/errors"
+	"github.com/celestiaorg/celestia-app/x/blob/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// BlobKeeper defines the blob keeper methods used by the ante decorators.
+type BlobKeeper interface {
+	GetBlobBaseFee(ctx sdk.Context) sdk.Dec
+}
+
+// BankKeeper defines the bank keeper methods used to burn the blob base fee.
+type BankKeeper interface {
+	SendCoinsFromModuleToModule(ctx sdk.Context, senderModule, recipientModule string, amt sdk.Coins) error
+	BurnCoins(ctx sdk.Context, moduleName string, amt sdk.Coins) error
+}
+
+// BlobBaseFeeDecorator checks that the fee of a transaction covers the blob
+// base fee for the shares occupied by the blobs of its MsgPayForBlobs and
+// burns that portion of the fee. The remainder of the fee is left to the fee
+// collector. It must be placed after the decorator that deducts the fee and
+// only for the app versions that enable the blob base fee.
+type BlobBaseFeeDecorator struct {
+	blobKeeper BlobKeeper
+	bankKeeper BankKeeper
+	denom      string
+	// checkOnly is true if the fee is only checked against the blob base fee
+	// without burning it. It is used for the transactions of a proposal,
+	// whose fee isn't deducted.
+	checkOnly bool
+}
+
+// NewBlobBaseFeeDecorator returns the decorator used in the ante handler of
+// the app, which burns the blob base fee.
+func NewBlobBaseFeeDecorator(blobKeeper BlobKeeper, bankKeeper BankKeeper, denom string) BlobBaseFeeDecorator {
+	return BlobBaseFeeDecorator{
+		blobKeeper: blobKeeper,
+		bankKeeper: bankKeeper,
+		denom:      denom,
+	}
+}
+
+// NewProposalBlobBaseFeeDecorator returns the decorator used to check the
+// transactions of a proposal, which rejects transactions whose fee doesn't
+// cover the blob base fee so that they don't take up space in the square only
+// to fail in DeliverTx. Unlike in the ante handler of the app, the fee is also
+// checked in simulate mode, which ProcessProposal uses to run its checks.
+func NewProposalBlobBaseFeeDecorator(blobKeeper BlobKeeper, denom string) BlobBaseFeeDecorator {
+	return BlobBaseFeeDecorator{
+		blobKeeper: blobKeeper,
+		denom:      denom,
+		checkOnly:  true,
+	}
+}
+
+func (d BlobBaseFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	sharesUsed := 0
+	for _, msg := range tx.GetMsgs() {
+		if pfb, ok := types.PFBFromMsg(msg); ok {
+			sharesUsed += pfb.SharesUsed()
+		}
+	}
+	// the fee is not enforced when simulating so that the gas of a
+	// transaction can be estimated before its fee is known
+	if sharesUsed == 0 || (simulate && !d.checkOnly) {
+		return next(ctx, tx, simulate)
+	}
+
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return ctx, errors.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
+	}
+
+	// the burn is not charged to the transaction so that its gas doesn't
+	// depend on whether it is simulated
+	burnCtx := ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+	baseFee := d.blobKeeper.GetBlobBaseFee(burnCtx)
+	burn := sdk.NewCoins(sdk.NewCoin(d.denom, types.BlobBaseFeeAmount(baseFee, uint64(sharesUsed))))
+	if fee := feeTx.GetFee(); !fee.IsAllGTE(burn) {
+		return ctx, errors.Wrapf(sdkerrors.ErrInsufficientFee, "insufficient fee for %d blob shares at a blob base fee of %s%s: got %s, required %s", sharesUsed, baseFee, d.denom, fee, burn)
+	}
+	if d.checkOnly {
+		return next(ctx, tx, simulate)
+	}
+
+	if err := d.bankKeeper.SendCoinsFromModuleToModule(burnCtx, authtypes.FeeCollectorName, types.ModuleName, burn); err != nil {
+		return ctx, err
+	}
+	if err := d.bankKeeper.BurnCoins(burnCtx, types.ModuleName, burn); err != nil {
+		return ctx, err
+	}
+
+	return next(ctx, tx, simulate)
+}
@@ -0,0 +1,145 @@
+package ante_test
+
+import (
+	"testing"
+
+	"github.com/celestiaorg/celestia-app/x/blob/ante"
+	"github.com/celestiaorg/celestia-app/x/blob/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const denom = "utia"
+
+func TestBlobBaseFeeDecorator(t *testing.T) {
+	pfb := &types.MsgPayForBlobs{
+		BlobSizes:     []uint32{100, 1000},
+		ShareVersions: []uint32{0, 0},
+	}
+	// 1 + 3 shares at 2utia per share
+	baseFee := sdk.NewDec(2)
+	required := sdk.NewCoins(sdk.NewInt64Coin(denom, 8))
+
+	type test struct {
+		name        string
+		msgs        []sdk.Msg
+		fee         sdk.Coins
+		simulate    bool
+		expectedErr error
+		burned      sdk.Coins
+	}
+	tests := []test{
+		{
+			name:   "fee covers the blob base fee",
+			msgs:   []sdk.Msg{pfb},
+			fee:    sdk.NewCoins(sdk.NewInt64Coin(denom, 10)),
+			burned: required,
+		},
+		{
+			name:        "fee does not cover the blob base fee",
+			msgs:        []sdk.Msg{pfb},
+			fee:         sdk.NewCoins(sdk.NewInt64Coin(denom, 7)),
+			expectedErr: sdkerrors.ErrInsufficientFee,
+		},
+		{
+			name:        "fee in a different denom",
+			msgs:        []sdk.Msg{pfb},
+			fee:         sdk.NewCoins(sdk.NewInt64Coin("stake", 10)),
+			expectedErr: sdkerrors.ErrInsufficientFee,
+		},
+		{
+			name: "no MsgPayForBlobs",
+			msgs: []sdk.Msg{&banktypes.MsgSend{}},
+		},
+		{
+			name:     "simulation",
+			msgs:     []sdk.Msg{pfb},
+			simulate: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			bank := &mockBankKeeper{}
+			blobKeeper := mockBlobKeeper{baseFee: baseFee}
+			decorator := ante.NewBlobBaseFeeDecorator(blobKeeper, bank, denom)
+			tx := mockFeeTx{msgs: tt.msgs, fee: tt.fee}
+			_, err := decorator.AnteHandle(sdk.Context{}, tx, tt.simulate, nextAnteHandler)
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.burned, bank.burned)
+		})
+	}
+}
+
+func TestProposalBlobBaseFeeDecorator(t *testing.T) {
+	pfb := &types.MsgPayForBlobs{
+		BlobSizes:     []uint32{100},
+		ShareVersions: []uint32{0},
+	}
+	// 1 share at 2utia per share
+	blobKeeper := mockBlobKeeper{baseFee: sdk.NewDec(2)}
+	decorator := ante.NewProposalBlobBaseFeeDecorator(blobKeeper, denom)
+
+	// the fee is checked even in simulate mode, in which ProcessProposal runs
+	// its checks, but nothing is burned as the fee of a proposal's
+	// transactions isn't deducted
+	for _, simulate := range []bool{false, true} {
+		tx := mockFeeTx{msgs: []sdk.Msg{pfb}, fee: sdk.NewCoins(sdk.NewInt64Coin(denom, 1))}
+		_, err := decorator.AnteHandle(sdk.Context{}, tx, simulate, nextAnteHandler)
+		assert.ErrorIs(t, err, sdkerrors.ErrInsufficientFee)
+
+		tx.fee = sdk.NewCoins(sdk.NewInt64Coin(denom, 2))
+		_, err = decorator.AnteHandle(sdk.Context{}, tx, simulate, nextAnteHandler)
+		require.NoError(t, err)
+	}
+}
+
+func nextAnteHandler(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) {
+	return ctx, nil
+}
+
+type mockBlobKeeper struct {
+	baseFee        sdk.Dec
+	gasPerBlobByte uint32
+}
+
+func (k mockBlobKeeper) GetBlobBaseFee(sdk.Context) sdk.Dec {
+	return k.baseFee
+}
+
+func (k mockBlobKeeper) GasPerBlobByte(sdk.Context) uint32 {
+	return k.gasPerBlobByte
+}
+
+type mockBankKeeper struct {
+	burned sdk.Coins
+}
+
+func (k *mockBankKeeper) SendCoinsFromModuleToModule(sdk.Context, string, string, sdk.Coins) error {
+	return nil
+}
+
+func (k *mockBankKeeper) BurnCoins(_ sdk.Context, _ string, amt sdk.Coins) error {
+	k.burned = k.burned.Add(amt...)
+	return nil
+}
+
+type mockFeeTx struct {
+	msgs       []sdk.Msg
+	fee        sdk.Coins
+	feePayer   sdk.AccAddress
+	feeGranter sdk.AccAddress
+}
+
+func (tx mockFeeTx) GetMsgs() []sdk.Msg         { return tx.msgs }
+func (tx mockFeeTx) ValidateBasic() error       { return nil }
+func (tx mockFeeTx) GetGas() uint64             { return 0 }
+func (tx mockFeeTx) GetFee() sdk.Coins          { return tx.fee }
+func (tx mockFeeTx) FeePayer() sdk.AccAddress   { return tx.feePayer }
+func (tx mockFeeTx) FeeGranter() sdk.AccAddress { return tx.feeGranter }
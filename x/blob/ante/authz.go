@@ -0,0 +1,105 @@
+package ante
+
+import (
+	"time"
+
+	"github.com/celestiaorg/celestia-app/x/blob/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+)
+
+// AuthzKeeper defines the authz keeper methods used to look up and update the
+// grant of a MsgPayForBlobs executed through an authz MsgExec.
+type AuthzKeeper interface {
+	GetAuthorization(ctx sdk.Context, grantee, granter sdk.AccAddress, msgType string) (authz.Authorization, *time.Time)
+	SaveGrant(ctx sdk.Context, grantee, granter sdk.AccAddress, authorization authz.Authorization, expiration *time.Time) error
+	DeleteGrant(ctx sdk.Context, grantee, granter sdk.AccAddress, msgType string) error
+}
+
+// BlobAuthorizationDecorator rejects transactions containing an authz MsgExec
+// that executes a MsgPayForBlobs which the grantee isn't authorized to execute
+// on behalf of its signer. The authz module only checks the grant when the
+// MsgExec is executed, after the blobs have already been included in the
+// square, so the grant must also be checked before a transaction is accepted
+// into a block.
+//
+// The grant updated by the authorization, e.g. the blob bytes spent from a
+// BlobAuthorization, is saved so that later transactions of the mempool or of
+// a proposal are checked against what is left of it.
+type BlobAuthorizationDecorator struct {
+	authzKeeper AuthzKeeper
+	// saveInDeliverTx is true if the updated grant is also saved outside of
+	// CheckTx. It is false in the ante handler of the app because in
+	// DeliverTx the authz module updates the grant when it executes the
+	// MsgExec.
+	saveInDeliverTx bool
+}
+
+// NewBlobAuthorizationDecorator returns the decorator used in the ante handler
+// of the app, which only saves the updated grant in CheckTx.
+func NewBlobAuthorizationDecorator(authzKeeper AuthzKeeper) BlobAuthorizationDecorator {
+	return BlobAuthorizationDecorator{authzKeeper: authzKeeper}
+}
+
+// NewProposalBlobAuthorizationDecorator returns the decorator used to check
+// the transactions of a proposal, which always saves the updated grant.
+func NewProposalBlobAuthorizationDecorator(authzKeeper AuthzKeeper) BlobAuthorizationDecorator {
+	return BlobAuthorizationDecorator{authzKeeper: authzKeeper, saveInDeliverTx: true}
+}
+
+func (d BlobAuthorizationDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	for _, msg := range tx.GetMsgs() {
+		exec, ok := msg.(*authz.MsgExec)
+		if !ok {
+			continue
+		}
+		pfb, ok := types.PFBFromMsg(exec)
+		if !ok {
+			continue
+		}
+		grantee, err := sdk.AccAddressFromBech32(exec.Grantee)
+		if err != nil {
+			return ctx, sdkerrors.ErrInvalidAddress.Wrapf("invalid grantee address: %s", err)
+		}
+		granter, err := sdk.AccAddressFromBech32(pfb.Signer)
+		if err != nil {
+			return ctx, sdkerrors.ErrInvalidAddress.Wrapf("invalid signer address: %s", err)
+		}
+		// like the authz module, a grantee may always execute its own msgs
+		if granter.Equals(grantee) {
+			continue
+		}
+
+		authorization, expiration := d.authzKeeper.GetAuthorization(ctx, grantee, granter, types.URLMsgPayForBlobs)
+		if authorization == nil {
+			return ctx, authz.ErrNoAuthorizationFound.Wrapf("%s is not authorized to pay for blobs on behalf of %s", exec.Grantee, pfb.Signer)
+		}
+		resp, err := authorization.Accept(ctx, pfb)
+		if err != nil {
+			return ctx, err
+		}
+		if !resp.Accept {
+			return ctx, sdkerrors.ErrUnauthorized.Wrapf("%s is not authorized to pay for these blobs on behalf of %s", exec.Grantee, pfb.Signer)
+		}
+		if ctx.IsCheckTx() || d.saveInDeliverTx {
+			if err := d.saveGrant(ctx, grantee, granter, resp, expiration); err != nil {
+				return ctx, err
+			}
+		}
+	}
+	return next(ctx, tx, simulate)
+}
+
+// saveGrant updates the grant in the same way as the authz module does when it
+// executes a MsgExec.
+func (d BlobAuthorizationDecorator) saveGrant(ctx sdk.Context, grantee, granter sdk.AccAddress, resp authz.AcceptResponse, expiration *time.Time) error {
+	switch {
+	case resp.Delete:
+		return d.authzKeeper.DeleteGrant(ctx, grantee, granter, types.URLMsgPayForBlobs)
+	case resp.Updated != nil:
+		return d.authzKeeper.SaveGrant(ctx, grantee, granter, resp.Updated, expiration)
+	default:
+		return nil
+	}
+}
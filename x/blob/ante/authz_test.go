@@ -0,0 +1,162 @@
+package ante_test
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	appns "github.com/celestiaorg/celestia-app/pkg/namespace"
+	"github.com/celestiaorg/celestia-app/x/blob/ante"
+	"github.com/celestiaorg/celestia-app/x/blob/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBlobAuthorizationDecorator(t *testing.T) {
+	granter := sdk.AccAddress("granter")
+	grantee := sdk.AccAddress("grantee")
+	authorized := appns.MustNewV0(bytes.Repeat([]byte{1}, appns.NamespaceVersionZeroIDSize))
+	unauthorized := appns.MustNewV0(bytes.Repeat([]byte{2}, appns.NamespaceVersionZeroIDSize))
+	pfb := func(signer sdk.AccAddress, namespaces ...appns.Namespace) *types.MsgPayForBlobs {
+		msg := &types.MsgPayForBlobs{Signer: signer.String()}
+		for _, ns := range namespaces {
+			msg.Namespaces = append(msg.Namespaces, ns.Bytes())
+		}
+		return msg
+	}
+	exec := func(msgs ...sdk.Msg) *authz.MsgExec {
+		msg := authz.NewMsgExec(grantee, msgs)
+		return &msg
+	}
+
+	type test struct {
+		name        string
+		msgs        []sdk.Msg
+		expectedErr error
+	}
+	tests := []test{
+		{
+			name: "authorized namespace",
+			msgs: []sdk.Msg{exec(pfb(granter, authorized))},
+		},
+		{
+			name:        "unauthorized namespace",
+			msgs:        []sdk.Msg{exec(pfb(granter, authorized, unauthorized))},
+			expectedErr: types.ErrNamespaceNotAuthorized,
+		},
+		{
+			name:        "no grant",
+			msgs:        []sdk.Msg{exec(pfb(sdk.AccAddress("other granter"), authorized))},
+			expectedErr: authz.ErrNoAuthorizationFound,
+		},
+		{
+			name: "grantee signed the MsgPayForBlobs",
+			msgs: []sdk.Msg{exec(pfb(grantee, unauthorized))},
+		},
+		{
+			name: "MsgPayForBlobs without MsgExec",
+			msgs: []sdk.Msg{pfb(sdk.AccAddress("other granter"), unauthorized)},
+		},
+		{
+			name: "MsgExec without MsgPayForBlobs",
+			msgs: []sdk.Msg{exec(&banktypes.MsgSend{})},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			keeper := mockAuthzKeeper{grants: map[string]authz.Authorization{
+				granter.String() + grantee.String(): types.NewBlobAuthorization([]appns.Namespace{authorized}, 0, 0, nil),
+			}}
+			decorator := ante.NewBlobAuthorizationDecorator(keeper)
+			_, err := decorator.AnteHandle(sdk.Context{}, mockFeeTx{msgs: tt.msgs}, false, nextAnteHandler)
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestBlobAuthorizationDecoratorSavesGrant(t *testing.T) {
+	granter := sdk.AccAddress("granter")
+	grantee := sdk.AccAddress("grantee")
+	namespace := appns.MustNewV0(bytes.Repeat([]byte{1}, appns.NamespaceVersionZeroIDSize))
+	exec := authz.NewMsgExec(grantee, []sdk.Msg{&types.MsgPayForBlobs{
+		Signer:     granter.String(),
+		Namespaces: [][]byte{namespace.Bytes()},
+		BlobSizes:  []uint32{600},
+	}})
+
+	type test struct {
+		name      string
+		decorator func(ante.AuthzKeeper) ante.BlobAuthorizationDecorator
+		checkTx   bool
+		// expectedErr is the error returned when executing the tx a second
+		// time, if the first execution spent the grant
+		expectedErr error
+	}
+	tests := []test{
+		{
+			name:        "check tx",
+			decorator:   ante.NewBlobAuthorizationDecorator,
+			checkTx:     true,
+			expectedErr: types.ErrBlobBytesLimitExceeded,
+		},
+		{
+			name:        "proposal",
+			decorator:   ante.NewProposalBlobAuthorizationDecorator,
+			expectedErr: types.ErrBlobBytesLimitExceeded,
+		},
+		{
+			// the authz module saves the grant when it executes the MsgExec
+			name:      "deliver tx",
+			decorator: ante.NewBlobAuthorizationDecorator,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			key := granter.String() + grantee.String()
+			keeper := mockAuthzKeeper{grants: map[string]authz.Authorization{
+				key: types.NewBlobAuthorization([]appns.Namespace{namespace}, 1000, 0, nil),
+			}}
+			decorator := tt.decorator(keeper)
+			ctx := sdk.Context{}.WithIsCheckTx(tt.checkTx)
+			_, err := decorator.AnteHandle(ctx, mockFeeTx{msgs: []sdk.Msg{&exec}}, false, nextAnteHandler)
+			require.NoError(t, err)
+			_, err = decorator.AnteHandle(ctx, mockFeeTx{msgs: []sdk.Msg{&exec}}, false, nextAnteHandler)
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+				assert.EqualValues(t, 600, keeper.grants[key].(*types.BlobAuthorization).BytesUsed)
+				return
+			}
+			require.NoError(t, err)
+			assert.Zero(t, keeper.grants[key].(*types.BlobAuthorization).BytesUsed)
+		})
+	}
+}
+
+type mockAuthzKeeper struct {
+	grants map[string]authz.Authorization
+}
+
+func (k mockAuthzKeeper) GetAuthorization(_ sdk.Context, grantee, granter sdk.AccAddress, msgType string) (authz.Authorization, *time.Time) {
+	authorization, ok := k.grants[granter.String()+grantee.String()]
+	if !ok || authorization.MsgTypeURL() != msgType {
+		return nil, nil
+	}
+	return authorization, nil
+}
+
+func (k mockAuthzKeeper) SaveGrant(_ sdk.Context, grantee, granter sdk.AccAddress, authorization authz.Authorization, _ *time.Time) error {
+	k.grants[granter.String()+grantee.String()] = authorization
+	return nil
+}
+
+func (k mockAuthzKeeper) DeleteGrant(_ sdk.Context, grantee, granter sdk.AccAddress, _ string) error {
+	delete(k.grants, granter.String()+grantee.String())
+	return nil
+}
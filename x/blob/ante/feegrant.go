@@ -0,0 +1,57 @@
+package ante
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+)
+
+// FeeGrantDecorator uses the fee allowance granted to the fee payer of a
+// transaction by its fee granter, without deducting the fee. It is used to
+// check the transactions of a proposal, which don't run the decorator that
+// deducts the fee, so that the limits of an allowance, e.g. the blob bytes
+// left of a BlobAllowance, are enforced before blobs are included in the
+// square. Since the updated allowance is saved, later transactions of the
+// proposal are checked against what is left of it.
+type FeeGrantDecorator struct {
+	feegrantKeeper ante.FeegrantKeeper
+	// ignoreRejected is true if a tx that isn't accepted by the allowance is
+	// passed on without using it rather than returning an error
+	ignoreRejected bool
+}
+
+func NewFeeGrantDecorator(feegrantKeeper ante.FeegrantKeeper) FeeGrantDecorator {
+	return FeeGrantDecorator{feegrantKeeper: feegrantKeeper}
+}
+
+// NewNormalTxFeeGrantDecorator returns a FeeGrantDecorator for the normal
+// transactions of a proposal. They aren't dropped from the proposal when the
+// allowance doesn't accept them, as they simply fail when delivered, but the
+// allowance they use is counted so that it isn't available to the blob
+// transactions that follow them.
+func NewNormalTxFeeGrantDecorator(feegrantKeeper ante.FeegrantKeeper) FeeGrantDecorator {
+	return FeeGrantDecorator{feegrantKeeper: feegrantKeeper, ignoreRejected: true}
+}
+
+func (d FeeGrantDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return ctx, sdkerrors.ErrTxDecode.Wrap("Tx must be a FeeTx")
+	}
+	feePayer, feeGranter := feeTx.FeePayer(), feeTx.FeeGranter()
+	// like the decorator that deducts the fee, a fee payer that is also the
+	// fee granter pays the fee itself
+	if feeGranter != nil && !feeGranter.Equals(feePayer) {
+		if d.ignoreRejected {
+			cacheCtx, write := ctx.CacheContext()
+			if err := d.feegrantKeeper.UseGrantedFees(cacheCtx, feeGranter, feePayer, feeTx.GetFee(), tx.GetMsgs()); err == nil {
+				write()
+			}
+			return next(ctx, tx, simulate)
+		}
+		if err := d.feegrantKeeper.UseGrantedFees(ctx, feeGranter, feePayer, feeTx.GetFee(), tx.GetMsgs()); err != nil {
+			return ctx, sdkerrors.Wrapf(err, "%s does not allow to pay fees for %s", feeGranter, feePayer)
+		}
+	}
+	return next(ctx, tx, simulate)
+}
@@ -0,0 +1,84 @@
+package ante_test
+
+import (
+	"testing"
+
+	"github.com/celestiaorg/celestia-app/x/blob/ante"
+	"github.com/celestiaorg/celestia-app/x/blob/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFeeGrantDecorator(t *testing.T) {
+	granter := sdk.AccAddress("granter")
+	grantee := sdk.AccAddress("grantee")
+	fee := sdk.NewCoins(sdk.NewInt64Coin("utia", 100))
+	pfbTx := func(feePayer, feeGranter sdk.AccAddress) mockFeeTx {
+		return mockFeeTx{
+			msgs:       []sdk.Msg{&types.MsgPayForBlobs{Signer: feePayer.String(), BlobSizes: []uint32{600}}},
+			fee:        fee,
+			feePayer:   feePayer,
+			feeGranter: feeGranter,
+		}
+	}
+
+	type test struct {
+		name string
+		tx   mockFeeTx
+		// expectedErr is the error returned when checking the tx a second
+		// time, if the first check used the allowance
+		expectedErr error
+	}
+	tests := []test{
+		{
+			name:        "fee granter",
+			tx:          pfbTx(grantee, granter),
+			expectedErr: types.ErrBlobBytesLimitExceeded,
+		},
+		{
+			name: "no fee granter",
+			tx:   pfbTx(grantee, nil),
+		},
+		{
+			name: "fee payer is the fee granter",
+			tx:   pfbTx(granter, granter),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			allowance, err := types.NewBlobAllowance(&feegrant.BasicAllowance{}, 1000)
+			require.NoError(t, err)
+			keeper := mockFeegrantKeeper{allowances: map[string]feegrant.FeeAllowanceI{
+				granter.String() + grantee.String(): allowance,
+			}}
+			decorator := ante.NewFeeGrantDecorator(keeper)
+			_, err = decorator.AnteHandle(sdk.Context{}, tt.tx, false, nextAnteHandler)
+			require.NoError(t, err)
+			_, err = decorator.AnteHandle(sdk.Context{}, tt.tx, false, nextAnteHandler)
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+type mockFeegrantKeeper struct {
+	allowances map[string]feegrant.FeeAllowanceI
+}
+
+func (k mockFeegrantKeeper) UseGrantedFees(ctx sdk.Context, granter, grantee sdk.AccAddress, fee sdk.Coins, msgs []sdk.Msg) error {
+	key := granter.String() + grantee.String()
+	allowance, ok := k.allowances[key]
+	if !ok {
+		return feegrant.ErrNoAllowance
+	}
+	remove, err := allowance.Accept(ctx, fee, msgs)
+	if remove {
+		delete(k.allowances, key)
+	}
+	return err
+}
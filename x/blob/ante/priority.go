@@ -0,0 +1,85 @@
+package ante
+
+import (
+	"math"
+
+	"github.com/celestiaorg/celestia-app/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/x/blob/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// PriorityKeeper defines the blob keeper methods used to compute the priority
+// of transactions that pay for blobs.
+type PriorityKeeper interface {
+	BlobKeeper
+	GasPerBlobByte(ctx sdk.Context) uint32
+}
+
+// BlobPriorityDecorator sets the mempool priority of transactions that pay for
+// blobs based on the fee they pay per share occupied by their blobs. To be
+// comparable to the priority of normal transactions, which is their gas price,
+// the fee per share is divided by the gas that a PFB consumes per share at the
+// current GasPerBlobByte. Unlike the gas price, this priority doesn't decrease
+// when the gas limit of the transaction is raised and can't be increased by
+// lowering the gas limit below what the blobs consume. As in the SDK, the
+// lowest priority of the fee's denominations is used. If the app version
+// charges the blob base fee, the part of the fee that is burned is excluded
+// so that the priority reflects the fee that the validators receive. The
+// priority is only set in CheckTx, including on recheck, so that it follows
+// the current GasPerBlobByte and blob base fee.
+type BlobPriorityDecorator struct {
+	blobKeeper PriorityKeeper
+	denom      string
+}
+
+func NewBlobPriorityDecorator(blobKeeper PriorityKeeper, denom string) BlobPriorityDecorator {
+	return BlobPriorityDecorator{blobKeeper: blobKeeper, denom: denom}
+}
+
+func (d BlobPriorityDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	if !ctx.IsCheckTx() || simulate {
+		return next(ctx, tx, simulate)
+	}
+	sharesUsed := 0
+	for _, msg := range tx.GetMsgs() {
+		if pfb, ok := types.PFBFromMsg(msg); ok {
+			sharesUsed += pfb.SharesUsed()
+		}
+	}
+	feeTx, ok := tx.(sdk.FeeTx)
+	if sharesUsed == 0 || !ok || feeTx.GetFee().IsZero() {
+		return next(ctx, tx, simulate)
+	}
+
+	fee := feeTx.GetFee()
+	if appconsts.BlobBaseFeeEnabled(ctx.BlockHeader().Version.App) {
+		burn := sdk.NewCoin(d.denom, types.BlobBaseFeeAmount(d.blobKeeper.GetBlobBaseFee(ctx), uint64(sharesUsed)))
+		// the blob base fee decorator rejects fees that don't cover the burn
+		if remaining, hasNeg := fee.SafeSub(burn); !hasNeg {
+			fee = remaining
+		}
+	}
+	gasPerShare := uint64(appconsts.ShareSize) * uint64(d.blobKeeper.GasPerBlobByte(ctx))
+	sharesGas := sdk.NewIntFromUint64(uint64(sharesUsed) * gasPerShare)
+	if sharesGas.IsZero() {
+		return next(ctx, tx, simulate)
+	}
+
+	return next(ctx.WithPriority(sharesGasPricePriority(fee, sharesGas)), tx, simulate)
+}
+
+// sharesGasPricePriority returns the lowest price that the denominations of
+// fee pay for sharesGas, truncated like the gas price priority of the SDK.
+func sharesGasPricePriority(fee sdk.Coins, sharesGas sdk.Int) int64 {
+	var priority int64
+	for i, coin := range fee {
+		p := int64(math.MaxInt64)
+		if gasPrice := coin.Amount.Quo(sharesGas); gasPrice.IsInt64() {
+			p = gasPrice.Int64()
+		}
+		if i == 0 || p < priority {
+			priority = p
+		}
+	}
+	return priority
+}
@@ -0,0 +1,92 @@
+package ante_test
+
+import (
+	"testing"
+
+	"github.com/celestiaorg/celestia-app/pkg/appconsts"
+	v1 "github.com/celestiaorg/celestia-app/pkg/appconsts/v1"
+	v2 "github.com/celestiaorg/celestia-app/pkg/appconsts/v2"
+	"github.com/celestiaorg/celestia-app/x/blob/ante"
+	"github.com/celestiaorg/celestia-app/x/blob/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	tmversion "github.com/tendermint/tendermint/proto/tendermint/version"
+)
+
+func TestBlobPriorityDecorator(t *testing.T) {
+	// 1 + 3 shares
+	pfb := &types.MsgPayForBlobs{
+		BlobSizes:     []uint32{100, 1000},
+		ShareVersions: []uint32{0, 0},
+	}
+	const gasPerBlobByte = 8
+	sharesGas := int64(4 * appconsts.ShareSize * gasPerBlobByte)
+
+	type test struct {
+		name       string
+		msgs       []sdk.Msg
+		fee        sdk.Coins
+		appVersion uint64
+		isCheckTx  bool
+		want       int64
+	}
+	tests := []test{
+		{
+			name:       "fee per share",
+			msgs:       []sdk.Msg{pfb},
+			fee:        sdk.NewCoins(sdk.NewInt64Coin(denom, 100*sharesGas)),
+			appVersion: v1.Version,
+			isCheckTx:  true,
+			want:       100,
+		},
+		{
+			name:       "lowest priority of the denominations",
+			msgs:       []sdk.Msg{pfb},
+			fee:        sdk.NewCoins(sdk.NewInt64Coin(denom, 100*sharesGas), sdk.NewInt64Coin("stake", 10*sharesGas)),
+			appVersion: v1.Version,
+			isCheckTx:  true,
+			want:       10,
+		},
+		{
+			name:       "blob base fee excluded",
+			msgs:       []sdk.Msg{pfb},
+			fee:        sdk.NewCoins(sdk.NewInt64Coin(denom, 100*sharesGas+8)),
+			appVersion: v2.Version,
+			isCheckTx:  true,
+			want:       100,
+		},
+		{
+			name:       "priority kept for txs without PFBs",
+			msgs:       []sdk.Msg{&banktypes.MsgSend{}},
+			fee:        sdk.NewCoins(sdk.NewInt64Coin(denom, 100*sharesGas)),
+			appVersion: v1.Version,
+			isCheckTx:  true,
+			want:       -1,
+		},
+		{
+			name:       "priority kept in DeliverTx",
+			msgs:       []sdk.Msg{pfb},
+			fee:        sdk.NewCoins(sdk.NewInt64Coin(denom, 100*sharesGas)),
+			appVersion: v1.Version,
+			want:       -1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// 2utia per share
+			blobKeeper := mockBlobKeeper{baseFee: sdk.NewDec(2), gasPerBlobByte: gasPerBlobByte}
+			decorator := ante.NewBlobPriorityDecorator(blobKeeper, denom)
+			ctx := sdk.Context{}.
+				WithBlockHeader(tmproto.Header{Version: tmversion.Consensus{App: tt.appVersion}}).
+				WithIsCheckTx(tt.isCheckTx).
+				WithPriority(-1)
+			tx := mockFeeTx{msgs: tt.msgs, fee: tt.fee}
+			ctx, err := decorator.AnteHandle(ctx, tx, false, nextAnteHandler)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, ctx.Priority())
+		})
+	}
+}
@@ -6,15 +6,21 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
 
 	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v2"
 
+	"github.com/celestiaorg/celestia-app/pkg/appconsts"
 	appns "github.com/celestiaorg/celestia-app/pkg/namespace"
 	"github.com/celestiaorg/celestia-app/x/blob/types"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/input"
 	sdktx "github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/crypto/types/multisig"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	coretypes "github.com/tendermint/tendermint/types"
 )
@@ -27,54 +33,211 @@ const (
 	// FlagNamespaceVersion allows the user to override the namespace version when
 	// submitting a PayForBlob.
 	FlagNamespaceVersion = "namespace-version"
+
+	// FlagBlob allows the user to add a blob read from a file to a PayForBlob.
+	// It is formatted as [hexNamespaceID]:[path] and can be repeated.
+	FlagBlob = "blob"
+
+	// FlagInputFile allows the user to provide the blobs of a PayForBlob via a
+	// JSON or YAML file.
+	FlagInputFile = "input-file"
 )
 
 func CmdPayForBlob() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "PayForBlobs [hexNamespaceID] [hexBlob]",
-		Short: "Pay for a data blob to be published to the Celestia blockchain",
-		Long: "Pay for a data blob to be published to the Celestia blockchain. " +
+		Short: "Pay for data blobs to be published to the Celestia blockchain",
+		Long: "Pay for data blobs to be published to the Celestia blockchain. " +
 			"[hexNamespaceID] must be a 10 byte hex encoded namespace ID. " +
 			"[hexBlob] can be an arbitrary length hex encoded data blob. " +
-			"This command only supports a single blob per invocation. ",
-		Args: cobra.ExactArgs(2),
-		RunE: func(cmd *cobra.Command, args []string) error {
-			namespaceID, err := hex.DecodeString(args[0])
-			if err != nil {
-				return fmt.Errorf("failure to decode hex namespace ID: %w", err)
+			"Additional blobs can be read from files via the --blob and --input-file flags. " +
+			"All blobs are paid for by a single PayForBlobs message and are published atomically. " +
+			"With --gas auto, the fee is derived from the estimated gas and --gas-prices and includes the blob base fee. " +
+			"Fees passed with --fees must cover the blob base fee of the blobs in addition to the gas.",
+		Example: fmt.Sprintf(`celestia-appd tx blob PayForBlobs 0102030405060708090a 68656c6c6f --from mykey
+celestia-appd tx blob PayForBlobs --%[1]s 0102030405060708090a:blob1.bin --%[1]s 0a090807060504030201:blob2.bin --from mykey
+celestia-appd tx blob PayForBlobs --%[2]s blobs.yaml --from mykey
+
+where blobs.yaml contains:
+
+blobs:
+  - namespace_id: 0102030405060708090a
+    blob: 68656c6c6f
+  - namespace_id: 0a090807060504030201
+    path: blob2.bin
+    share_version: 0`, FlagBlob, FlagInputFile),
+		Args: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 0 && len(args) != 2 {
+				return fmt.Errorf("accepts 0 or 2 arg(s), received %d", len(args))
 			}
+			return nil
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
 			namespaceVersion, err := cmd.Flags().GetUint8(FlagNamespaceVersion)
 			if err != nil {
 				return err
 			}
-			namespace, err := getNamespace(namespaceID, namespaceVersion)
+			shareVersion, err := cmd.Flags().GetUint8(FlagShareVersion)
 			if err != nil {
 				return err
 			}
 
-			shareVersion, _ := cmd.Flags().GetUint8(FlagShareVersion)
+			var blobs []*types.Blob
+			if len(args) == 2 {
+				rawblob, err := hex.DecodeString(args[1])
+				if err != nil {
+					return fmt.Errorf("failure to decode hex blob: %w", err)
+				}
+				blob, err := newBlob(args[0], rawblob, namespaceVersion, shareVersion)
+				if err != nil {
+					return err
+				}
+				blobs = append(blobs, blob)
+			}
 
-			rawblob, err := hex.DecodeString(args[1])
+			blobFlags, err := cmd.Flags().GetStringArray(FlagBlob)
 			if err != nil {
-				return fmt.Errorf("failure to decode hex blob: %w", err)
+				return err
+			}
+			for _, blobFlag := range blobFlags {
+				blob, err := parseBlobFlag(blobFlag, namespaceVersion, shareVersion)
+				if err != nil {
+					return err
+				}
+				blobs = append(blobs, blob)
 			}
 
-			// TODO: allow for more than one blob to be sumbmitted via the cli
-			blob, err := types.NewBlob(namespace, rawblob, shareVersion)
+			inputFile, err := cmd.Flags().GetString(FlagInputFile)
 			if err != nil {
 				return err
 			}
+			if inputFile != "" {
+				fileBlobs, err := parseInputFile(inputFile, namespaceVersion, shareVersion)
+				if err != nil {
+					return err
+				}
+				blobs = append(blobs, fileBlobs...)
+			}
 
-			return broadcastPFB(cmd, blob)
+			if len(blobs) == 0 {
+				return fmt.Errorf("no blobs provided: pass [hexNamespaceID] [hexBlob], --%s or --%s", FlagBlob, FlagInputFile)
+			}
+
+			return broadcastPFB(cmd, blobs...)
 		},
 	}
 
 	flags.AddTxFlagsToCmd(cmd)
 	cmd.PersistentFlags().Uint8(FlagNamespaceVersion, 0, "Specify the namespace version")
 	cmd.PersistentFlags().Uint8(FlagShareVersion, 0, "Specify the share version")
+	cmd.Flags().StringArray(FlagBlob, nil, "Add a blob read from a file formatted as [hexNamespaceID]:[path] (can be repeated)")
+	cmd.Flags().String(FlagInputFile, "", "Path to a JSON or YAML file listing the blobs to pay for")
 	return cmd
 }
 
+// inputFile is the format of the file passed via FlagInputFile.
+type inputFile struct {
+	Blobs []inputBlob `yaml:"blobs"`
+}
+
+// inputBlob is a blob of an inputFile. Exactly one of Blob and Path must be
+// set. The versions default to the values of the respective flags.
+type inputBlob struct {
+	NamespaceID string `yaml:"namespace_id"`
+	// Blob is the hex encoded blob.
+	Blob string `yaml:"blob"`
+	// Path is the path of a file containing the blob. Relative paths are
+	// resolved relative to the directory of the input file.
+	Path             string `yaml:"path"`
+	NamespaceVersion *uint8 `yaml:"namespace_version"`
+	ShareVersion     *uint8 `yaml:"share_version"`
+}
+
+// parseInputFile reads the blobs listed in the JSON or YAML file at path.
+func parseInputFile(path string, namespaceVersion, shareVersion uint8) ([]*types.Blob, error) {
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	// YAML is a superset of JSON so both are decoded by the YAML decoder
+	var input inputFile
+	if err := yaml.UnmarshalStrict(bz, &input); err != nil {
+		return nil, fmt.Errorf("failure to decode input file %s: %w", path, err)
+	}
+	if len(input.Blobs) == 0 {
+		return nil, fmt.Errorf("input file %s contains no blobs", path)
+	}
+
+	blobs := make([]*types.Blob, len(input.Blobs))
+	for i, b := range input.Blobs {
+		var rawblob []byte
+		switch {
+		case b.Blob != "" && b.Path != "":
+			return nil, fmt.Errorf("blob %d of input file %s: only one of blob and path can be set", i, path)
+		case b.Blob != "":
+			rawblob, err = hex.DecodeString(b.Blob)
+			if err != nil {
+				return nil, fmt.Errorf("blob %d of input file %s: failure to decode hex blob: %w", i, path, err)
+			}
+		case b.Path != "":
+			blobPath := b.Path
+			if !filepath.IsAbs(blobPath) {
+				blobPath = filepath.Join(filepath.Dir(path), blobPath)
+			}
+			rawblob, err = os.ReadFile(blobPath)
+			if err != nil {
+				return nil, fmt.Errorf("blob %d of input file %s: %w", i, path, err)
+			}
+		default:
+			return nil, fmt.Errorf("blob %d of input file %s: one of blob and path must be set", i, path)
+		}
+
+		nsVersion, sVersion := namespaceVersion, shareVersion
+		if b.NamespaceVersion != nil {
+			nsVersion = *b.NamespaceVersion
+		}
+		if b.ShareVersion != nil {
+			sVersion = *b.ShareVersion
+		}
+		blobs[i], err = newBlob(b.NamespaceID, rawblob, nsVersion, sVersion)
+		if err != nil {
+			return nil, fmt.Errorf("blob %d of input file %s: %w", i, path, err)
+		}
+	}
+	return blobs, nil
+}
+
+// parseBlobFlag reads the blob of a FlagBlob value formatted as
+// [hexNamespaceID]:[path].
+func parseBlobFlag(value string, namespaceVersion, shareVersion uint8) (*types.Blob, error) {
+	hexNamespaceID, path, ok := strings.Cut(value, ":")
+	if !ok || path == "" {
+		return nil, fmt.Errorf("invalid --%s %q: expected [hexNamespaceID]:[path]", FlagBlob, value)
+	}
+	rawblob, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	blob, err := newBlob(hexNamespaceID, rawblob, namespaceVersion, shareVersion)
+	if err != nil {
+		return nil, fmt.Errorf("invalid --%s %q: %w", FlagBlob, value, err)
+	}
+	return blob, nil
+}
+
+// newBlob creates a blob from a hex encoded namespace ID.
+func newBlob(hexNamespaceID string, rawblob []byte, namespaceVersion, shareVersion uint8) (*types.Blob, error) {
+	namespaceID, err := hex.DecodeString(hexNamespaceID)
+	if err != nil {
+		return nil, fmt.Errorf("failure to decode hex namespace ID: %w", err)
+	}
+	namespace, err := getNamespace(namespaceID, namespaceVersion)
+	if err != nil {
+		return nil, err
+	}
+	return types.NewBlob(namespace, rawblob, shareVersion)
+}
+
 func getNamespace(namespaceID []byte, namespaceVersion uint8) (appns.Namespace, error) {
 	switch namespaceVersion {
 	case appns.NamespaceVersionZero:
@@ -89,15 +252,13 @@ func getNamespace(namespaceID []byte, namespaceVersion uint8) (appns.Namespace,
 
 // broadcastPFB creates the new PFB message type that will later be broadcast to tendermint nodes
 // this private func is used in CmdPayForBlob and CmdTestRandBlob
-func broadcastPFB(cmd *cobra.Command, blob *types.Blob) error {
+func broadcastPFB(cmd *cobra.Command, blobs ...*types.Blob) error {
 	clientCtx, err := client.GetClientTxContext(cmd)
 	if err != nil {
 		return err
 	}
 
-	// TODO: allow the user to override the share version via a new flag
-	// See https://github.com/celestiaorg/celestia-app/issues/1041
-	pfbMsg, err := types.NewMsgPayForBlobs(clientCtx.FromAddress.String(), blob)
+	pfbMsg, err := types.NewMsgPayForBlobs(clientCtx.FromAddress.String(), blobs...)
 	if err != nil {
 		return err
 	}
@@ -107,12 +268,21 @@ func broadcastPFB(cmd *cobra.Command, blob *types.Blob) error {
 		return err
 	}
 
-	txBytes, err := writeTx(clientCtx, sdktx.NewFactoryCLI(clientCtx, cmd.Flags()), pfbMsg)
+	txf := sdktx.NewFactoryCLI(clientCtx, cmd.Flags())
+	// estimate the gas of the PFB via the blob module instead of simulating it
+	if txf.SimulateAndExecute() && !clientCtx.GenerateOnly {
+		txf, err = estimateGasForBlobs(cmd, clientCtx, txf, pfbMsg)
+		if err != nil {
+			return err
+		}
+	}
+
+	txBytes, err := writeTx(clientCtx, txf, pfbMsg)
 	if err != nil {
 		return err
 	}
 
-	blobTx, err := coretypes.MarshalBlobTx(txBytes, blob)
+	blobTx, err := coretypes.MarshalBlobTx(txBytes, blobs...)
 	if err != nil {
 		return err
 	}
@@ -126,6 +296,69 @@ func broadcastPFB(cmd *cobra.Command, blob *types.Blob) error {
 	return clientCtx.PrintProto(res)
 }
 
+// estimateGasForBlobs sets the gas limit of the factory to the gas estimated
+// by the blob module multiplied by the gas adjustment. Unless fees were
+// provided, the fee is set to the fee of the adjusted gas limit at the provided
+// gas price, or the default minimum gas price if none was provided, plus the
+// blob base fee of the blobs. Provided fees are used as is and must cover the
+// blob base fee themselves.
+//
+// The estimate accounts for a signature per key of the signer which is a
+// multisig or for a single signature otherwise.
+func estimateGasForBlobs(cmd *cobra.Command, clientCtx client.Context, txf sdktx.Factory, msg *types.MsgPayForBlobs) (sdktx.Factory, error) {
+	gasPrice := txf.GasPrices().AmountOf(appconsts.BondDenom)
+	if gasPrice.IsZero() {
+		gasPrice = sdk.MustNewDecFromStr(strconv.FormatFloat(appconsts.DefaultMinGasPrice, 'f', -1, 64))
+	}
+	req := &types.QueryEstimateGasForBlobsRequest{
+		BlobSizes:     msg.BlobSizes,
+		ShareVersions: msg.ShareVersions,
+		GasPrice:      gasPrice,
+		NumSignatures: signatureCount(clientCtx),
+	}
+	res, err := types.NewQueryClient(clientCtx).EstimateGasForBlobs(cmd.Context(), req)
+	if err != nil {
+		return txf, err
+	}
+
+	gas := uint64(txf.GasAdjustment() * float64(res.GasLimit))
+	txf = txf.WithGas(gas).WithSimulateAndExecute(false)
+	if txf.Fees().IsZero() {
+		// the estimated fee is for the unadjusted gas limit so the additional
+		// gas is paid for at the same gas price
+		fee := res.Fee
+		if gas > res.GasLimit {
+			fee = fee.AddAmount(gasPrice.MulInt64(int64(gas - res.GasLimit)).Ceil().TruncateInt())
+		}
+		// the fee replaces the gas prices as the SDK would derive the fee
+		// from them without the blob base fee
+		txf = txf.WithFees(fee.String()).WithGasPrices("")
+	}
+	_, _ = fmt.Fprintf(cmd.ErrOrStderr(), "%s\n", sdktx.GasEstimateResponse{GasEstimate: txf.Gas()})
+	return txf, nil
+}
+
+// signatureCount returns the number of signatures of a tx signed by the
+// signer of the client context. Signers missing from the keyring are assumed
+// to sign with a single key.
+func signatureCount(clientCtx client.Context) uint32 {
+	if clientCtx.Keyring == nil {
+		return 1
+	}
+	record, err := clientCtx.Keyring.KeyByAddress(clientCtx.GetFromAddress())
+	if err != nil {
+		return 1
+	}
+	pubKey, err := record.GetPubKey()
+	if err != nil {
+		return 1
+	}
+	if multisigPubKey, ok := pubKey.(multisig.PubKey); ok {
+		return uint32(len(multisigPubKey.GetPubKeys()))
+	}
+	return 1
+}
+
 // writeTx attempts to generate and sign a transaction using the normal
 // cosmos-sdk cli argument parsing code with the given set of messages. It will also simulate gas
 // requirements if necessary. It will return an error upon failure.
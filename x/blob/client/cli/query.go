@@ -20,6 +20,9 @@ func GetQueryCmd(_ string) *cobra.Command {
 	}
 
 	cmd.AddCommand(CmdQueryParams())
+	cmd.AddCommand(CmdQueryBlobBaseFee())
+	cmd.AddCommand(CmdQueryEstimateGas())
+	cmd.AddCommand(CmdQueryGet())
 
 	return cmd
 }
@@ -0,0 +1,73 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/celestiaorg/celestia-app/x/blob/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+)
+
+const (
+	// FlagGasPrice is the gas price in utia used to estimate the fee.
+	FlagGasPrice = "gas-price"
+
+	// FlagNumSignatures is the number of signatures of the transaction.
+	FlagNumSignatures = "num-signatures"
+)
+
+func CmdQueryEstimateGas() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "estimate-gas [blob-size]...",
+		Short: "estimates the gas limit and fee of a PFB paying for blobs of the provided sizes in bytes",
+		Args:  cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			blobSizes := make([]uint32, len(args))
+			for i, arg := range args {
+				size, err := strconv.ParseUint(arg, 10, 32)
+				if err != nil {
+					return fmt.Errorf("invalid blob size %q: %w", arg, err)
+				}
+				blobSizes[i] = uint32(size)
+			}
+			numSignatures, err := cmd.Flags().GetUint32(FlagNumSignatures)
+			if err != nil {
+				return err
+			}
+			req := &types.QueryEstimateGasForBlobsRequest{
+				BlobSizes:     blobSizes,
+				NumSignatures: numSignatures,
+			}
+			gasPrice, err := cmd.Flags().GetString(FlagGasPrice)
+			if err != nil {
+				return err
+			}
+			if gasPrice != "" {
+				req.GasPrice, err = sdk.NewDecFromStr(gasPrice)
+				if err != nil {
+					return fmt.Errorf("invalid gas price %q: %w", gasPrice, err)
+				}
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.EstimateGasForBlobs(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	cmd.Flags().String(FlagGasPrice, "", "gas price in utia used to estimate the fee (defaults to the default minimum gas price)")
+	cmd.Flags().Uint32(FlagNumSignatures, 1, "number of signatures of the transaction")
+
+	return cmd
+}
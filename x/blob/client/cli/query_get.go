@@ -0,0 +1,250 @@
+package cli
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	"github.com/celestiaorg/celestia-app/pkg/appconsts"
+	appns "github.com/celestiaorg/celestia-app/pkg/namespace"
+	"github.com/celestiaorg/celestia-app/pkg/shares"
+	"github.com/celestiaorg/celestia-app/pkg/square"
+	"github.com/celestiaorg/celestia-app/x/blob/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+	tmbytes "github.com/tendermint/tendermint/libs/bytes"
+)
+
+const (
+	// FlagCommitment filters the retrieved blobs by their hex encoded share
+	// commitment.
+	FlagCommitment = "commitment"
+
+	// FlagOutputDir is the directory that retrieved blobs are written to.
+	FlagOutputDir = "output-dir"
+)
+
+// RetrievedBlob is a blob that was retrieved from a block along with its
+// share commitment.
+type RetrievedBlob struct {
+	Namespace    tmbytes.HexBytes `json:"namespace"`
+	Commitment   tmbytes.HexBytes `json:"commitment"`
+	ShareVersion uint8            `json:"share_version"`
+	// Signer is only set for blobs of share version one.
+	Signer string `json:"signer,omitempty"`
+	Data   []byte `json:"data"`
+}
+
+func CmdQueryGet() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "get [height] [namespace]",
+		Short: "retrieves the blobs of a namespace that were published at a height",
+		Long: "Retrieves the blobs of a namespace that were published at a height. The block is " +
+			"fetched from the node, its data square is reconstructed and the blobs of the namespace " +
+			"are parsed from it. [namespace] is either the hex encoded 29 byte namespace or the hex " +
+			"encoded 10 byte namespace ID, in which case --namespace-version is used. The blobs are " +
+			"printed as JSON unless --output-dir is set, in which case each blob is written to a file " +
+			"named after its hex encoded share commitment.",
+		Example: fmt.Sprintf(`celestia-appd query blob get 1234 0102030405060708090a
+celestia-appd query blob get 1234 0102030405060708090a --%s <hex commitment> --%s ./blobs`, FlagCommitment, FlagOutputDir),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			height, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid height %q: %w", args[0], err)
+			}
+			if height <= 0 {
+				return fmt.Errorf("height %d must be strictly positive", height)
+			}
+			namespaceVersion, err := cmd.Flags().GetUint8(FlagNamespaceVersion)
+			if err != nil {
+				return err
+			}
+			namespace, err := parseNamespace(args[1], namespaceVersion)
+			if err != nil {
+				return err
+			}
+			commitmentHex, err := cmd.Flags().GetString(FlagCommitment)
+			if err != nil {
+				return err
+			}
+			commitment, err := hex.DecodeString(commitmentHex)
+			if err != nil {
+				return fmt.Errorf("failure to decode hex commitment: %w", err)
+			}
+			outputDir, err := cmd.Flags().GetString(FlagOutputDir)
+			if err != nil {
+				return err
+			}
+
+			node, err := clientCtx.GetNode()
+			if err != nil {
+				return err
+			}
+			res, err := node.Block(cmd.Context(), &height)
+			if err != nil {
+				return err
+			}
+
+			blobs, err := GetBlobs(res.Block.Data.Txs.ToSliceOfBytes(), res.Block.Header.Version.App, namespace)
+			if err != nil {
+				return err
+			}
+			if len(commitment) > 0 {
+				blobs = filterByCommitment(blobs, commitment)
+			}
+			if len(blobs) == 0 {
+				return fmt.Errorf("no blobs found for namespace %X at height %d", namespace.Bytes(), height)
+			}
+
+			if outputDir != "" {
+				return writeBlobs(cmd, outputDir, blobs)
+			}
+			bz, err := json.Marshal(blobs)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintRaw(bz)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	cmd.Flags().Uint8(FlagNamespaceVersion, 0, "Specify the namespace version if [namespace] is a namespace ID")
+	cmd.Flags().String(FlagCommitment, "", "Only retrieve the blob with this hex encoded share commitment")
+	cmd.Flags().String(FlagOutputDir, "", "Write each blob to a file in this directory instead of printing them")
+
+	return cmd
+}
+
+// GetBlobs reconstructs the data square of a block from its transactions and
+// returns the blobs of the namespace along with their share commitments. As
+// the application's state isn't available, the upper bound square size of
+// the app version is used instead of the square size dictated by governance.
+func GetBlobs(txs [][]byte, appVersion uint64, namespace appns.Namespace) ([]RetrievedBlob, error) {
+	dataSquare, err := square.Construct(txs, appVersion, appconsts.SquareSizeUpperBound(appVersion))
+	if err != nil {
+		return nil, fmt.Errorf("reconstructing data square: %w", err)
+	}
+
+	shareRange, err := shares.GetShareRangeForNamespace(dataSquare, namespace)
+	if err != nil {
+		return nil, err
+	}
+	nsShares := dataSquare[shareRange.Start:shareRange.End]
+	parsedBlobs, err := shares.ParseBlobs(nsShares)
+	if err != nil {
+		return nil, err
+	}
+	signers, err := blobSigners(nsShares)
+	if err != nil {
+		return nil, err
+	}
+	if len(signers) != len(parsedBlobs) {
+		return nil, fmt.Errorf("parsed %d blobs but found %d blob sequences", len(parsedBlobs), len(signers))
+	}
+
+	blobs := make([]RetrievedBlob, len(parsedBlobs))
+	for i, parsedBlob := range parsedBlobs {
+		blob := &types.Blob{
+			NamespaceId:      parsedBlob.NamespaceID,
+			Data:             parsedBlob.Data,
+			ShareVersion:     uint32(parsedBlob.ShareVersion),
+			NamespaceVersion: uint32(parsedBlob.NamespaceVersion),
+		}
+		commitment, err := types.CreateCommitmentWithSigner(blob, signers[i])
+		if err != nil {
+			return nil, err
+		}
+
+		blobs[i] = RetrievedBlob{
+			Namespace:    namespace.Bytes(),
+			Commitment:   commitment,
+			ShareVersion: parsedBlob.ShareVersion,
+			Data:         parsedBlob.Data,
+		}
+		if signers[i] != nil {
+			blobs[i].Signer = sdk.AccAddress(signers[i]).String()
+		}
+	}
+	return blobs, nil
+}
+
+// blobSigners returns the signer of every blob of the shares in the order in
+// which shares.ParseBlobs returns the blobs. The signer is nil for blobs whose
+// share version doesn't embed one.
+func blobSigners(nsShares []shares.Share) ([][]byte, error) {
+	var signers [][]byte
+	for _, share := range nsShares {
+		isStart, err := share.IsSequenceStart()
+		if err != nil {
+			return nil, err
+		}
+		isPadding, err := share.IsPadding()
+		if err != nil {
+			return nil, err
+		}
+		isCompact, err := share.IsCompactShare()
+		if err != nil {
+			return nil, err
+		}
+		if !isStart || isPadding || isCompact {
+			continue
+		}
+		signer, err := share.Signer()
+		if err != nil {
+			return nil, err
+		}
+		signers = append(signers, signer)
+	}
+	return signers, nil
+}
+
+func filterByCommitment(blobs []RetrievedBlob, commitment []byte) []RetrievedBlob {
+	var filtered []RetrievedBlob
+	for _, blob := range blobs {
+		if bytes.Equal(blob.Commitment, commitment) {
+			filtered = append(filtered, blob)
+		}
+	}
+	return filtered
+}
+
+// writeBlobs writes the data of each blob to a file in dir named after the
+// blob's hex encoded share commitment.
+func writeBlobs(cmd *cobra.Command, dir string, blobs []RetrievedBlob) error {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return err
+	}
+	for _, blob := range blobs {
+		path := filepath.Join(dir, hex.EncodeToString(blob.Commitment))
+		if err := os.WriteFile(path, blob.Data, 0o600); err != nil {
+			return err
+		}
+		fmt.Fprintln(cmd.OutOrStdout(), path)
+	}
+	return nil
+}
+
+// parseNamespace decodes either a full namespace or a namespace ID of the
+// provided version.
+func parseNamespace(hexNamespace string, namespaceVersion uint8) (appns.Namespace, error) {
+	bz, err := hex.DecodeString(hexNamespace)
+	if err != nil {
+		return appns.Namespace{}, fmt.Errorf("failure to decode hex namespace: %w", err)
+	}
+	if len(bz) == appns.NamespaceSize {
+		return appns.From(bz)
+	}
+	return getNamespace(bz, namespaceVersion)
+}
@@ -0,0 +1,69 @@
+package cli_test
+
+import (
+	"testing"
+
+	"github.com/celestiaorg/celestia-app/app"
+	"github.com/celestiaorg/celestia-app/app/encoding"
+	"github.com/celestiaorg/celestia-app/pkg/appconsts"
+	v2 "github.com/celestiaorg/celestia-app/pkg/appconsts/v2"
+	appns "github.com/celestiaorg/celestia-app/pkg/namespace"
+	"github.com/celestiaorg/celestia-app/test/util/testfactory"
+	"github.com/celestiaorg/celestia-app/x/blob/client/cli"
+	"github.com/celestiaorg/celestia-app/x/blob/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	tmrand "github.com/tendermint/tendermint/libs/rand"
+	coretypes "github.com/tendermint/tendermint/types"
+)
+
+func TestGetBlobs(t *testing.T) {
+	encCfg := encoding.MakeConfig(app.ModuleEncodingRegisters...)
+	signer := types.GenerateKeyringSigner(t, "test")
+	addr, err := signer.GetSignerInfo().GetAddress()
+	require.NoError(t, err)
+
+	namespace := appns.RandomBlobNamespace()
+	v1Blob, err := types.NewBlob(namespace, tmrand.Bytes(1000), appconsts.ShareVersionOne)
+	require.NoError(t, err)
+	v0Blob, err := types.NewBlob(namespace, tmrand.Bytes(600), appconsts.ShareVersionZero)
+	require.NoError(t, err)
+	msg, err := types.NewMsgPayForBlobs(addr.String(), v1Blob, v0Blob)
+	require.NoError(t, err)
+	stx, err := signer.BuildSignedTx(signer.NewTxBuilder(), msg)
+	require.NoError(t, err)
+	rawTx, err := encCfg.TxConfig.TxEncoder()(stx)
+	require.NoError(t, err)
+	blobTx, err := coretypes.MarshalBlobTx(rawTx, v1Blob, v0Blob)
+	require.NoError(t, err)
+	txs := append(testfactory.GenerateRandomTxs(10, 200).ToSliceOfBytes(), blobTx)
+
+	blobs, err := cli.GetBlobs(txs, v2.Version, namespace)
+	require.NoError(t, err)
+	require.Len(t, blobs, 2)
+
+	byCommitment := make(map[string]cli.RetrievedBlob)
+	for _, blob := range blobs {
+		assert.Equal(t, namespace.Bytes(), blob.Namespace.Bytes())
+		byCommitment[string(blob.Commitment)] = blob
+	}
+
+	// the signer embedded in the shares of the v1 blob is part of its share
+	// commitment
+	retrievedV1, ok := byCommitment[string(msg.ShareCommitments[0])]
+	require.True(t, ok)
+	assert.Equal(t, appconsts.ShareVersionOne, retrievedV1.ShareVersion)
+	assert.Equal(t, sdk.AccAddress(addr).String(), retrievedV1.Signer)
+	assert.Equal(t, v1Blob.Data, retrievedV1.Data)
+
+	retrievedV0, ok := byCommitment[string(msg.ShareCommitments[1])]
+	require.True(t, ok)
+	assert.Equal(t, appconsts.ShareVersionZero, retrievedV0.ShareVersion)
+	assert.Empty(t, retrievedV0.Signer)
+	assert.Equal(t, v0Blob.Data, retrievedV0.Data)
+
+	blobs, err = cli.GetBlobs(txs, v2.Version, appns.RandomBlobNamespace())
+	require.NoError(t, err)
+	assert.Empty(t, blobs)
+}
@@ -3,7 +3,10 @@ package testutil
 import (
 	"bytes"
 	"encoding/hex"
+	"encoding/json"
 	"fmt"
+	"os"
+	"path/filepath"
 	"strconv"
 	"testing"
 
@@ -18,12 +21,14 @@ import (
 
 	"github.com/celestiaorg/celestia-app/x/blob/types"
 
+	"github.com/celestiaorg/celestia-app/pkg/appconsts"
 	appns "github.com/celestiaorg/celestia-app/pkg/namespace"
 	"github.com/celestiaorg/celestia-app/test/util/network"
 	"github.com/celestiaorg/celestia-app/test/util/testfactory"
 	"github.com/celestiaorg/celestia-app/x/blob/client/cli"
 	paycli "github.com/celestiaorg/celestia-app/x/blob/client/cli"
 	abci "github.com/tendermint/tendermint/abci/types"
+	tmcli "github.com/tendermint/tendermint/libs/cli"
 )
 
 // username is used to create a funded genesis account under this name
@@ -89,6 +94,21 @@ func (s *IntegrationTestSuite) TestSubmitPayForBlob() {
 			expectedCode: 0,
 			respType:     &sdk.TxResponse{},
 		},
+		{
+			name: "valid transaction with estimated gas",
+			args: []string{
+				hexNamespace,
+				hexBlob,
+				fmt.Sprintf("--from=%s", username),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagGas, flags.GasFlagAuto),
+				fmt.Sprintf("--%s=%s", flags.FlagGasPrices, sdk.NewDecCoins(sdk.NewDecCoinFromDec(s.cfg.BondDenom, sdk.NewDecWithPrec(1, 1))).String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+			},
+			expectErr:    false,
+			expectedCode: 0,
+			respType:     &sdk.TxResponse{},
+		},
 		{
 			name: "unsupported share version",
 			args: []string{
@@ -98,7 +118,7 @@ func (s *IntegrationTestSuite) TestSubmitPayForBlob() {
 				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
 				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(2))).String()),
 				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
-				fmt.Sprintf("--%s=1", cli.FlagShareVersion),
+				fmt.Sprintf("--%s=2", cli.FlagShareVersion),
 			},
 			expectErr:    true,
 			expectedCode: 0,
@@ -149,7 +169,13 @@ func (s *IntegrationTestSuite) TestSubmitPayForBlob() {
 			}
 			require.NoError(err, "test: %s\noutput: %s", tc.name, out.String())
 
-			err = clientCtx.Codec.UnmarshalJSON(out.Bytes(), tc.respType)
+			// the gas estimate is written to stderr which shares the output
+			// buffer of the command ahead of the response
+			respBz := out.Bytes()
+			if estimate, resp, ok := bytes.Cut(respBz, []byte("\n")); ok && bytes.HasPrefix(estimate, []byte("gas estimate:")) {
+				respBz = resp
+			}
+			err = clientCtx.Codec.UnmarshalJSON(respBz, tc.respType)
 			require.NoError(err, out.String(), "test: %s, output\n:", tc.name, out.String())
 
 			txResp := tc.respType.(*sdk.TxResponse)
@@ -182,6 +208,200 @@ func (s *IntegrationTestSuite) TestSubmitPayForBlob() {
 	}
 }
 
+func (s *IntegrationTestSuite) TestSubmitPayForBlobsFromFiles() {
+	require := s.Require()
+	val := s.network.Validators[0]
+	dir := s.T().TempDir()
+
+	blobA := []byte("first blob")
+	blobB := bytes.Repeat([]byte{0xab}, 1000)
+	pathA := filepath.Join(dir, "a.bin")
+	pathB := filepath.Join(dir, "b.bin")
+	require.NoError(os.WriteFile(pathA, blobA, 0o600))
+	require.NoError(os.WriteFile(pathB, blobB, 0o600))
+
+	hexNamespaceA := hex.EncodeToString(appns.RandomBlobNamespaceID())
+	hexNamespaceB := hex.EncodeToString(appns.RandomBlobNamespaceID())
+	inputFile := filepath.Join(dir, "blobs.yaml")
+	input := fmt.Sprintf("blobs:\n  - namespace_id: %s\n    path: a.bin\n  - namespace_id: %s\n    blob: %s\n", hexNamespaceA, hexNamespaceB, hex.EncodeToString(blobB))
+	require.NoError(os.WriteFile(inputFile, []byte(input), 0o600))
+
+	txFlags := []string{
+		fmt.Sprintf("--from=%s", username),
+		fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+		fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(2))).String()),
+		fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+	}
+
+	testCases := []struct {
+		name          string
+		args          []string
+		expectErr     bool
+		wantBlobSizes []uint32
+	}{
+		{
+			name: "blob flags",
+			args: append([]string{
+				fmt.Sprintf("--%s=%s:%s", cli.FlagBlob, hexNamespaceA, pathA),
+				fmt.Sprintf("--%s=%s:%s", cli.FlagBlob, hexNamespaceB, pathB),
+			}, txFlags...),
+			wantBlobSizes: []uint32{uint32(len(blobA)), uint32(len(blobB))},
+		},
+		{
+			name:          "input file",
+			args:          append([]string{fmt.Sprintf("--%s=%s", cli.FlagInputFile, inputFile)}, txFlags...),
+			wantBlobSizes: []uint32{uint32(len(blobA)), uint32(len(blobB))},
+		},
+		{
+			name: "positional blob combined with a blob flag",
+			args: append([]string{
+				hexNamespaceA,
+				hex.EncodeToString(blobA),
+				fmt.Sprintf("--%s=%s:%s", cli.FlagBlob, hexNamespaceB, pathB),
+			}, txFlags...),
+			wantBlobSizes: []uint32{uint32(len(blobA)), uint32(len(blobB))},
+		},
+		{
+			name:      "no blobs",
+			args:      txFlags,
+			expectErr: true,
+		},
+		{
+			name:      "blob flag without path",
+			args:      append([]string{fmt.Sprintf("--%s=%s", cli.FlagBlob, hexNamespaceA)}, txFlags...),
+			expectErr: true,
+		},
+		{
+			name:      "blob file does not exist",
+			args:      append([]string{fmt.Sprintf("--%s=%s:%s", cli.FlagBlob, hexNamespaceA, filepath.Join(dir, "missing.bin"))}, txFlags...),
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		s.Require().NoError(s.network.WaitForNextBlock())
+		s.Run(tc.name, func() {
+			cmd := paycli.CmdPayForBlob()
+			clientCtx := val.ClientCtx
+
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
+			if tc.expectErr {
+				require.Error(err)
+				return
+			}
+			require.NoError(err, "test: %s\noutput: %s", tc.name, out.String())
+
+			var txResp sdk.TxResponse
+			require.NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), &txResp), out.String())
+			require.Equal(abci.CodeTypeOK, txResp.Code, out.String())
+
+			eventType := proto.MessageName(&types.EventPayForBlobs{})
+			var found bool
+			for _, e := range txResp.Logs[0].GetEvents() {
+				if e.Type != eventType {
+					continue
+				}
+				found = true
+				for _, attr := range e.GetAttributes() {
+					if attr.Key != "blob_sizes" {
+						continue
+					}
+					var blobSizes []uint32
+					require.NoError(json.Unmarshal([]byte(attr.Value), &blobSizes))
+					require.Equal(tc.wantBlobSizes, blobSizes)
+				}
+			}
+			require.True(found, "no %s event emitted", eventType)
+		})
+	}
+}
+
+func (s *IntegrationTestSuite) TestQueryGet() {
+	require := s.Require()
+	val := s.network.Validators[0]
+	clientCtx := val.ClientCtx
+
+	namespaceID := appns.RandomBlobNamespaceID()
+	blobData := []byte("blob to retrieve")
+	out, err := clitestutil.ExecTestCLICmd(clientCtx, paycli.CmdPayForBlob(), []string{
+		hex.EncodeToString(namespaceID),
+		hex.EncodeToString(blobData),
+		fmt.Sprintf("--from=%s", username),
+		fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+		fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(2))).String()),
+		fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+	})
+	require.NoError(err)
+	var txResp sdk.TxResponse
+	require.NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), &txResp), out.String())
+	require.Equal(abci.CodeTypeOK, txResp.Code, out.String())
+
+	namespace := appns.MustNewV0(namespaceID)
+	blob, err := types.NewBlob(namespace, blobData, appconsts.ShareVersionZero)
+	require.NoError(err)
+	commitment, err := types.CreateCommitment(blob)
+	require.NoError(err)
+	height := strconv.FormatInt(txResp.Height, 10)
+
+	testCases := []struct {
+		name      string
+		args      []string
+		expectErr bool
+	}{
+		{
+			name: "namespace ID",
+			args: []string{height, hex.EncodeToString(namespaceID)},
+		},
+		{
+			name: "namespace",
+			args: []string{height, hex.EncodeToString(namespace.Bytes())},
+		},
+		{
+			name: "matching commitment",
+			args: []string{height, hex.EncodeToString(namespaceID), fmt.Sprintf("--%s=%X", cli.FlagCommitment, commitment)},
+		},
+		{
+			name:      "other commitment",
+			args:      []string{height, hex.EncodeToString(namespaceID), fmt.Sprintf("--%s=%X", cli.FlagCommitment, bytes.Repeat([]byte{1}, 32))},
+			expectErr: true,
+		},
+		{
+			name:      "other height",
+			args:      []string{strconv.FormatInt(txResp.Height-1, 10), hex.EncodeToString(namespaceID)},
+			expectErr: true,
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		s.Run(tc.name, func() {
+			args := append(tc.args, fmt.Sprintf("--%s=json", tmcli.OutputFlag))
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, cli.CmdQueryGet(), args)
+			if tc.expectErr {
+				require.Error(err)
+				return
+			}
+			require.NoError(err)
+
+			var blobs []cli.RetrievedBlob
+			require.NoError(json.Unmarshal(out.Bytes(), &blobs), out.String())
+			require.Len(blobs, 1)
+			require.Equal(blobData, blobs[0].Data)
+			require.Equal(commitment, []byte(blobs[0].Commitment))
+			require.Equal(namespace.Bytes(), []byte(blobs[0].Namespace))
+		})
+	}
+
+	s.Run("output dir", func() {
+		dir := s.T().TempDir()
+		_, err := clitestutil.ExecTestCLICmd(clientCtx, cli.CmdQueryGet(), []string{height, hex.EncodeToString(namespaceID), fmt.Sprintf("--%s=%s", cli.FlagOutputDir, dir)})
+		require.NoError(err)
+		got, err := os.ReadFile(filepath.Join(dir, hex.EncodeToString(commitment)))
+		require.NoError(err)
+		require.Equal(blobData, got)
+	})
+}
+
 func TestIntegrationTestSuite(t *testing.T) {
 	suite.Run(t, NewIntegrationTestSuite(network.DefaultConfig()))
 }
@@ -7,14 +7,29 @@ import (
 )
 
 // InitGenesis initializes the capability module's state from a provided genesis
-// state.
+// state. The blob base fee is only stored if the app version enables it, so
+// that the state of earlier versions is unchanged.
 func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState) {
 	k.SetParams(ctx, genState.Params)
+	if !k.BlobBaseFeeEnabled(ctx) {
+		return
+	}
+	if !genState.BlobBaseFee.IsNil() && !genState.BlobBaseFee.IsZero() {
+		k.SetBlobBaseFee(ctx, genState.BlobBaseFee)
+	} else {
+		k.SetBlobBaseFee(ctx, types.MinBlobBaseFee)
+	}
 }
 
-// ExportGenesis returns the capability module's exported genesis.
+// ExportGenesis returns the capability module's exported genesis. The blob
+// base fee is left unset if the app version doesn't enable it, as it is
+// neither stored nor imported by those versions.
 func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	genesis := types.DefaultGenesis()
 	genesis.Params = k.GetParams(ctx)
+	genesis.BlobBaseFee = sdk.ZeroDec()
+	if k.BlobBaseFeeEnabled(ctx) {
+		genesis.BlobBaseFee = k.GetBlobBaseFee(ctx)
+	}
 	return genesis
 }
@@ -3,20 +3,41 @@ package blob_test
 import (
 	"testing"
 
+	v1 "github.com/celestiaorg/celestia-app/pkg/appconsts/v1"
+	v2 "github.com/celestiaorg/celestia-app/pkg/appconsts/v2"
 	keepertest "github.com/celestiaorg/celestia-app/test/util/keeper"
 	"github.com/celestiaorg/celestia-app/x/blob"
 	"github.com/celestiaorg/celestia-app/x/blob/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 )
 
 func TestGenesis(t *testing.T) {
 	genesisState := types.GenesisState{
-		Params: types.DefaultParams(),
+		Params:      types.DefaultParams(),
+		BlobBaseFee: sdk.NewDec(8),
 	}
 
-	k, ctx := keepertest.BlobKeeper(t)
+	k, ctx := keepertest.BlobKeeperWithAppVersion(t, v2.Version)
 	blob.InitGenesis(ctx, *k, genesisState)
 	got := blob.ExportGenesis(ctx, *k)
 	require.NotNil(t, got)
 	require.Equal(t, types.DefaultParams(), got.Params)
+	require.Equal(t, genesisState.BlobBaseFee, got.BlobBaseFee)
+}
+
+func TestGenesisBeforeBlobBaseFee(t *testing.T) {
+	genesisState := types.GenesisState{
+		Params:      types.DefaultParams(),
+		BlobBaseFee: sdk.NewDec(8),
+	}
+
+	// the blob base fee is neither stored nor exported before v2
+	k, ctx := keepertest.BlobKeeperWithAppVersion(t, v1.Version)
+	blob.InitGenesis(ctx, *k, genesisState)
+	got := blob.ExportGenesis(ctx, *k)
+	require.NotNil(t, got)
+	require.Equal(t, types.DefaultParams(), got.Params)
+	require.True(t, got.BlobBaseFee.IsZero())
+	require.NoError(t, got.Validate())
 }
@@ -0,0 +1,64 @@
+package keeper
+
+import (
+	"encoding/binary"
+
+	"github.com/celestiaorg/celestia-app/x/blob/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GetBlobBaseFee returns the fee in utia that is burned per share occupied by
+// blobs. MinBlobBaseFee is returned if the base fee has not been set yet.
+func (k Keeper) GetBlobBaseFee(ctx sdk.Context) sdk.Dec {
+	bz := ctx.KVStore(k.storeKey).Get(types.BlobBaseFeeKey)
+	if bz == nil {
+		return types.MinBlobBaseFee
+	}
+	var baseFee sdk.Dec
+	if err := baseFee.Unmarshal(bz); err != nil {
+		panic(err)
+	}
+	return baseFee
+}
+
+// SetBlobBaseFee sets the fee in utia that is burned per share occupied by
+// blobs.
+func (k Keeper) SetBlobBaseFee(ctx sdk.Context, baseFee sdk.Dec) {
+	bz, err := baseFee.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	ctx.KVStore(k.storeKey).Set(types.BlobBaseFeeKey, bz)
+}
+
+// UpdateBlobBaseFee adjusts the blob base fee of the next block based on the
+// number of blob shares paid for in the current block relative to the target
+// number of shares. It is expected to be called once at the end of each block.
+func (k Keeper) UpdateBlobBaseFee(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	sharesUsed := k.blobSharesUsed(ctx)
+	store.Delete(types.BlobSharesUsedKey)
+
+	target := types.TargetBlobShares(k.GovSquareSizeUpperBound(ctx))
+	k.SetBlobBaseFee(ctx, types.NextBlobBaseFee(k.GetBlobBaseFee(ctx), sharesUsed, target))
+}
+
+// blobSharesUsed returns the number of blob shares paid for in the current
+// block.
+func (k Keeper) blobSharesUsed(ctx sdk.Context) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(types.BlobSharesUsedKey)
+	if bz == nil {
+		return 0
+	}
+	return binary.BigEndian.Uint64(bz)
+}
+
+// addBlobSharesUsed adds to the number of blob shares paid for in the current
+// block. The bookkeeping is not charged to the transaction so that the gas
+// consumed by a MsgPayForBlobs only depends on its blobs.
+func (k Keeper) addBlobSharesUsed(ctx sdk.Context, sharesUsed uint64) {
+	ctx = ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, k.blobSharesUsed(ctx)+sharesUsed)
+	ctx.KVStore(k.storeKey).Set(types.BlobSharesUsedKey, bz)
+}
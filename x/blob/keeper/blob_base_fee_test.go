@@ -0,0 +1,48 @@
+package keeper_test
+
+import (
+	"testing"
+
+	v1 "github.com/celestiaorg/celestia-app/pkg/appconsts/v1"
+	v2 "github.com/celestiaorg/celestia-app/pkg/appconsts/v2"
+	testkeeper "github.com/celestiaorg/celestia-app/test/util/keeper"
+	"github.com/celestiaorg/celestia-app/x/blob/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUpdateBlobBaseFee(t *testing.T) {
+	k, ctx := testkeeper.BlobKeeperWithAppVersion(t, v2.Version)
+	wctx := sdk.WrapSDKContext(ctx)
+	require.Equal(t, types.MinBlobBaseFee, k.GetBlobBaseFee(ctx))
+
+	baseFee := sdk.NewDec(8)
+	k.SetBlobBaseFee(ctx, baseFee)
+
+	// paying for more shares than the target increases the base fee
+	target := types.TargetBlobShares(k.GovSquareSizeUpperBound(ctx))
+	msg := &types.MsgPayForBlobs{
+		BlobSizes:     []uint32{uint32(target * 2 * 478)},
+		ShareVersions: []uint32{0},
+	}
+	_, err := k.PayForBlobs(wctx, msg)
+	require.NoError(t, err)
+	k.UpdateBlobBaseFee(ctx)
+	increased := k.GetBlobBaseFee(ctx)
+	require.True(t, increased.GT(baseFee), increased.String())
+
+	// an empty block decreases the base fee
+	k.UpdateBlobBaseFee(ctx)
+	require.True(t, k.GetBlobBaseFee(ctx).LT(increased))
+
+	response, err := k.BlobBaseFee(wctx, &types.QueryBlobBaseFeeRequest{})
+	require.NoError(t, err)
+	require.Equal(t, k.GetBlobBaseFee(ctx), response.BlobBaseFee)
+}
+
+func TestBlobBaseFeeQueryDisabled(t *testing.T) {
+	k, ctx := testkeeper.BlobKeeperWithAppVersion(t, v1.Version)
+	response, err := k.BlobBaseFee(sdk.WrapSDKContext(ctx), &types.QueryBlobBaseFeeRequest{})
+	require.NoError(t, err)
+	require.True(t, response.BlobBaseFee.IsZero(), response.BlobBaseFee.String())
+}
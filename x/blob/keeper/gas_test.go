@@ -42,6 +42,7 @@ func keeper(t *testing.T) (*Keeper, store.CommitMultiStore) {
 		storeKey,
 		memStoreKey,
 		paramsSubspace,
+		nil,
 	)
 	k.SetParams(tempCtx, types.DefaultParams())
 
@@ -0,0 +1,23 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/celestiaorg/celestia-app/x/blob/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) BlobBaseFee(c context.Context, req *types.QueryBlobBaseFeeRequest) (*types.QueryBlobBaseFeeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	// no blob base fee is burned in blocks of app versions without it
+	if !k.BlobBaseFeeEnabled(ctx) {
+		return &types.QueryBlobBaseFeeResponse{BlobBaseFee: sdk.ZeroDec()}, nil
+	}
+	return &types.QueryBlobBaseFeeResponse{BlobBaseFee: k.GetBlobBaseFee(ctx)}, nil
+}
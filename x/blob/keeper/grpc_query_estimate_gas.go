@@ -0,0 +1,58 @@
+package keeper
+
+import (
+	"context"
+	"math"
+	"strconv"
+
+	"github.com/celestiaorg/celestia-app/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/x/blob/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"golang.org/x/exp/slices"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) EstimateGasForBlobs(c context.Context, req *types.QueryEstimateGasForBlobsRequest) (*types.QueryEstimateGasForBlobsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if len(req.BlobSizes) == 0 {
+		return nil, status.Error(codes.InvalidArgument, types.ErrNoBlobs.Error())
+	}
+	if len(req.ShareVersions) != 0 && len(req.ShareVersions) != len(req.BlobSizes) {
+		return nil, status.Errorf(codes.InvalidArgument, "got %d share versions for %d blobs", len(req.ShareVersions), len(req.BlobSizes))
+	}
+	for _, shareVersion := range req.ShareVersions {
+		if shareVersion > math.MaxUint8 || !slices.Contains(appconsts.SupportedShareVersions, uint8(shareVersion)) {
+			return nil, status.Error(codes.InvalidArgument, types.ErrUnsupportedShareVersion.Error())
+		}
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	numSignatures := int(req.NumSignatures)
+	if numSignatures == 0 {
+		numSignatures = 1
+	}
+	gasPrice := req.GasPrice
+	if gasPrice.IsNil() || gasPrice.IsZero() {
+		gasPrice = sdk.MustNewDecFromStr(strconv.FormatFloat(appconsts.DefaultMinGasPrice, 'f', -1, 64))
+	}
+	if gasPrice.IsNegative() {
+		return nil, status.Error(codes.InvalidArgument, "gas price cannot be negative")
+	}
+
+	authParams := k.accountKeeper.GetParams(ctx)
+	gasLimit := types.EstimateGas(req.BlobSizes, req.ShareVersions, numSignatures, k.GasPerBlobByte(ctx), authParams.TxSizeCostPerByte, authParams.SigVerifyCostSecp256k1)
+
+	fee := gasPrice.MulInt64(int64(gasLimit)).Ceil().TruncateInt()
+	if k.BlobBaseFeeEnabled(ctx) {
+		sharesUsed := types.BlobSharesUsed(req.BlobSizes, req.ShareVersions)
+		fee = fee.Add(types.BlobBaseFeeAmount(k.GetBlobBaseFee(ctx), uint64(sharesUsed)))
+	}
+
+	return &types.QueryEstimateGasForBlobsResponse{
+		GasLimit: gasLimit,
+		Fee:      sdk.NewCoin(appconsts.BondDenom, fee),
+	}, nil
+}
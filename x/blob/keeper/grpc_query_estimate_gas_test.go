@@ -0,0 +1,86 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/celestiaorg/celestia-app/pkg/appconsts"
+	v1 "github.com/celestiaorg/celestia-app/pkg/appconsts/v1"
+	v2 "github.com/celestiaorg/celestia-app/pkg/appconsts/v2"
+	testkeeper "github.com/celestiaorg/celestia-app/test/util/keeper"
+	"github.com/celestiaorg/celestia-app/x/blob/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEstimateGasForBlobsQuery(t *testing.T) {
+	k, ctx := testkeeper.BlobKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+
+	_, err := k.EstimateGasForBlobs(wctx, &types.QueryEstimateGasForBlobsRequest{})
+	require.Error(t, err)
+
+	blobSizes := []uint32{100, 10000}
+	res, err := k.EstimateGasForBlobs(wctx, &types.QueryEstimateGasForBlobsRequest{
+		BlobSizes: blobSizes,
+		GasPrice:  sdk.NewDec(1),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, types.DefaultEstimateGas(blobSizes, nil), res.GasLimit)
+
+	// more signatures consume more gas
+	multisig, err := k.EstimateGasForBlobs(wctx, &types.QueryEstimateGasForBlobsRequest{
+		BlobSizes:     blobSizes,
+		NumSignatures: 3,
+	})
+	require.NoError(t, err)
+	assert.Greater(t, multisig.GasLimit, res.GasLimit)
+
+	shareVersions := []uint32{0, 1}
+	shareVersionOne, err := k.EstimateGasForBlobs(wctx, &types.QueryEstimateGasForBlobsRequest{
+		BlobSizes:     blobSizes,
+		ShareVersions: shareVersions,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, types.DefaultEstimateGas(blobSizes, shareVersions), shareVersionOne.GasLimit)
+
+	_, err = k.EstimateGasForBlobs(wctx, &types.QueryEstimateGasForBlobsRequest{
+		BlobSizes:     blobSizes,
+		ShareVersions: []uint32{0},
+	})
+	require.Error(t, err)
+}
+
+func TestEstimateGasForBlobsQueryFee(t *testing.T) {
+	blobSizes := []uint32{100, 10000}
+	testCases := []struct {
+		name       string
+		appVersion uint64
+		// blobBaseFee is the blob base fee expected in the estimated fee
+		blobBaseFee int64
+	}{
+		{
+			name:        "the blob base fee isn't charged in v1",
+			appVersion:  v1.Version,
+			blobBaseFee: 0,
+		},
+		{
+			// 1 + 21 shares at the minimum blob base fee of 0.001utia per share
+			name:        "the blob base fee is charged from v2",
+			appVersion:  v2.Version,
+			blobBaseFee: 1,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			k, ctx := testkeeper.BlobKeeperWithAppVersion(t, tc.appVersion)
+			res, err := k.EstimateGasForBlobs(sdk.WrapSDKContext(ctx), &types.QueryEstimateGasForBlobsRequest{
+				BlobSizes: blobSizes,
+				GasPrice:  sdk.NewDec(1),
+			})
+			require.NoError(t, err)
+			expectedFee := sdk.NewCoin(appconsts.BondDenom, sdk.NewIntFromUint64(res.GasLimit).AddRaw(tc.blobBaseFee))
+			assert.Equal(t, expectedFee, res.Fee)
+		})
+	}
+}
@@ -5,7 +5,6 @@ import (
 	"fmt"
 
 	"github.com/celestiaorg/celestia-app/pkg/appconsts"
-	"github.com/celestiaorg/celestia-app/pkg/shares"
 	"github.com/celestiaorg/celestia-app/x/blob/types"
 	"github.com/cosmos/cosmos-sdk/codec"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
@@ -20,10 +19,11 @@ const (
 
 // Keeper handles all the state changes for the blob module.
 type Keeper struct {
-	cdc        codec.BinaryCodec
-	storeKey   storetypes.StoreKey
-	memKey     storetypes.StoreKey
-	paramStore paramtypes.Subspace
+	cdc           codec.BinaryCodec
+	storeKey      storetypes.StoreKey
+	memKey        storetypes.StoreKey
+	paramStore    paramtypes.Subspace
+	accountKeeper types.AccountKeeper
 }
 
 func NewKeeper(
@@ -31,16 +31,18 @@ func NewKeeper(
 	storeKey,
 	memKey storetypes.StoreKey,
 	ps paramtypes.Subspace,
+	accountKeeper types.AccountKeeper,
 ) *Keeper {
 	if !ps.HasKeyTable() {
 		ps = ps.WithKeyTable(types.ParamKeyTable())
 	}
 
 	return &Keeper{
-		cdc:        cdc,
-		storeKey:   storeKey,
-		memKey:     memKey,
-		paramStore: ps,
+		cdc:           cdc,
+		storeKey:      storeKey,
+		memKey:        memKey,
+		paramStore:    ps,
+		accountKeeper: accountKeeper,
 	}
 }
 
@@ -48,18 +50,25 @@ func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
 }
 
+// BlobBaseFeeEnabled returns whether the blob base fee is charged and adjusted
+// for the app version of the block that ctx belongs to.
+func (k Keeper) BlobBaseFeeEnabled(ctx sdk.Context) bool {
+	return appconsts.BlobBaseFeeEnabled(ctx.BlockHeader().Version.App)
+}
+
 // PayForBlobs consumes gas based on the blob sizes in the MsgPayForBlobs.
 func (k Keeper) PayForBlobs(goCtx context.Context, msg *types.MsgPayForBlobs) (*types.MsgPayForBlobsResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	totalSharesUsed := 0
-	for _, size := range msg.BlobSizes {
-		totalSharesUsed += shares.SparseSharesNeeded(size)
-	}
+	totalSharesUsed := msg.SharesUsed()
 
 	gasToConsume := uint32(totalSharesUsed*appconsts.ShareSize) * k.GasPerBlobByte(ctx)
 	ctx.GasMeter().ConsumeGas(uint64(gasToConsume), payForBlobGasDescriptor)
 
+	if k.BlobBaseFeeEnabled(ctx) {
+		k.addBlobSharesUsed(ctx, uint64(totalSharesUsed))
+	}
+
 	err := ctx.EventManager().EmitTypedEvent(
 		types.NewPayForBlobsEvent(sdk.AccAddress(msg.Signer).String(), msg.BlobSizes, msg.Namespaces),
 	)
@@ -158,8 +158,13 @@ func (AppModule) ConsensusVersion() uint64 { return 2 }
 // BeginBlock executes all ABCI BeginBlock logic respective to the capability module.
 func (am AppModule) BeginBlock(_ sdk.Context, _ abci.RequestBeginBlock) {}
 
-// EndBlock executes all ABCI EndBlock logic respective to the capability module. It
-// returns an empty list of validator updates.
-func (am AppModule) EndBlock(_ sdk.Context, _ abci.RequestEndBlock) []abci.ValidatorUpdate {
+// EndBlock adjusts the blob base fee of the next block based on the number of
+// blob shares paid for in this block if the blob base fee is enabled for the
+// app version of the block. It returns an empty list of validator
+// updates.
+func (am AppModule) EndBlock(ctx sdk.Context, _ abci.RequestEndBlock) []abci.ValidatorUpdate {
+	if am.keeper.BlobBaseFeeEnabled(ctx) {
+		am.keeper.UpdateBlobBaseFee(ctx)
+	}
 	return []abci.ValidatorUpdate{}
 }
@@ -13,7 +13,9 @@ import (
 )
 
 // SubmitPayForBlobs builds, signs, and synchronously submits a PayForBlob
-// transaction. It returns a sdk.TxResponse after submission.
+// transaction. It returns a sdk.TxResponse after submission. Use user.TxClient
+// to submit several transactions of the same account without waiting for each
+// of them to be committed.
 func SubmitPayForBlob(
 	ctx context.Context,
 	signer *types.KeyringSigner,
@@ -0,0 +1,277 @@
+package types
+
+import (
+	"bytes"
+	"time"
+
+	"cosmossdk.io/errors"
+	"github.com/celestiaorg/celestia-app/pkg/appconsts"
+	appns "github.com/celestiaorg/celestia-app/pkg/namespace"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
+	"github.com/gogo/protobuf/proto"
+)
+
+// URLMsgExec is the type URL of the authz MsgExec that a MsgPayForBlobs may be
+// wrapped in.
+var URLMsgExec = sdk.MsgTypeURL(&authz.MsgExec{})
+
+var _ authz.Authorization = &BlobAuthorization{}
+
+// NewBlobAuthorization creates a BlobAuthorization that allows the grantee to
+// pay for blobs in the provided namespaces. If maxBytesPerPeriod is not zero,
+// the grantee may pay for at most that many blob bytes per period or, if the
+// period is zero, in total. The authorization can't be used after the
+// expiration if it is set.
+func NewBlobAuthorization(namespaces []appns.Namespace, maxBytesPerPeriod uint64, period time.Duration, expiration *time.Time) *BlobAuthorization {
+	auth := &BlobAuthorization{
+		Namespaces:        make([][]byte, len(namespaces)),
+		MaxBytesPerPeriod: maxBytesPerPeriod,
+		Period:            period,
+		Expiration:        expiration,
+	}
+	for i, ns := range namespaces {
+		auth.Namespaces[i] = ns.Bytes()
+	}
+	return auth
+}
+
+// MsgTypeURL implements authz.Authorization.
+func (a BlobAuthorization) MsgTypeURL() string {
+	return URLMsgPayForBlobs
+}
+
+// Accept implements authz.Authorization. It accepts a MsgPayForBlobs if the
+// authorization hasn't expired, all of its namespaces are authorized and its
+// blobs fit in the bytes left for the current period.
+func (a BlobAuthorization) Accept(ctx sdk.Context, msg sdk.Msg) (authz.AcceptResponse, error) {
+	pfb, ok := msg.(*MsgPayForBlobs)
+	if !ok {
+		return authz.AcceptResponse{}, sdkerrors.ErrInvalidType.Wrapf("expected %T, got %T", &MsgPayForBlobs{}, msg)
+	}
+	blockTime := ctx.BlockTime()
+	if a.Expiration != nil && !blockTime.Before(*a.Expiration) {
+		return authz.AcceptResponse{}, authz.ErrAuthorizationExpired
+	}
+	for _, namespace := range pfb.Namespaces {
+		if !a.isAuthorized(namespace) {
+			return authz.AcceptResponse{}, ErrNamespaceNotAuthorized.Wrapf("%X", namespace)
+		}
+	}
+	if a.MaxBytesPerPeriod == 0 {
+		return authz.AcceptResponse{Accept: true}, nil
+	}
+
+	a.tryResetPeriod(blockTime)
+	size := blobBytes(pfb)
+	if size > a.MaxBytesPerPeriod-a.BytesUsed {
+		return authz.AcceptResponse{}, ErrBlobBytesLimitExceeded.Wrapf(
+			"%d blob bytes exceed the %d bytes left of %d", size, a.MaxBytesPerPeriod-a.BytesUsed, a.MaxBytesPerPeriod)
+	}
+	a.BytesUsed += size
+	return authz.AcceptResponse{Accept: true, Updated: &a}, nil
+}
+
+// tryResetPeriod starts a new period if the current one has ended. Like the
+// periodic fee allowance, a new period starts where the last one ended unless
+// more than a whole period has passed since then.
+func (a *BlobAuthorization) tryResetPeriod(blockTime time.Time) {
+	if a.Period == 0 || blockTime.Before(a.PeriodReset) {
+		return
+	}
+	a.BytesUsed = 0
+	a.PeriodReset = a.PeriodReset.Add(a.Period)
+	if blockTime.After(a.PeriodReset) {
+		a.PeriodReset = blockTime.Add(a.Period)
+	}
+}
+
+// ValidateBasic implements authz.Authorization.
+func (a BlobAuthorization) ValidateBasic() error {
+	if len(a.Namespaces) == 0 {
+		return ErrNoNamespaces
+	}
+	for _, namespace := range a.Namespaces {
+		ns, err := appns.From(namespace)
+		if err != nil {
+			return errors.Wrap(ErrInvalidNamespace, err.Error())
+		}
+		if err := ValidateBlobNamespaceID(ns); err != nil {
+			return err
+		}
+	}
+	if a.Period < 0 {
+		return sdkerrors.ErrInvalidRequest.Wrapf("negative period %s", a.Period)
+	}
+	if a.Period != 0 && a.MaxBytesPerPeriod == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("a period requires a maximum number of bytes per period")
+	}
+	if a.MaxBytesPerPeriod != 0 && a.BytesUsed > a.MaxBytesPerPeriod {
+		return sdkerrors.ErrInvalidRequest.Wrapf("%d bytes used exceed the maximum of %d", a.BytesUsed, a.MaxBytesPerPeriod)
+	}
+	return nil
+}
+
+func (a BlobAuthorization) isAuthorized(namespace []byte) bool {
+	for _, authorized := range a.Namespaces {
+		if bytes.Equal(authorized, namespace) {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	_ feegrant.FeeAllowanceI             = (*BlobAllowance)(nil)
+	_ codectypes.UnpackInterfacesMessage = (*BlobAllowance)(nil)
+)
+
+// NewBlobAllowance wraps allowance in a BlobAllowance that pays for at most
+// maxBytes blob bytes.
+func NewBlobAllowance(allowance feegrant.FeeAllowanceI, maxBytes uint64) (*BlobAllowance, error) {
+	a := &BlobAllowance{RemainingBytes: maxBytes}
+	if err := a.SetAllowance(allowance); err != nil {
+		return nil, err
+	}
+	return a, nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (a *BlobAllowance) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	var allowance feegrant.FeeAllowanceI
+	return unpacker.UnpackAny(a.Allowance, &allowance)
+}
+
+// GetAllowance returns the wrapped fee allowance.
+func (a *BlobAllowance) GetAllowance() (feegrant.FeeAllowanceI, error) {
+	allowance, ok := a.Allowance.GetCachedValue().(feegrant.FeeAllowanceI)
+	if !ok {
+		return nil, errors.Wrap(feegrant.ErrNoAllowance, "failed to get allowance")
+	}
+	return allowance, nil
+}
+
+// SetAllowance sets the wrapped fee allowance.
+func (a *BlobAllowance) SetAllowance(allowance feegrant.FeeAllowanceI) error {
+	msg, ok := allowance.(proto.Message)
+	if !ok {
+		return sdkerrors.ErrPackAny.Wrapf("cannot proto marshal %T", allowance)
+	}
+	any, err := codectypes.NewAnyWithValue(msg)
+	if err != nil {
+		return err
+	}
+	a.Allowance = any
+	return nil
+}
+
+// Accept implements feegrant.FeeAllowanceI. It only accepts transactions in
+// which every msg pays for blobs and whose blobs fit in the remaining bytes,
+// and only if the wrapped allowance accepts the fee.
+func (a *BlobAllowance) Accept(ctx sdk.Context, fee sdk.Coins, msgs []sdk.Msg) (bool, error) {
+	var size uint64
+	for _, msg := range msgs {
+		pfb, ok := PFBFromMsg(msg)
+		if !ok {
+			return false, errors.Wrapf(feegrant.ErrMessageNotAllowed, "%s does not pay for blobs", sdk.MsgTypeURL(msg))
+		}
+		size += blobBytes(pfb)
+	}
+	if size > a.RemainingBytes {
+		return false, ErrBlobBytesLimitExceeded.Wrapf("%d blob bytes exceed the %d bytes left", size, a.RemainingBytes)
+	}
+
+	allowance, err := a.GetAllowance()
+	if err != nil {
+		return false, err
+	}
+	remove, err := allowance.Accept(ctx, fee, msgs)
+	if err != nil || remove {
+		return remove, err
+	}
+	a.RemainingBytes -= size
+	if a.RemainingBytes == 0 {
+		return true, nil
+	}
+	return false, a.SetAllowance(allowance)
+}
+
+// ValidateBasic implements feegrant.FeeAllowanceI.
+func (a *BlobAllowance) ValidateBasic() error {
+	if a.Allowance == nil {
+		return errors.Wrap(feegrant.ErrNoAllowance, "allowance should not be empty")
+	}
+	if a.RemainingBytes == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("remaining bytes must be positive")
+	}
+	allowance, err := a.GetAllowance()
+	if err != nil {
+		return err
+	}
+	return allowance.ValidateBasic()
+}
+
+// ExpiresAt implements feegrant.FeeAllowanceI.
+func (a *BlobAllowance) ExpiresAt() (*time.Time, error) {
+	allowance, err := a.GetAllowance()
+	if err != nil {
+		return nil, err
+	}
+	return allowance.ExpiresAt()
+}
+
+// blobBytes returns the total size of the blobs paid for by pfb.
+func blobBytes(pfb *MsgPayForBlobs) uint64 {
+	var size uint64
+	for _, blobSize := range pfb.BlobSizes {
+		size += uint64(blobSize)
+	}
+	return size
+}
+
+// PFBFromMsg returns the MsgPayForBlobs that msg consists of. The
+// MsgPayForBlobs is either msg itself or the only msg executed by an authz
+// MsgExec, which allows a grantee to pay for blobs on behalf of the signer of
+// the MsgPayForBlobs.
+func PFBFromMsg(msg sdk.Msg) (*MsgPayForBlobs, bool) {
+	switch msg := msg.(type) {
+	case *MsgPayForBlobs:
+		return msg, true
+	case *authz.MsgExec:
+		msgs, err := msg.GetMessages()
+		if err != nil || len(msgs) != 1 {
+			return nil, false
+		}
+		pfb, ok := msgs[0].(*MsgPayForBlobs)
+		return pfb, ok
+	default:
+		return nil, false
+	}
+}
+
+// ContainsPFB returns true if any of msgs is or wraps a MsgPayForBlobs. Unlike
+// PFBFromMsg, a MsgExec is considered to contain a MsgPayForBlobs even if it
+// executes other msgs too. MsgExecs are only looked into for the app versions
+// that allow executing a MsgPayForBlobs through them.
+func ContainsPFB(msgs []sdk.Msg, appVersion uint64) bool {
+	for _, msg := range msgs {
+		if _, ok := msg.(*MsgPayForBlobs); ok {
+			return true
+		}
+		exec, ok := msg.(*authz.MsgExec)
+		if !ok || !appconsts.AuthzPayForBlobsEnabled(appVersion) {
+			continue
+		}
+		execMsgs, err := exec.GetMessages()
+		if err != nil {
+			continue
+		}
+		if ContainsPFB(execMsgs, appVersion) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,202 @@
+package types
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	v1 "github.com/celestiaorg/celestia-app/pkg/appconsts/v1"
+	v2 "github.com/celestiaorg/celestia-app/pkg/appconsts/v2"
+	appns "github.com/celestiaorg/celestia-app/pkg/namespace"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBlobAuthorizationValidateBasic(t *testing.T) {
+	ns := []appns.Namespace{appns.RandomBlobNamespace()}
+	type test struct {
+		name        string
+		auth        *BlobAuthorization
+		expectedErr error
+	}
+	tests := []test{
+		{"valid", NewBlobAuthorization(ns, 0, 0, nil), nil},
+		{"valid with limits", NewBlobAuthorization(ns, 1000, time.Hour, nil), nil},
+		{"no namespaces", NewBlobAuthorization(nil, 0, 0, nil), ErrNoNamespaces},
+		{"invalid namespace", &BlobAuthorization{Namespaces: [][]byte{{1, 2, 3}}}, ErrInvalidNamespace},
+		{"reserved namespace", NewBlobAuthorization([]appns.Namespace{appns.TxNamespace}, 0, 0, nil), ErrReservedNamespace},
+		{"negative period", NewBlobAuthorization(ns, 1000, -time.Hour, nil), sdkerrors.ErrInvalidRequest},
+		{"period without max bytes", NewBlobAuthorization(ns, 0, time.Hour, nil), sdkerrors.ErrInvalidRequest},
+		{"bytes used exceed max bytes", &BlobAuthorization{Namespaces: [][]byte{ns[0].Bytes()}, MaxBytesPerPeriod: 10, BytesUsed: 11}, sdkerrors.ErrInvalidRequest},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.auth.ValidateBasic()
+			if tt.expectedErr == nil {
+				require.NoError(t, err)
+				return
+			}
+			assert.ErrorIs(t, err, tt.expectedErr)
+		})
+	}
+}
+
+func TestBlobAuthorizationAccept(t *testing.T) {
+	authorized := appns.MustNewV0(bytes.Repeat([]byte{1}, appns.NamespaceVersionZeroIDSize))
+	unauthorized := appns.MustNewV0(bytes.Repeat([]byte{2}, appns.NamespaceVersionZeroIDSize))
+	ctx := sdk.Context{}.WithBlockTime(time.Unix(1000, 0))
+	pfb := func(sizes ...uint32) *MsgPayForBlobs {
+		msg := &MsgPayForBlobs{BlobSizes: sizes}
+		for range sizes {
+			msg.Namespaces = append(msg.Namespaces, authorized.Bytes())
+		}
+		return msg
+	}
+
+	auth := NewBlobAuthorization([]appns.Namespace{authorized}, 0, 0, nil)
+	assert.Equal(t, URLMsgPayForBlobs, auth.MsgTypeURL())
+	resp, err := auth.Accept(ctx, pfb(100, 200))
+	require.NoError(t, err)
+	assert.True(t, resp.Accept)
+	assert.False(t, resp.Delete)
+	assert.Nil(t, resp.Updated)
+
+	_, err = auth.Accept(ctx, &MsgPayForBlobs{Namespaces: [][]byte{authorized.Bytes(), unauthorized.Bytes()}, BlobSizes: []uint32{1, 1}})
+	assert.ErrorIs(t, err, ErrNamespaceNotAuthorized)
+	_, err = auth.Accept(ctx, &banktypes.MsgSend{})
+	assert.ErrorIs(t, err, sdkerrors.ErrInvalidType)
+
+	expiration := ctx.BlockTime()
+	_, err = NewBlobAuthorization([]appns.Namespace{authorized}, 0, 0, &expiration).Accept(ctx, pfb(1))
+	assert.ErrorIs(t, err, authz.ErrAuthorizationExpired)
+
+	// a total budget of 300 bytes
+	var limited authz.Authorization = NewBlobAuthorization([]appns.Namespace{authorized}, 300, 0, nil)
+	resp, err = limited.Accept(ctx, pfb(100, 100))
+	require.NoError(t, err)
+	require.True(t, resp.Accept)
+	limited = resp.Updated
+	assert.EqualValues(t, 200, limited.(*BlobAuthorization).BytesUsed)
+	_, err = limited.Accept(ctx, pfb(101))
+	assert.ErrorIs(t, err, ErrBlobBytesLimitExceeded)
+	resp, err = limited.Accept(ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour)), pfb(100))
+	require.NoError(t, err)
+	assert.EqualValues(t, 300, resp.Updated.(*BlobAuthorization).BytesUsed)
+
+	// a budget of 300 bytes per hour
+	var periodic authz.Authorization = NewBlobAuthorization([]appns.Namespace{authorized}, 300, time.Hour, nil)
+	resp, err = periodic.Accept(ctx, pfb(300))
+	require.NoError(t, err)
+	periodic = resp.Updated
+	assert.Equal(t, ctx.BlockTime().Add(time.Hour), periodic.(*BlobAuthorization).PeriodReset)
+	_, err = periodic.Accept(ctx.WithBlockTime(ctx.BlockTime().Add(time.Minute)), pfb(1))
+	assert.ErrorIs(t, err, ErrBlobBytesLimitExceeded)
+	resp, err = periodic.Accept(ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour)), pfb(10))
+	require.NoError(t, err)
+	assert.EqualValues(t, 10, resp.Updated.(*BlobAuthorization).BytesUsed)
+	assert.Equal(t, ctx.BlockTime().Add(2*time.Hour), resp.Updated.(*BlobAuthorization).PeriodReset)
+}
+
+func TestBlobAllowance(t *testing.T) {
+	grantee := sdk.AccAddress("grantee")
+	ctx := sdk.Context{}.WithBlockTime(time.Unix(1000, 0))
+	fee := sdk.NewCoins(sdk.NewInt64Coin(denom, 10))
+	pfb := &MsgPayForBlobs{Signer: sdk.AccAddress("granter").String(), BlobSizes: []uint32{100}}
+	exec := authz.NewMsgExec(grantee, []sdk.Msg{pfb})
+
+	newAllowance := func(spendLimit int64, maxBytes uint64) *BlobAllowance {
+		allowance, err := NewBlobAllowance(&feegrant.BasicAllowance{SpendLimit: sdk.NewCoins(sdk.NewInt64Coin(denom, spendLimit))}, maxBytes)
+		require.NoError(t, err)
+		require.NoError(t, allowance.ValidateBasic())
+		return allowance
+	}
+
+	allowance := newAllowance(100, 250)
+	remove, err := allowance.Accept(ctx, fee, []sdk.Msg{pfb})
+	require.NoError(t, err)
+	assert.False(t, remove)
+	remove, err = allowance.Accept(ctx, fee, []sdk.Msg{&exec})
+	require.NoError(t, err)
+	assert.False(t, remove)
+	assert.EqualValues(t, 50, allowance.RemainingBytes)
+	inner, err := allowance.GetAllowance()
+	require.NoError(t, err)
+	assert.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(denom, 80)), inner.(*feegrant.BasicAllowance).SpendLimit)
+
+	_, err = allowance.Accept(ctx, fee, []sdk.Msg{pfb})
+	assert.ErrorIs(t, err, ErrBlobBytesLimitExceeded)
+	_, err = allowance.Accept(ctx, fee, []sdk.Msg{&banktypes.MsgSend{}})
+	assert.ErrorIs(t, err, feegrant.ErrMessageNotAllowed)
+
+	// the allowance is removed once its bytes or the wrapped allowance are
+	// used up
+	remove, err = newAllowance(100, 100).Accept(ctx, fee, []sdk.Msg{pfb})
+	require.NoError(t, err)
+	assert.True(t, remove)
+	remove, err = newAllowance(10, 1000).Accept(ctx, fee, []sdk.Msg{pfb})
+	require.NoError(t, err)
+	assert.True(t, remove)
+
+	// a blob allowance requires a wrapped allowance and a byte budget
+	assert.Error(t, (&BlobAllowance{RemainingBytes: 1}).ValidateBasic())
+	invalid, err := NewBlobAllowance(&feegrant.BasicAllowance{}, 0)
+	require.NoError(t, err)
+	assert.Error(t, invalid.ValidateBasic())
+}
+
+func TestPFBFromMsg(t *testing.T) {
+	grantee := sdk.AccAddress("grantee")
+	pfb := &MsgPayForBlobs{Signer: sdk.AccAddress("granter").String()}
+	exec := func(msgs ...sdk.Msg) *authz.MsgExec {
+		msg := authz.NewMsgExec(grantee, msgs)
+		return &msg
+	}
+
+	type test struct {
+		name        string
+		msg         sdk.Msg
+		expectedPFB bool
+		containsPFB bool
+	}
+	tests := []test{
+		{name: "MsgPayForBlobs", msg: pfb, expectedPFB: true, containsPFB: true},
+		{name: "MsgExec with MsgPayForBlobs", msg: exec(pfb), expectedPFB: true, containsPFB: true},
+		{name: "MsgExec with multiple msgs", msg: exec(pfb, &banktypes.MsgSend{}), containsPFB: true},
+		{name: "nested MsgExec", msg: exec(exec(pfb)), containsPFB: true},
+		{name: "MsgExec without MsgPayForBlobs", msg: exec(&banktypes.MsgSend{})},
+		{name: "other msg", msg: &banktypes.MsgSend{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := PFBFromMsg(tt.msg)
+			assert.Equal(t, tt.expectedPFB, ok)
+			if tt.expectedPFB {
+				assert.Equal(t, pfb, got)
+			}
+			assert.Equal(t, tt.containsPFB, ContainsPFB([]sdk.Msg{tt.msg}, v2.Version))
+			// MsgExecs aren't looked into before v2
+			_, isPFB := tt.msg.(*MsgPayForBlobs)
+			assert.Equal(t, isPFB, ContainsPFB([]sdk.Msg{tt.msg}, v1.Version))
+		})
+	}
+}
+
+func TestPFBFromTxWithMsgExec(t *testing.T) {
+	_, addr, signer, _ := setupSigTest(t)
+	msg, _ := randMsgPayForBlobsWithNamespaceAndSigner(t, addr.String(), appns.RandomBlobNamespace(), 100)
+	exec := authz.NewMsgExec(sdk.AccAddress("grantee"), []sdk.Msg{msg})
+
+	stx, err := signer.BuildSignedTx(signer.NewTxBuilder(), &exec)
+	require.NoError(t, err)
+	rawTx, err := signer.EncodeTx(stx)
+	require.NoError(t, err)
+
+	got, err := PFBFromTx(rawTx)
+	require.NoError(t, err)
+	assert.Equal(t, msg, got)
+}
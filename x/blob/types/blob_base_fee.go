@@ -0,0 +1,50 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	// MinBlobBaseFee is the lowest fee in utia per share that the blob base
+	// fee can be lowered to.
+	MinBlobBaseFee = sdk.NewDecWithPrec(1, 3)
+
+	// TargetSquareFullness is the fraction of the shares of a square with a
+	// width of GovMaxSquareSize that blobs should occupy. The blob base fee
+	// increases when more shares are occupied and decreases when fewer are.
+	TargetSquareFullness = sdk.NewDecWithPrec(5, 1)
+)
+
+// BlobBaseFeeChangeDenominator bounds the change of the blob base fee between
+// two blocks. The fee changes by at most 1/BlobBaseFeeChangeDenominator, which
+// happens when the square is either empty or twice as full as the target.
+const BlobBaseFeeChangeDenominator = 8
+
+// TargetBlobShares returns the number of blob shares per block that the blob
+// base fee targets.
+func TargetBlobShares(govMaxSquareSize uint64) uint64 {
+	return TargetSquareFullness.MulInt64(int64(govMaxSquareSize * govMaxSquareSize)).TruncateInt().Uint64()
+}
+
+// NextBlobBaseFee returns the blob base fee of the next block given the base
+// fee of the current block and the number of blob shares paid for in it.
+func NextBlobBaseFee(baseFee sdk.Dec, blobShares, targetShares uint64) sdk.Dec {
+	if targetShares == 0 {
+		return baseFee
+	}
+	// baseFee * (1 + (blobShares - targetShares) / targetShares / denominator)
+	delta := sdk.NewDec(int64(blobShares) - int64(targetShares)).
+		QuoInt64(int64(targetShares)).
+		QuoInt64(BlobBaseFeeChangeDenominator)
+	next := baseFee.Add(baseFee.Mul(delta))
+	if next.LT(MinBlobBaseFee) {
+		return MinBlobBaseFee
+	}
+	return next
+}
+
+// BlobBaseFeeAmount returns the fee in utia, rounded up, that is burned for
+// the provided number of blob shares.
+func BlobBaseFeeAmount(baseFee sdk.Dec, blobShares uint64) sdk.Int {
+	return baseFee.MulInt64(int64(blobShares)).Ceil().TruncateInt()
+}
@@ -0,0 +1,80 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/celestiaorg/celestia-app/x/blob/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNextBlobBaseFee(t *testing.T) {
+	baseFee := sdk.NewDec(8)
+	type test struct {
+		name       string
+		baseFee    sdk.Dec
+		blobShares uint64
+		target     uint64
+		expected   sdk.Dec
+	}
+	tests := []test{
+		{
+			name:       "at target",
+			baseFee:    baseFee,
+			blobShares: 100,
+			target:     100,
+			expected:   baseFee,
+		},
+		{
+			name:       "twice the target",
+			baseFee:    baseFee,
+			blobShares: 200,
+			target:     100,
+			expected:   sdk.NewDec(9),
+		},
+		{
+			name:       "half the target",
+			baseFee:    baseFee,
+			blobShares: 50,
+			target:     100,
+			expected:   sdk.MustNewDecFromStr("7.5"),
+		},
+		{
+			name:       "empty square",
+			baseFee:    baseFee,
+			blobShares: 0,
+			target:     100,
+			expected:   sdk.NewDec(7),
+		},
+		{
+			name:       "bounded by the minimum",
+			baseFee:    types.MinBlobBaseFee,
+			blobShares: 0,
+			target:     100,
+			expected:   types.MinBlobBaseFee,
+		},
+		{
+			name:       "no target",
+			baseFee:    baseFee,
+			blobShares: 100,
+			target:     0,
+			expected:   baseFee,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := types.NextBlobBaseFee(tt.baseFee, tt.blobShares, tt.target)
+			assert.True(t, tt.expected.Equal(got), "expected %s, got %s", tt.expected, got)
+		})
+	}
+}
+
+func TestBlobBaseFeeAmount(t *testing.T) {
+	assert.Equal(t, sdk.NewInt(1), types.BlobBaseFeeAmount(types.MinBlobBaseFee, 1))
+	assert.Equal(t, sdk.NewInt(2), types.BlobBaseFeeAmount(types.MinBlobBaseFee, 1001))
+	assert.Equal(t, sdk.NewInt(24), types.BlobBaseFeeAmount(sdk.NewDec(8), 3))
+}
+
+func TestTargetBlobShares(t *testing.T) {
+	assert.Equal(t, uint64(32), types.TargetBlobShares(8))
+}
@@ -5,11 +5,16 @@ import (
 	"fmt"
 	math "math"
 
+	"github.com/celestiaorg/celestia-app/pkg/appconsts"
 	appns "github.com/celestiaorg/celestia-app/pkg/namespace"
 	shares "github.com/celestiaorg/celestia-app/pkg/shares"
 	"github.com/cosmos/cosmos-sdk/client"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdktx "github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/cosmos/cosmos-sdk/x/authz"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 	core "github.com/tendermint/tendermint/types"
+	"golang.org/x/exp/slices"
 )
 
 // Blob wraps the tendermint type so that users can simply import this one.
@@ -27,6 +32,10 @@ func NewBlob(ns appns.Namespace, blob []byte, shareVersion uint8) (*Blob, error)
 		return nil, ErrZeroBlobSize
 	}
 
+	if !slices.Contains(appconsts.SupportedShareVersions, shareVersion) {
+		return nil, ErrUnsupportedShareVersion
+	}
+
 	return &tmproto.Blob{
 		NamespaceId:      ns.ID,
 		Data:             blob,
@@ -36,8 +45,9 @@ func NewBlob(ns appns.Namespace, blob []byte, shareVersion uint8) (*Blob, error)
 }
 
 // ValidateBlobTx performs stateless checks on the BlobTx to ensure that the
-// blobs attached to the transaction are valid.
-func ValidateBlobTx(txcfg client.TxEncodingConfig, bTx tmproto.BlobTx) error {
+// blobs attached to the transaction are valid. Blobs must use a share version
+// that is enabled for the provided app version.
+func ValidateBlobTx(txcfg client.TxEncodingConfig, bTx tmproto.BlobTx, appVersion uint64) error {
 	sdkTx, err := txcfg.TxDecoder()(bTx.Tx)
 	if err != nil {
 		return err
@@ -50,10 +60,15 @@ func ValidateBlobTx(txcfg client.TxEncodingConfig, bTx tmproto.BlobTx) error {
 		return ErrMultipleMsgsInBlobTx
 	}
 	msg := msgs[0]
-	msgPFB, ok := msg.(*MsgPayForBlobs)
+	msgPFB, ok := PFBFromMsg(msg)
 	if !ok {
 		return ErrNoPFB
 	}
+	// a MsgPayForBlobs executed through an authz MsgExec is only valid from
+	// the app version that supports it
+	if _, isExec := msg.(*authz.MsgExec); isExec && !appconsts.AuthzPayForBlobsEnabled(appVersion) {
+		return ErrNoPFB
+	}
 	err = msgPFB.ValidateBasic()
 	if err != nil {
 		return err
@@ -69,6 +84,13 @@ func ValidateBlobTx(txcfg client.TxEncodingConfig, bTx tmproto.BlobTx) error {
 		return err
 	}
 
+	shareVersions := appconsts.ShareVersions(appVersion)
+	for _, blob := range bTx.Blobs {
+		if !slices.Contains(shareVersions, uint8(blob.ShareVersion)) {
+			return ErrUnsupportedShareVersion.Wrapf("share version %d is not supported in app version %d", blob.ShareVersion, appVersion)
+		}
+	}
+
 	// check that the sizes in the blobTx match the sizes in the msgPFB
 	if !equalSlices(sizes, msgPFB.BlobSizes) {
 		return ErrBlobSizeMismatch.Wrapf("actual %v declared %v", sizes, msgPFB.BlobSizes)
@@ -90,9 +112,15 @@ func ValidateBlobTx(txcfg client.TxEncodingConfig, bTx tmproto.BlobTx) error {
 		}
 	}
 
-	// verify that the commitment of the blob matches that of the msgPFB
+	// verify that the commitment of the blob matches that of the msgPFB. Blobs
+	// of share version one commit to the signer of the msgPFB so a mismatching
+	// signer results in an invalid share commitment.
+	signer, err := blobSigner(msgPFB.Signer, bTx.Blobs)
+	if err != nil {
+		return err
+	}
 	for i, commitment := range msgPFB.ShareCommitments {
-		calculatedCommit, err := CreateCommitment(bTx.Blobs[i])
+		calculatedCommit, err := CreateCommitmentWithSigner(bTx.Blobs[i], signer)
 		if err != nil {
 			return ErrCalculateCommitment
 		}
@@ -104,10 +132,61 @@ func ValidateBlobTx(txcfg client.TxEncodingConfig, bTx tmproto.BlobTx) error {
 	return nil
 }
 
+// PFBSignerFromTx returns the address bytes of the signer of the
+// MsgPayForBlobs contained in the provided raw sdk.Tx. The tx is decoded
+// without an interface registry so that it can be used wherever a
+// TxEncodingConfig is not available.
+func PFBSignerFromTx(rawTx []byte) ([]byte, error) {
+	msgPFB, err := PFBFromTx(rawTx)
+	if err != nil {
+		return nil, err
+	}
+	return SignerBytes(msgPFB.Signer)
+}
+
+// PFBFromTx returns the first MsgPayForBlobs contained in the provided raw
+// sdk.Tx, including one wrapped in an authz MsgExec. Like PFBSignerFromTx, it
+// doesn't require an interface registry.
+func PFBFromTx(rawTx []byte) (*MsgPayForBlobs, error) {
+	var tx sdktx.Tx
+	if err := tx.Unmarshal(rawTx); err != nil {
+		return nil, err
+	}
+	if tx.Body == nil {
+		return nil, ErrNoPFB
+	}
+	return pfbFromAnys(tx.Body.Messages)
+}
+
+func pfbFromAnys(msgs []*codectypes.Any) (*MsgPayForBlobs, error) {
+	for _, msg := range msgs {
+		if msg == nil {
+			continue
+		}
+		switch msg.TypeUrl {
+		case URLMsgPayForBlobs:
+			var msgPFB MsgPayForBlobs
+			if err := msgPFB.Unmarshal(msg.Value); err != nil {
+				return nil, err
+			}
+			return &msgPFB, nil
+		case URLMsgExec:
+			var exec authz.MsgExec
+			if err := exec.Unmarshal(msg.Value); err != nil {
+				return nil, err
+			}
+			if msgPFB, err := pfbFromAnys(exec.Msgs); err == nil {
+				return msgPFB, nil
+			}
+		}
+	}
+	return nil, ErrNoPFB
+}
+
 func BlobTxSharesUsed(btx tmproto.BlobTx) int {
 	sharesUsed := 0
 	for _, blob := range btx.Blobs {
-		sharesUsed += shares.SparseSharesNeeded(uint32(len(blob.Data)))
+		sharesUsed += shares.SparseSharesNeededForVersion(uint32(len(blob.Data)), uint8(blob.ShareVersion))
 	}
 	return sharesUsed
 }
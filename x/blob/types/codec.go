@@ -7,12 +7,16 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/msgservice"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
 )
 
 var ModuleCdc = codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
 
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgPayForBlobs{}, URLMsgPayForBlobs, nil)
+	cdc.RegisterConcrete(&BlobAuthorization{}, "celestia/blob/BlobAuthorization", nil)
+	cdc.RegisterConcrete(&BlobAllowance{}, "celestia/blob/BlobAllowance", nil)
 }
 
 func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
@@ -20,6 +24,14 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		&MsgPayForBlobs{},
 	)
 
+	registry.RegisterImplementations((*authz.Authorization)(nil),
+		&BlobAuthorization{},
+	)
+
+	registry.RegisterImplementations((*feegrant.FeeAllowanceI)(nil),
+		&BlobAllowance{},
+	)
+
 	registry.RegisterInterface(
 		"cosmos.auth.v1beta1.BaseAccount",
 		(*authtypes.AccountI)(nil),
@@ -33,4 +33,6 @@ var (
 	ErrNoBlobSizes                    = errors.Register(ModuleName, 11134, "no blob sizes provided")
 	ErrNoShareCommitments             = errors.Register(ModuleName, 11135, "no share commitments provided")
 	ErrInvalidNamespace               = errors.Register(ModuleName, 11136, "invalid namespace")
+	ErrNamespaceNotAuthorized         = errors.Register(ModuleName, 11137, "namespace not authorized")
+	ErrBlobBytesLimitExceeded         = errors.Register(ModuleName, 11138, "blob bytes limit exceeded")
 )
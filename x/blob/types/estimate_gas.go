@@ -0,0 +1,110 @@
+package types
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+
+	"github.com/celestiaorg/celestia-app/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/pkg/shares"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+const (
+	// PFBGasFixedCost is an estimate of the gas consumed by a transaction
+	// containing a MsgPayForBlobs that depends on neither its blobs nor its
+	// signatures, such as reading and writing the signer's account and
+	// deducting the fee. It covers the first transaction of an account,
+	// which also stores its public key. It is pinned against delivered PFBs
+	// by TestEstimateGasConstants in app/test.
+	PFBGasFixedCost = 75000
+
+	// PFBGasPerBlob is an estimate of the gas consumed per blob of a
+	// MsgPayForBlobs that doesn't depend on the size of the blob, such as
+	// checking that the signer may use the namespace of the blob. It is
+	// pinned like PFBGasFixedCost.
+	PFBGasPerBlob = 2500
+
+	// PFBTxFixedSize is an estimate of the size in bytes of a transaction
+	// containing a MsgPayForBlobs without any blobs or signatures, i.e. its
+	// message type, signer, fee and gas limit. It is pinned like
+	// PFBGasFixedCost.
+	PFBTxFixedSize = 250
+
+	// BytesPerBlobInfo is the maximum number of bytes that a delivered
+	// MsgPayForBlobs grows by per blob: the namespace and the share
+	// commitment of the blob, each a length prefixed field, and its size,
+	// share version and share index, each a packed varint. The share index is
+	// added by the index wrapper that PFBs are delivered in.
+	BytesPerBlobInfo = (protoFieldOverhead + appconsts.NamespaceSize) +
+		(protoFieldOverhead + sha256.Size) +
+		binary.MaxVarintLen32 + 1 + binary.MaxVarintLen32
+
+	// BytesPerSignature is the maximum number of bytes that a transaction
+	// grows by per secp256k1 signature: the signer info, which holds the
+	// public key as an Any, the sign mode and the sequence, and the
+	// signature itself.
+	BytesPerSignature = (protoFieldOverhead + signerInfoSize) +
+		(protoFieldOverhead + secp256k1SignatureSize)
+
+	// protoFieldOverhead is the number of bytes taken by the tag and the
+	// length prefix of a length prefixed protobuf field shorter than 128
+	// bytes.
+	protoFieldOverhead = 2
+
+	// signerInfoSize is the maximum size of the signer info of a secp256k1
+	// key signing in direct mode.
+	signerInfoSize = (protoFieldOverhead + pubKeyAnySize) +
+		(protoFieldOverhead + protoFieldOverhead + 2) +
+		(1 + binary.MaxVarintLen64)
+
+	// pubKeyAnySize is the size of a secp256k1 public key packed in an Any.
+	pubKeyAnySize = (protoFieldOverhead + len(secp256k1PubKeyTypeURL)) +
+		(protoFieldOverhead + protoFieldOverhead + secp256k1.PubKeySize)
+
+	secp256k1PubKeyTypeURL = "/cosmos.crypto.secp256k1.PubKey"
+
+	// secp256k1SignatureSize is the size of a secp256k1 signature in the
+	// r || s format used by the SDK.
+	secp256k1SignatureSize = 64
+)
+
+// EstimateGas estimates the gas consumed by a transaction containing a
+// MsgPayForBlobs that pays for blobs of the provided sizes and share versions
+// and that is signed by numSignatures secp256k1 keys. Blobs without a share
+// version are assumed to use share version zero.
+func EstimateGas(blobSizes, shareVersions []uint32, numSignatures int, gasPerBlobByte uint32, txSizeCostPerByte, sigVerifyCost uint64) uint64 {
+	sharesUsed := BlobSharesUsed(blobSizes, shareVersions)
+	blobGas := uint64(sharesUsed*appconsts.ShareSize) * uint64(gasPerBlobByte)
+
+	txSize := uint64(PFBTxFixedSize + len(blobSizes)*BytesPerBlobInfo + numSignatures*BytesPerSignature)
+	txSizeGas := txSize * txSizeCostPerByte
+
+	sigGas := uint64(numSignatures) * sigVerifyCost
+
+	perBlobGas := uint64(len(blobSizes)) * PFBGasPerBlob
+
+	return PFBGasFixedCost + perBlobGas + blobGas + txSizeGas + sigGas
+}
+
+// DefaultEstimateGas estimates the gas consumed by a transaction containing a
+// MsgPayForBlobs signed by a single key using the default parameters of the
+// blob and auth modules.
+func DefaultEstimateGas(blobSizes, shareVersions []uint32) uint64 {
+	return EstimateGas(blobSizes, shareVersions, 1, DefaultGasPerBlobByte, authtypes.DefaultTxSizeCostPerByte, authtypes.DefaultSigVerifyCostSecp256k1)
+}
+
+// BlobSharesUsed returns the number of shares occupied by blobs of the provided
+// sizes and share versions. Blobs without a share version are assumed to use
+// share version zero.
+func BlobSharesUsed(blobSizes, shareVersions []uint32) int {
+	sharesUsed := 0
+	for i, size := range blobSizes {
+		shareVersion := appconsts.ShareVersionZero
+		if i < len(shareVersions) {
+			shareVersion = uint8(shareVersions[i])
+		}
+		sharesUsed += shares.SparseSharesNeededForVersion(size, shareVersion)
+	}
+	return sharesUsed
+}
@@ -0,0 +1,25 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/celestiaorg/celestia-app/x/blob/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEstimateGas(t *testing.T) {
+	// a single share blob signed by a single key
+	expected := uint64(types.PFBGasFixedCost + types.PFBGasPerBlob + 512*8 + (types.PFBTxFixedSize+types.BytesPerBlobInfo+types.BytesPerSignature)*10 + 1000)
+	assert.Equal(t, expected, types.EstimateGas([]uint32{100}, nil, 1, 8, 10, 1000))
+	assert.Equal(t, expected, types.DefaultEstimateGas([]uint32{100}, nil))
+
+	// blob gas is charged per share so blobs that occupy the same number of
+	// shares consume the same amount of gas
+	assert.Equal(t, types.DefaultEstimateGas([]uint32{1}, nil), types.DefaultEstimateGas([]uint32{478}, nil))
+	assert.Less(t, types.DefaultEstimateGas([]uint32{478}, nil), types.DefaultEstimateGas([]uint32{479}, nil))
+	assert.Less(t, types.DefaultEstimateGas([]uint32{100}, nil), types.DefaultEstimateGas([]uint32{100, 100}, nil))
+
+	// blobs of share version one embed the signer so they may occupy an
+	// additional share
+	assert.Less(t, types.DefaultEstimateGas([]uint32{478}, []uint32{0}), types.DefaultEstimateGas([]uint32{478}, []uint32{1}))
+}
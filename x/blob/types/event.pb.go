@@ -87,28 +87,147 @@ func (m *EventPayForBlobs) GetNamespaces() [][]byte {
 	return nil
 }
 
+// EventBlobPlacement defines an event that is emitted at the end of a block for
+// each blob of a pay for blob that was processed successfully. It describes
+// where the blob was placed in the data square of the block.
+type EventBlobPlacement struct {
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	// namespace is the namespace of the blob.
+	Namespace []byte `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// blob_index is the index of the blob in the pay for blob.
+	BlobIndex       uint32 `protobuf:"varint,3,opt,name=blob_index,json=blobIndex,proto3" json:"blob_index,omitempty"`
+	BlobSize        uint32 `protobuf:"varint,4,opt,name=blob_size,json=blobSize,proto3" json:"blob_size,omitempty"`
+	ShareCommitment []byte `protobuf:"bytes,5,opt,name=share_commitment,json=shareCommitment,proto3" json:"share_commitment,omitempty"`
+	// start_share_index is the index of the first share of the blob in the data
+	// square of the block.
+	StartShareIndex uint32 `protobuf:"varint,6,opt,name=start_share_index,json=startShareIndex,proto3" json:"start_share_index,omitempty"`
+	// share_length is the number of shares that the blob occupies.
+	ShareLength uint32 `protobuf:"varint,7,opt,name=share_length,json=shareLength,proto3" json:"share_length,omitempty"`
+	// tx_hash is the hex encoded hash of the transaction of the pay for blob.
+	TxHash string `protobuf:"bytes,8,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+}
+
+func (m *EventBlobPlacement) Reset()         { *m = EventBlobPlacement{} }
+func (m *EventBlobPlacement) String() string { return proto.CompactTextString(m) }
+func (*EventBlobPlacement) ProtoMessage()    {}
+func (*EventBlobPlacement) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9d90f0a63835a06e, []int{1}
+}
+func (m *EventBlobPlacement) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventBlobPlacement) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventBlobPlacement.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventBlobPlacement) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventBlobPlacement.Merge(m, src)
+}
+func (m *EventBlobPlacement) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventBlobPlacement) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventBlobPlacement.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventBlobPlacement proto.InternalMessageInfo
+
+func (m *EventBlobPlacement) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
+}
+
+func (m *EventBlobPlacement) GetNamespace() []byte {
+	if m != nil {
+		return m.Namespace
+	}
+	return nil
+}
+
+func (m *EventBlobPlacement) GetBlobIndex() uint32 {
+	if m != nil {
+		return m.BlobIndex
+	}
+	return 0
+}
+
+func (m *EventBlobPlacement) GetBlobSize() uint32 {
+	if m != nil {
+		return m.BlobSize
+	}
+	return 0
+}
+
+func (m *EventBlobPlacement) GetShareCommitment() []byte {
+	if m != nil {
+		return m.ShareCommitment
+	}
+	return nil
+}
+
+func (m *EventBlobPlacement) GetStartShareIndex() uint32 {
+	if m != nil {
+		return m.StartShareIndex
+	}
+	return 0
+}
+
+func (m *EventBlobPlacement) GetShareLength() uint32 {
+	if m != nil {
+		return m.ShareLength
+	}
+	return 0
+}
+
+func (m *EventBlobPlacement) GetTxHash() string {
+	if m != nil {
+		return m.TxHash
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*EventPayForBlobs)(nil), "celestia.blob.v1.EventPayForBlobs")
+	proto.RegisterType((*EventBlobPlacement)(nil), "celestia.blob.v1.EventBlobPlacement")
 }
 
 func init() { proto.RegisterFile("celestia/blob/v1/event.proto", fileDescriptor_9d90f0a63835a06e) }
 
 var fileDescriptor_9d90f0a63835a06e = []byte{
-	// 214 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x49, 0x4e, 0xcd, 0x49,
-	0x2d, 0x2e, 0xc9, 0x4c, 0xd4, 0x4f, 0xca, 0xc9, 0x4f, 0xd2, 0x2f, 0x33, 0xd4, 0x4f, 0x2d, 0x4b,
-	0xcd, 0x2b, 0xd1, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x80, 0xc9, 0xea, 0x81, 0x64, 0xf5,
-	0xca, 0x0c, 0x95, 0x32, 0xb9, 0x04, 0x5c, 0x41, 0x0a, 0x02, 0x12, 0x2b, 0xdd, 0xf2, 0x8b, 0x9c,
-	0x72, 0xf2, 0x93, 0x8a, 0x85, 0xc4, 0xb8, 0xd8, 0x8a, 0x33, 0xd3, 0xf3, 0x52, 0x8b, 0x24, 0x18,
-	0x15, 0x18, 0x35, 0x38, 0x83, 0xa0, 0x3c, 0x21, 0x59, 0x2e, 0x2e, 0x90, 0xb6, 0xf8, 0xe2, 0xcc,
-	0xaa, 0xd4, 0x62, 0x09, 0x26, 0x05, 0x66, 0x0d, 0xde, 0x20, 0x4e, 0x90, 0x48, 0x30, 0x48, 0x40,
-	0x48, 0x8e, 0x8b, 0x2b, 0x2f, 0x31, 0x37, 0xb5, 0xb8, 0x20, 0x31, 0x39, 0xb5, 0x58, 0x82, 0x59,
-	0x81, 0x59, 0x83, 0x27, 0x08, 0x49, 0xc4, 0xc9, 0xeb, 0xc4, 0x23, 0x39, 0xc6, 0x0b, 0x8f, 0xe4,
-	0x18, 0x1f, 0x3c, 0x92, 0x63, 0x9c, 0xf0, 0x58, 0x8e, 0xe1, 0xc2, 0x63, 0x39, 0x86, 0x1b, 0x8f,
-	0xe5, 0x18, 0xa2, 0x0c, 0xd2, 0x33, 0x4b, 0x32, 0x4a, 0x93, 0xf4, 0x92, 0xf3, 0x73, 0xf5, 0x61,
-	0x2e, 0xcc, 0x2f, 0x4a, 0x87, 0xb3, 0x75, 0x13, 0x0b, 0x0a, 0xf4, 0x2b, 0x20, 0x3e, 0x2a, 0xa9,
-	0x2c, 0x48, 0x2d, 0x4e, 0x62, 0x03, 0xfb, 0xc7, 0x18, 0x10, 0x00, 0x00, 0xff, 0xff, 0x9a, 0x7b,
-	0x9e, 0x60, 0xef, 0x00, 0x00, 0x00,
+	// 355 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x91, 0xc1, 0x4e, 0xab, 0x40,
+	0x18, 0x85, 0x4b, 0xb9, 0xb7, 0x2d, 0x73, 0x7b, 0xd3, 0x3a, 0x0b, 0x9d, 0xc4, 0x4a, 0xb0, 0x2b,
+	0x34, 0x11, 0x6c, 0x7c, 0x83, 0x1a, 0x8d, 0x1a, 0x17, 0x0d, 0xdd, 0xb9, 0x21, 0x03, 0xfe, 0x01,
+	0x12, 0x60, 0x08, 0x33, 0x36, 0xd4, 0xa7, 0x70, 0xeb, 0x1b, 0xb9, 0xec, 0xd2, 0xa5, 0x69, 0x5f,
+	0xc4, 0xcc, 0x20, 0xe8, 0xc6, 0xdd, 0xcc, 0x77, 0xfe, 0x7f, 0xce, 0x99, 0x1c, 0x34, 0x09, 0x21,
+	0x05, 0x2e, 0x12, 0xea, 0x06, 0x29, 0x0b, 0xdc, 0xd5, 0xcc, 0x85, 0x15, 0xe4, 0xc2, 0x29, 0x4a,
+	0x26, 0x18, 0x1e, 0x37, 0xaa, 0x23, 0x55, 0x67, 0x35, 0x9b, 0x26, 0x68, 0x7c, 0x25, 0x07, 0x16,
+	0x74, 0x7d, 0xcd, 0xca, 0x79, 0xca, 0x02, 0x8e, 0xf7, 0x51, 0x8f, 0x27, 0x51, 0x0e, 0x25, 0xd1,
+	0x2c, 0xcd, 0x36, 0xbc, 0xaf, 0x1b, 0x3e, 0x42, 0x48, 0xae, 0xf9, 0x3c, 0x79, 0x06, 0x4e, 0xba,
+	0x96, 0x6e, 0xff, 0xf7, 0x0c, 0x49, 0x96, 0x12, 0x60, 0x13, 0xa1, 0x9c, 0x66, 0xc0, 0x0b, 0x1a,
+	0x02, 0x27, 0xba, 0xa5, 0xdb, 0x43, 0xef, 0x07, 0x99, 0xbe, 0x76, 0x11, 0x56, 0x5e, 0xd2, 0x65,
+	0x91, 0xd2, 0x10, 0x32, 0xc8, 0xc5, 0xaf, 0x6e, 0x13, 0x64, 0xb4, 0xcb, 0xa4, 0x6b, 0x69, 0xf6,
+	0xd0, 0xfb, 0x06, 0x6d, 0x96, 0x24, 0x7f, 0x84, 0x8a, 0xe8, 0x96, 0xd6, 0x64, 0xb9, 0x95, 0x00,
+	0x1f, 0x22, 0xa3, 0x8d, 0x4a, 0xfe, 0x28, 0x75, 0xd0, 0x24, 0xc5, 0x27, 0x68, 0xcc, 0x63, 0x5a,
+	0x82, 0x1f, 0xb2, 0x2c, 0x4b, 0x84, 0x4c, 0x41, 0xfe, 0x2a, 0x83, 0x91, 0xe2, 0x97, 0x2d, 0xc6,
+	0xa7, 0x68, 0x8f, 0x0b, 0x5a, 0x0a, 0xbf, 0x5e, 0xa8, 0xdd, 0x7a, 0xea, 0xbd, 0x91, 0x12, 0x96,
+	0x92, 0xd7, 0x9e, 0xc7, 0x68, 0x58, 0x4f, 0xa5, 0x90, 0x47, 0x22, 0x26, 0x7d, 0x35, 0xf6, 0x4f,
+	0xb1, 0x7b, 0x85, 0xf0, 0x01, 0xea, 0x8b, 0xca, 0x8f, 0x29, 0x8f, 0xc9, 0xa0, 0xfe, 0xac, 0xa8,
+	0x6e, 0x28, 0x8f, 0xe7, 0x77, 0x6f, 0x5b, 0x53, 0xdb, 0x6c, 0x4d, 0xed, 0x63, 0x6b, 0x6a, 0x2f,
+	0x3b, 0xb3, 0xb3, 0xd9, 0x99, 0x9d, 0xf7, 0x9d, 0xd9, 0x79, 0x38, 0x8f, 0x12, 0x11, 0x3f, 0x05,
+	0x4e, 0xc8, 0x32, 0xb7, 0x69, 0x8f, 0x95, 0x51, 0x7b, 0x3e, 0xa3, 0x45, 0xe1, 0x56, 0x75, 0xdb,
+	0x62, 0x5d, 0x00, 0x0f, 0x7a, 0xaa, 0xeb, 0x8b, 0xcf, 0x01, 0x00, 0xf1, 0x40, 0xbe, 0x8d, 0x0b,
+	0x02, 0x00, 0x00,
 }
 
 func (m *EventPayForBlobs) Marshal() (dAtA []byte, err error) {
@@ -168,6 +287,77 @@ func (m *EventPayForBlobs) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *EventBlobPlacement) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventBlobPlacement) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventBlobPlacement) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TxHash) > 0 {
+		i -= len(m.TxHash)
+		copy(dAtA[i:], m.TxHash)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.TxHash)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.ShareLength != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.ShareLength))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.StartShareIndex != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.StartShareIndex))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.ShareCommitment) > 0 {
+		i -= len(m.ShareCommitment)
+		copy(dAtA[i:], m.ShareCommitment)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.ShareCommitment)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.BlobSize != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.BlobSize))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.BlobIndex != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.BlobIndex))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintEvent(dAtA []byte, offset int, v uint64) int {
 	offset -= sovEvent(v)
 	base := offset
@@ -205,6 +395,43 @@ func (m *EventPayForBlobs) Size() (n int) {
 	return n
 }
 
+func (m *EventBlobPlacement) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.BlobIndex != 0 {
+		n += 1 + sovEvent(uint64(m.BlobIndex))
+	}
+	if m.BlobSize != 0 {
+		n += 1 + sovEvent(uint64(m.BlobSize))
+	}
+	l = len(m.ShareCommitment)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.StartShareIndex != 0 {
+		n += 1 + sovEvent(uint64(m.StartShareIndex))
+	}
+	if m.ShareLength != 0 {
+		n += 1 + sovEvent(uint64(m.ShareLength))
+	}
+	l = len(m.TxHash)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+
 func sovEvent(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -401,6 +628,264 @@ func (m *EventPayForBlobs) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *EventBlobPlacement) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventBlobPlacement: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventBlobPlacement: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = append(m.Namespace[:0], dAtA[iNdEx:postIndex]...)
+			if m.Namespace == nil {
+				m.Namespace = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlobIndex", wireType)
+			}
+			m.BlobIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlobIndex |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlobSize", wireType)
+			}
+			m.BlobSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlobSize |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShareCommitment", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ShareCommitment = append(m.ShareCommitment[:0], dAtA[iNdEx:postIndex]...)
+			if m.ShareCommitment == nil {
+				m.ShareCommitment = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartShareIndex", wireType)
+			}
+			m.StartShareIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartShareIndex |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShareLength", wireType)
+			}
+			m.ShareLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ShareLength |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipEvent(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -1,6 +1,7 @@
 package types
 
 import (
+	"github.com/celestiaorg/celestia-app/pkg/shares"
 	"github.com/cosmos/gogoproto/proto"
 )
 
@@ -14,3 +15,28 @@ func NewPayForBlobsEvent(signer string, blobSizes []uint32, namespaces [][]byte)
 		Namespaces: namespaces,
 	}
 }
+
+// NewBlobPlacementEvents returns an EventBlobPlacement for each blob of msg,
+// which is part of the transaction with the hex encoded txHash. shareIndexes
+// are the indexes of the first share of each blob in the data square, as
+// written to the IndexWrapper of the PFB. No events are returned if the number
+// of share indexes doesn't match the number of blobs.
+func NewBlobPlacementEvents(txHash string, msg *MsgPayForBlobs, shareIndexes []uint32) []*EventBlobPlacement {
+	if len(shareIndexes) != len(msg.BlobSizes) {
+		return nil
+	}
+	events := make([]*EventBlobPlacement, len(msg.BlobSizes))
+	for i, size := range msg.BlobSizes {
+		events[i] = &EventBlobPlacement{
+			Signer:          msg.Signer,
+			Namespace:       msg.Namespaces[i],
+			BlobIndex:       uint32(i),
+			BlobSize:        size,
+			ShareCommitment: msg.ShareCommitments[i],
+			StartShareIndex: shareIndexes[i],
+			ShareLength:     uint32(shares.SparseSharesNeededForVersion(size, uint8(msg.ShareVersions[i]))),
+			TxHash:          txHash,
+		}
+	}
+	return events
+}
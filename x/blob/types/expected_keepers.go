@@ -0,0 +1,11 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// AccountKeeper defines the account keeper methods used by the blob module.
+type AccountKeeper interface {
+	GetParams(ctx sdk.Context) authtypes.Params
+}
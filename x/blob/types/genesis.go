@@ -1,17 +1,24 @@
 package types
 
+import "fmt"
+
 // DefaultIndex is the default capability global index
 const DefaultIndex uint64 = 1
 
 // DefaultGenesis returns the default Capability genesis state
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
-		Params: DefaultParams(),
+		Params:      DefaultParams(),
+		BlobBaseFee: MinBlobBaseFee,
 	}
 }
 
 // Validate performs basic genesis state validation returning an error upon any
 // failure.
 func (gs GenesisState) Validate() error {
+	// an unset blob base fee defaults to MinBlobBaseFee
+	if !gs.BlobBaseFee.IsNil() && !gs.BlobBaseFee.IsZero() && gs.BlobBaseFee.LT(MinBlobBaseFee) {
+		return fmt.Errorf("blob base fee must be at least %s, got %s", MinBlobBaseFee, gs.BlobBaseFee)
+	}
 	return gs.Params.Validate()
 }
@@ -5,6 +5,7 @@ import (
 
 	"github.com/celestiaorg/celestia-app/pkg/appconsts"
 	"github.com/celestiaorg/celestia-app/x/blob/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 )
 
@@ -29,6 +30,14 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			valid: true,
 		},
+		{
+			desc: "invalid genesis state because BlobBaseFee",
+			genState: &types.GenesisState{
+				Params:      types.DefaultParams(),
+				BlobBaseFee: sdk.NewDecWithPrec(1, 4),
+			},
+			valid: false,
+		},
 		{
 			desc: "invalid genesis state because GovMaxSquareSize",
 			genState: &types.GenesisState{
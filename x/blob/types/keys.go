@@ -1,5 +1,14 @@
 package types
 
+var (
+	// BlobBaseFeeKey is the key of the current blob base fee.
+	BlobBaseFeeKey = []byte{0x01}
+
+	// BlobSharesUsedKey is the key of the number of blob shares that have been
+	// paid for in the current block.
+	BlobSharesUsedKey = []byte{0x02}
+)
+
 const (
 	// ModuleName defines the module name
 	ModuleName = "blob"
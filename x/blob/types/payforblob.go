@@ -11,6 +11,7 @@ import (
 	appshares "github.com/celestiaorg/celestia-app/pkg/shares"
 	"github.com/celestiaorg/nmt"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
 	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
 	"github.com/tendermint/tendermint/crypto/merkle"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
@@ -32,7 +33,11 @@ func NewMsgPayForBlobs(signer string, blobs ...*Blob) (*MsgPayForBlobs, error) {
 	if err != nil {
 		return nil, err
 	}
-	commitments, err := CreateCommitments(blobs)
+	signerBytes, err := blobSigner(signer, blobs)
+	if err != nil {
+		return nil, err
+	}
+	commitments, err := CreateCommitmentsWithSigner(blobs, signerBytes)
 	if err != nil {
 		return nil, err
 	}
@@ -114,7 +119,7 @@ func (msg *MsgPayForBlobs) ValidateBasic() error {
 	}
 
 	for _, v := range msg.ShareVersions {
-		if v != uint32(appconsts.ShareVersionZero) {
+		if v > math.MaxUint8 || !slices.Contains(appconsts.SupportedShareVersions, uint8(v)) {
 			return ErrUnsupportedShareVersion
 		}
 	}
@@ -133,6 +138,12 @@ func (msg *MsgPayForBlobs) ValidateBasic() error {
 	return nil
 }
 
+// SharesUsed returns the number of shares occupied by the blobs paid for by
+// the message, not including any padding.
+func (msg *MsgPayForBlobs) SharesUsed() int {
+	return BlobSharesUsed(msg.BlobSizes, msg.ShareVersions)
+}
+
 // ValidateBlobNamespaceID returns an error if the provided namespace.ID is an invalid or reserved namespace id.
 func ValidateBlobNamespaceID(ns appns.Namespace) error {
 	if ns.IsReserved() {
@@ -171,6 +182,13 @@ func (msg *MsgPayForBlobs) GetSigners() []sdk.AccAddress {
 // [Message layout rationale]: https://github.com/celestiaorg/celestia-specs/blob/e59efd63a2165866584833e91e1cb8a6ed8c8203/src/rationale/message_block_layout.md?plain=1#L12
 // [Non-interactive default rules]: https://github.com/celestiaorg/celestia-specs/blob/e59efd63a2165866584833e91e1cb8a6ed8c8203/src/rationale/message_block_layout.md?plain=1#L36
 func CreateCommitment(blob *Blob) ([]byte, error) {
+	return CreateCommitmentWithSigner(blob, nil)
+}
+
+// CreateCommitmentWithSigner generates the share commitment for a given blob
+// whose first share contains the provided signer. The signer is required for
+// blobs of appconsts.ShareVersionOne and ignored for all other share versions.
+func CreateCommitmentWithSigner(blob *Blob, signer []byte) ([]byte, error) {
 	coreblob := coretypes.Blob{
 		NamespaceID:      blob.NamespaceId,
 		Data:             blob.Data,
@@ -178,11 +196,25 @@ func CreateCommitment(blob *Blob) ([]byte, error) {
 		NamespaceVersion: uint8(blob.NamespaceVersion),
 	}
 
-	shares, err := appshares.SplitBlobs(0, nil, []coretypes.Blob{coreblob}, false)
+	splitter := appshares.NewSparseShareSplitter()
+	if err := splitter.WriteWithSigner(coreblob, signer); err != nil {
+		return nil, err
+	}
+	namespace, err := appns.New(uint8(blob.NamespaceVersion), blob.NamespaceId)
+	if err != nil {
+		return nil, err
+	}
+	subTreeRoots, err := SubtreeRoots(splitter.Export(), namespace)
 	if err != nil {
 		return nil, err
 	}
+	return merkle.HashFromByteSlices(subTreeRoots), nil
+}
 
+// SubtreeRoots returns the roots of the subtrees over the shares of a blob
+// whose Merkle root is the blob's share commitment. The shares are expected
+// to be all the shares of a single blob of the provided namespace.
+func SubtreeRoots(shares []appshares.Share, namespace appns.Namespace) ([][]byte, error) {
 	// the commitment is the root of a merkle mountain range with max tree size
 	// determined by the number of roots required to create a share commitment
 	// over that blob. The size of the tree is only increased if the number of
@@ -205,10 +237,6 @@ func CreateCommitment(blob *Blob) ([]byte, error) {
 		// create the nmt todo(evan) use nmt wrapper
 		tree := nmt.New(sha256.New(), nmt.NamespaceIDSize(appns.NamespaceSize), nmt.IgnoreMaxNamespace(true))
 		for _, leaf := range set {
-			namespace, err := appns.New(uint8(blob.NamespaceVersion), blob.NamespaceId)
-			if err != nil {
-				return nil, err
-			}
 			// the namespace must be added again here even though it is already
 			// included in the leaf to ensure that the hash will match that of
 			// the nmt wrapper (pkg/wrapper). Each namespace is added to keep
@@ -231,13 +259,17 @@ func CreateCommitment(blob *Blob) ([]byte, error) {
 		}
 		subTreeRoots[i] = root
 	}
-	return merkle.HashFromByteSlices(subTreeRoots), nil
+	return subTreeRoots, nil
 }
 
 func CreateCommitments(blobs []*Blob) ([][]byte, error) {
+	return CreateCommitmentsWithSigner(blobs, nil)
+}
+
+func CreateCommitmentsWithSigner(blobs []*Blob, signer []byte) ([][]byte, error) {
 	commitments := make([][]byte, len(blobs))
 	for i, blob := range blobs {
-		commitment, err := CreateCommitment(blob)
+		commitment, err := CreateCommitmentWithSigner(blob, signer)
 		if err != nil {
 			return nil, err
 		}
@@ -246,6 +278,34 @@ func CreateCommitments(blobs []*Blob) ([][]byte, error) {
 	return commitments, nil
 }
 
+// SignerBytes returns the address bytes of a bech32 encoded signer as they
+// are embedded in blobs of appconsts.ShareVersionOne. The human readable part
+// of the address is not checked.
+func SignerBytes(signer string) ([]byte, error) {
+	_, bz, err := bech32.DecodeAndConvert(signer)
+	if err != nil {
+		return nil, err
+	}
+	if len(bz) != appconsts.SignerSize {
+		return nil, fmt.Errorf("signer address must be %d bytes, got %d", appconsts.SignerSize, len(bz))
+	}
+	return bz, nil
+}
+
+// blobSigner returns the address bytes of the signer if any of the blobs uses
+// appconsts.ShareVersionOne and nil otherwise. Only blobs of share version one
+// embed the signer, so signers whose address is not appconsts.SignerSize
+// bytes long, such as module accounts, can still pay for blobs of share
+// version zero.
+func blobSigner(signer string, blobs []*Blob) ([]byte, error) {
+	for _, blob := range blobs {
+		if blob.ShareVersion == uint32(appconsts.ShareVersionOne) {
+			return SignerBytes(signer)
+		}
+	}
+	return nil, nil
+}
+
 // ValidateBlobs performs basic checks over the components of one or more PFBs.
 func ValidateBlobs(blobs ...*Blob) error {
 	if len(blobs) == 0 {
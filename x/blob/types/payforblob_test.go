@@ -277,6 +277,8 @@ func TestNewMsgPayForBlobs(t *testing.T) {
 	require.NoError(t, err)
 	ns1 := appns.MustNewV0(bytes.Repeat([]byte{1}, appns.NamespaceVersionZeroIDSize))
 	ns2 := appns.MustNewV0(bytes.Repeat([]byte{2}, appns.NamespaceVersionZeroIDSize))
+	// module and interchain accounts have 32 byte addresses
+	longAddr := sdk.AccAddress(bytes.Repeat([]byte{3}, 32))
 
 	testCases := []testCase{
 		{
@@ -349,6 +351,31 @@ func TestNewMsgPayForBlobs(t *testing.T) {
 			},
 			expectedErr: true,
 		},
+		{
+			name:   "valid msg PFB with a 32 byte signer",
+			signer: longAddr.String(),
+			blobs: []*tmproto.Blob{
+				{
+					NamespaceVersion: uint32(ns1.Version),
+					NamespaceId:      ns1.ID,
+					Data:             []byte{1},
+					ShareVersion:     uint32(appconsts.ShareVersionZero),
+				},
+			},
+		},
+		{
+			name:   "share version one with a 32 byte signer returns an error",
+			signer: longAddr.String(),
+			blobs: []*tmproto.Blob{
+				{
+					NamespaceVersion: uint32(ns1.Version),
+					NamespaceId:      ns1.ID,
+					Data:             []byte{1},
+					ShareVersion:     uint32(appconsts.ShareVersionOne),
+				},
+			},
+			expectedErr: true,
+		},
 		{
 			name:   "msg PFB with invalid signer returns an error",
 			signer: addr.String()[:10],
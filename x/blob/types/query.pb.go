@@ -6,6 +6,8 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
@@ -112,34 +114,264 @@ func (m *QueryParamsResponse) GetParams() Params {
 	return Params{}
 }
 
+// QueryBlobBaseFeeRequest is the request type for the Query/BlobBaseFee RPC
+// method.
+type QueryBlobBaseFeeRequest struct {
+}
+
+func (m *QueryBlobBaseFeeRequest) Reset()         { *m = QueryBlobBaseFeeRequest{} }
+func (m *QueryBlobBaseFeeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBlobBaseFeeRequest) ProtoMessage()    {}
+func (*QueryBlobBaseFeeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29ba8a4248383b64, []int{2}
+}
+func (m *QueryBlobBaseFeeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBlobBaseFeeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBlobBaseFeeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBlobBaseFeeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBlobBaseFeeRequest.Merge(m, src)
+}
+func (m *QueryBlobBaseFeeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBlobBaseFeeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBlobBaseFeeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBlobBaseFeeRequest proto.InternalMessageInfo
+
+// QueryBlobBaseFeeResponse is the response type for the Query/BlobBaseFee RPC
+// method.
+type QueryBlobBaseFeeResponse struct {
+	// BlobBaseFee is the fee in utia that is burned per share occupied by the
+	// blobs of a MsgPayForBlobs.
+	BlobBaseFee github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=blob_base_fee,json=blobBaseFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"blob_base_fee"`
+}
+
+func (m *QueryBlobBaseFeeResponse) Reset()         { *m = QueryBlobBaseFeeResponse{} }
+func (m *QueryBlobBaseFeeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBlobBaseFeeResponse) ProtoMessage()    {}
+func (*QueryBlobBaseFeeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29ba8a4248383b64, []int{3}
+}
+func (m *QueryBlobBaseFeeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBlobBaseFeeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBlobBaseFeeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBlobBaseFeeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBlobBaseFeeResponse.Merge(m, src)
+}
+func (m *QueryBlobBaseFeeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBlobBaseFeeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBlobBaseFeeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBlobBaseFeeResponse proto.InternalMessageInfo
+
+// QueryEstimateGasForBlobsRequest is the request type for the
+// Query/EstimateGasForBlobs RPC method.
+type QueryEstimateGasForBlobsRequest struct {
+	// BlobSizes are the sizes in bytes of the blobs paid for.
+	BlobSizes []uint32 `protobuf:"varint,1,rep,packed,name=blob_sizes,json=blobSizes,proto3" json:"blob_sizes,omitempty"`
+	// NumSignatures is the number of signatures of the transaction. It defaults
+	// to one.
+	NumSignatures uint32 `protobuf:"varint,2,opt,name=num_signatures,json=numSignatures,proto3" json:"num_signatures,omitempty"`
+	// GasPrice is the price in utia per unit of gas used to compute the fee. It
+	// defaults to the default minimum gas price.
+	GasPrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=gas_price,json=gasPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"gas_price"`
+	// ShareVersions are the share versions of the blobs paid for. If empty, all
+	// blobs are assumed to use share version zero.
+	ShareVersions []uint32 `protobuf:"varint,4,rep,packed,name=share_versions,json=shareVersions,proto3" json:"share_versions,omitempty"`
+}
+
+func (m *QueryEstimateGasForBlobsRequest) Reset()         { *m = QueryEstimateGasForBlobsRequest{} }
+func (m *QueryEstimateGasForBlobsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEstimateGasForBlobsRequest) ProtoMessage()    {}
+func (*QueryEstimateGasForBlobsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29ba8a4248383b64, []int{4}
+}
+func (m *QueryEstimateGasForBlobsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEstimateGasForBlobsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEstimateGasForBlobsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEstimateGasForBlobsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEstimateGasForBlobsRequest.Merge(m, src)
+}
+func (m *QueryEstimateGasForBlobsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEstimateGasForBlobsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEstimateGasForBlobsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEstimateGasForBlobsRequest proto.InternalMessageInfo
+
+func (m *QueryEstimateGasForBlobsRequest) GetBlobSizes() []uint32 {
+	if m != nil {
+		return m.BlobSizes
+	}
+	return nil
+}
+
+func (m *QueryEstimateGasForBlobsRequest) GetNumSignatures() uint32 {
+	if m != nil {
+		return m.NumSignatures
+	}
+	return 0
+}
+
+func (m *QueryEstimateGasForBlobsRequest) GetShareVersions() []uint32 {
+	if m != nil {
+		return m.ShareVersions
+	}
+	return nil
+}
+
+// QueryEstimateGasForBlobsResponse is the response type for the
+// Query/EstimateGasForBlobs RPC method.
+type QueryEstimateGasForBlobsResponse struct {
+	// GasLimit is the recommended gas limit of the transaction.
+	GasLimit uint64 `protobuf:"varint,1,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
+	// Fee is the recommended fee of the transaction: the gas limit at the gas
+	// price plus the blob base fee of the blobs.
+	Fee types.Coin `protobuf:"bytes,2,opt,name=fee,proto3" json:"fee"`
+}
+
+func (m *QueryEstimateGasForBlobsResponse) Reset()         { *m = QueryEstimateGasForBlobsResponse{} }
+func (m *QueryEstimateGasForBlobsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEstimateGasForBlobsResponse) ProtoMessage()    {}
+func (*QueryEstimateGasForBlobsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29ba8a4248383b64, []int{5}
+}
+func (m *QueryEstimateGasForBlobsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEstimateGasForBlobsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEstimateGasForBlobsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEstimateGasForBlobsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEstimateGasForBlobsResponse.Merge(m, src)
+}
+func (m *QueryEstimateGasForBlobsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEstimateGasForBlobsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEstimateGasForBlobsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEstimateGasForBlobsResponse proto.InternalMessageInfo
+
+func (m *QueryEstimateGasForBlobsResponse) GetGasLimit() uint64 {
+	if m != nil {
+		return m.GasLimit
+	}
+	return 0
+}
+
+func (m *QueryEstimateGasForBlobsResponse) GetFee() types.Coin {
+	if m != nil {
+		return m.Fee
+	}
+	return types.Coin{}
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "celestia.blob.v1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "celestia.blob.v1.QueryParamsResponse")
+	proto.RegisterType((*QueryBlobBaseFeeRequest)(nil), "celestia.blob.v1.QueryBlobBaseFeeRequest")
+	proto.RegisterType((*QueryBlobBaseFeeResponse)(nil), "celestia.blob.v1.QueryBlobBaseFeeResponse")
+	proto.RegisterType((*QueryEstimateGasForBlobsRequest)(nil), "celestia.blob.v1.QueryEstimateGasForBlobsRequest")
+	proto.RegisterType((*QueryEstimateGasForBlobsResponse)(nil), "celestia.blob.v1.QueryEstimateGasForBlobsResponse")
 }
 
 func init() { proto.RegisterFile("celestia/blob/v1/query.proto", fileDescriptor_29ba8a4248383b64) }
 
 var fileDescriptor_29ba8a4248383b64 = []byte{
-	// 304 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x90, 0x41, 0x4b, 0xc3, 0x30,
-	0x18, 0x86, 0x5b, 0xd1, 0x1d, 0xe2, 0x41, 0x8d, 0x03, 0xc7, 0x98, 0x51, 0x86, 0x82, 0x08, 0x26,
-	0x76, 0x82, 0x3f, 0x60, 0x47, 0x41, 0xd0, 0x1d, 0xbd, 0x25, 0x23, 0xc4, 0xc2, 0x96, 0x2f, 0x6b,
-	0xd2, 0xea, 0xae, 0xfe, 0x02, 0xc1, 0x3f, 0xb5, 0xe3, 0xc0, 0x8b, 0x27, 0x91, 0xd6, 0x1f, 0x22,
-	0x6d, 0x3a, 0xc1, 0xf5, 0xe0, 0xed, 0xe3, 0x7b, 0x9f, 0xf7, 0xcd, 0xfb, 0x05, 0xf5, 0xc6, 0x72,
-	0x22, 0xad, 0x8b, 0x39, 0x13, 0x13, 0x10, 0x2c, 0x8b, 0xd8, 0x2c, 0x95, 0xc9, 0x9c, 0x9a, 0x04,
-	0x1c, 0xe0, 0xdd, 0x95, 0x4a, 0x4b, 0x95, 0x66, 0x51, 0xb7, 0xad, 0x40, 0x41, 0x25, 0xb2, 0x72,
-	0xf2, 0x5c, 0xb7, 0xa7, 0x00, 0xd4, 0x44, 0x32, 0x6e, 0x62, 0xc6, 0xb5, 0x06, 0xc7, 0x5d, 0x0c,
-	0xda, 0xd6, 0xea, 0xf9, 0x18, 0xec, 0x14, 0x2c, 0x13, 0xdc, 0x4a, 0x1f, 0xcf, 0xb2, 0x48, 0x48,
-	0xc7, 0x23, 0x66, 0xb8, 0x8a, 0x75, 0x05, 0xd7, 0xec, 0x61, 0xa3, 0x8f, 0xe1, 0x09, 0x9f, 0xd6,
-	0x51, 0xfd, 0x36, 0xc2, 0xf7, 0x65, 0xc0, 0x5d, 0xb5, 0x1c, 0xc9, 0x59, 0x2a, 0xad, 0xeb, 0xdf,
-	0xa2, 0xfd, 0x3f, 0x5b, 0x6b, 0x40, 0x5b, 0x89, 0xaf, 0x51, 0xcb, 0x9b, 0x3b, 0xe1, 0x71, 0x78,
-	0xb6, 0x3d, 0xe8, 0xd0, 0xf5, 0x73, 0xa8, 0x77, 0x0c, 0x37, 0x17, 0x9f, 0x47, 0xc1, 0xa8, 0xa6,
-	0x07, 0x4f, 0x68, 0xab, 0x8a, 0xc3, 0x1a, 0xb5, 0x3c, 0x80, 0x4f, 0x9a, 0xd6, 0x66, 0x8f, 0xee,
-	0xe9, 0x3f, 0x94, 0xef, 0xd5, 0x3f, 0x78, 0x79, 0xff, 0x7e, 0xdb, 0xd8, 0xc3, 0x3b, 0x6b, 0x37,
-	0x0e, 0x6f, 0x16, 0x39, 0x09, 0x97, 0x39, 0x09, 0xbf, 0x72, 0x12, 0xbe, 0x16, 0x24, 0x58, 0x16,
-	0x24, 0xf8, 0x28, 0x48, 0xf0, 0x70, 0xa9, 0x62, 0xf7, 0x98, 0x0a, 0x3a, 0x86, 0x29, 0x5b, 0xbd,
-	0x01, 0x89, 0xfa, 0x9d, 0x2f, 0xb8, 0x31, 0xec, 0xd9, 0xe7, 0xb9, 0xb9, 0x91, 0x56, 0xb4, 0xaa,
-	0x0f, 0xbb, 0xfa, 0x09, 0x00, 0x00, 0xff, 0xff, 0x39, 0x82, 0x2f, 0xc4, 0xe1, 0x01, 0x00, 0x00,
+	// 614 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0xdd, 0x6e, 0xd3, 0x3c,
+	0x18, 0x6e, 0xd6, 0x7d, 0xd3, 0x37, 0x97, 0xf2, 0xe3, 0x4d, 0x2c, 0x2b, 0x5b, 0x5a, 0x22, 0x06,
+	0x65, 0xd2, 0x6c, 0x52, 0x24, 0x2e, 0xa0, 0xc0, 0x90, 0xf8, 0x91, 0x46, 0x26, 0x71, 0xc0, 0x49,
+	0xe4, 0x04, 0x2f, 0xb3, 0x68, 0xe2, 0x2c, 0x76, 0x2a, 0xc6, 0x21, 0xe2, 0x02, 0x90, 0xb8, 0x06,
+	0xb8, 0x96, 0x1d, 0x4e, 0xe2, 0x04, 0x81, 0x34, 0xa1, 0x96, 0x0b, 0x41, 0x76, 0xdc, 0xd2, 0xad,
+	0x54, 0xb0, 0xa3, 0xba, 0xef, 0xcf, 0xf3, 0x3c, 0xef, 0xfb, 0x3e, 0x0a, 0x58, 0x8b, 0x68, 0x8f,
+	0x0a, 0xc9, 0x08, 0x0e, 0x7b, 0x3c, 0xc4, 0x7d, 0x0f, 0x1f, 0x14, 0x34, 0x3f, 0x44, 0x59, 0xce,
+	0x25, 0x87, 0x97, 0x47, 0x59, 0xa4, 0xb2, 0xa8, 0xef, 0x35, 0x96, 0x63, 0x1e, 0x73, 0x9d, 0xc4,
+	0xea, 0x55, 0xd6, 0x35, 0xd6, 0x62, 0xce, 0xe3, 0x1e, 0xc5, 0x24, 0x63, 0x98, 0xa4, 0x29, 0x97,
+	0x44, 0x32, 0x9e, 0x0a, 0x93, 0xdd, 0x8c, 0xb8, 0x48, 0xb8, 0xc0, 0x21, 0x11, 0xb4, 0x84, 0xc7,
+	0x7d, 0x2f, 0xa4, 0x92, 0x78, 0x38, 0x23, 0x31, 0x4b, 0x75, 0xb1, 0xa9, 0x5d, 0x9f, 0xd2, 0x93,
+	0x91, 0x9c, 0x24, 0x23, 0x28, 0x67, 0x12, 0x6a, 0x04, 0x12, 0x71, 0x66, 0xda, 0xdd, 0x65, 0x00,
+	0x9f, 0x2b, 0x82, 0x1d, 0xdd, 0xe4, 0xd3, 0x83, 0x82, 0x0a, 0xe9, 0x3e, 0x03, 0x4b, 0xa7, 0xa2,
+	0x22, 0xe3, 0xa9, 0xa0, 0xf0, 0x1e, 0x58, 0x28, 0xc1, 0x6d, 0xab, 0x65, 0xb5, 0x6b, 0x1d, 0x1b,
+	0x9d, 0x1d, 0x17, 0x95, 0x1d, 0xdd, 0xf9, 0xa3, 0x93, 0x66, 0xc5, 0x37, 0xd5, 0xee, 0x2a, 0x58,
+	0xd1, 0x70, 0xdd, 0x1e, 0x0f, 0xbb, 0x44, 0xd0, 0x6d, 0x4a, 0x47, 0x4c, 0x29, 0xb0, 0xa7, 0x53,
+	0x86, 0xce, 0x07, 0x75, 0x05, 0x1b, 0x28, 0xed, 0xc1, 0x1e, 0xa5, 0x9a, 0xf5, 0x42, 0x17, 0x29,
+	0xec, 0x6f, 0x27, 0xcd, 0x9b, 0x31, 0x93, 0xfb, 0x45, 0x88, 0x22, 0x9e, 0x60, 0x33, 0x65, 0xf9,
+	0xb3, 0x25, 0x5e, 0xbd, 0xc6, 0xf2, 0x30, 0xa3, 0x02, 0x3d, 0xa0, 0x91, 0x5f, 0x0b, 0x7f, 0x63,
+	0xbb, 0xdf, 0x2d, 0xd0, 0xd4, 0x84, 0x0f, 0x85, 0x64, 0x09, 0x91, 0xf4, 0x11, 0x11, 0xdb, 0x3c,
+	0x57, 0xf4, 0xa3, 0xe9, 0xe1, 0x3a, 0x00, 0x9a, 0x57, 0xb0, 0xb7, 0x54, 0x8d, 0x5a, 0x6d, 0xd7,
+	0xfd, 0x45, 0x15, 0xd9, 0x55, 0x01, 0xb8, 0x01, 0x2e, 0xa6, 0x45, 0x12, 0x08, 0x16, 0xa7, 0x44,
+	0x16, 0x39, 0x15, 0xf6, 0x5c, 0xcb, 0x6a, 0xd7, 0xfd, 0x7a, 0x5a, 0x24, 0xbb, 0xe3, 0x20, 0x7c,
+	0x02, 0x16, 0x63, 0x22, 0x82, 0x2c, 0x67, 0x11, 0xb5, 0xab, 0x2d, 0xab, 0xbd, 0x78, 0x6e, 0xe5,
+	0xff, 0xc7, 0x44, 0xec, 0xa8, 0x7e, 0xc5, 0x29, 0xf6, 0x49, 0x4e, 0x83, 0x3e, 0xcd, 0x85, 0x72,
+	0x8a, 0x3d, 0xaf, 0x65, 0xd5, 0x75, 0xf4, 0x85, 0x09, 0xba, 0x39, 0x68, 0xcd, 0x1e, 0xce, 0x6c,
+	0xf5, 0x5a, 0xa9, 0xab, 0xc7, 0x12, 0x26, 0xf5, 0x46, 0xe7, 0x35, 0xcf, 0x53, 0xf5, 0x1f, 0x7a,
+	0xa0, 0xaa, 0x16, 0x3d, 0xa7, 0xcf, 0xbb, 0x8a, 0x4a, 0x55, 0x48, 0x1d, 0x00, 0x19, 0xf3, 0xa0,
+	0xfb, 0x9c, 0xa5, 0xe6, 0xbe, 0xaa, 0xb6, 0xf3, 0xb9, 0x0a, 0xfe, 0xd3, 0xa4, 0x30, 0x05, 0x0b,
+	0xe5, 0xf9, 0xe1, 0x8d, 0x69, 0x63, 0x4c, 0xbb, 0xac, 0xb1, 0xf1, 0x97, 0xaa, 0x52, 0xb0, 0xbb,
+	0xf2, 0xee, 0xcb, 0xcf, 0x8f, 0x73, 0x57, 0xe0, 0xa5, 0x33, 0x0e, 0x87, 0xef, 0x2d, 0x50, 0x9b,
+	0xf0, 0x0d, 0xbc, 0x3d, 0x03, 0x6f, 0xda, 0x76, 0x8d, 0xcd, 0x7f, 0x29, 0x35, 0xfc, 0x8e, 0xe6,
+	0xb7, 0xe1, 0xd5, 0x31, 0xff, 0x29, 0x57, 0xc2, 0x4f, 0x16, 0x58, 0xfa, 0xc3, 0xc2, 0xa1, 0x37,
+	0x83, 0x63, 0xb6, 0xf3, 0x1a, 0x9d, 0xf3, 0xb4, 0x18, 0x79, 0xb7, 0xb4, 0xbc, 0xeb, 0xb0, 0x39,
+	0x96, 0x47, 0x4d, 0x75, 0xa0, 0xee, 0xbc, 0xc7, 0xf3, 0x40, 0x25, 0x44, 0xf7, 0xf1, 0xd1, 0xc0,
+	0xb1, 0x8e, 0x07, 0x8e, 0xf5, 0x63, 0xe0, 0x58, 0x1f, 0x86, 0x4e, 0xe5, 0x78, 0xe8, 0x54, 0xbe,
+	0x0e, 0x9d, 0xca, 0xcb, 0x3b, 0x93, 0x7e, 0x34, 0x02, 0x78, 0x1e, 0x8f, 0xdf, 0x5b, 0x24, 0xcb,
+	0xf0, 0x9b, 0x12, 0x5f, 0xbb, 0x33, 0x5c, 0xd0, 0x5f, 0x8f, 0xbb, 0xbf, 0x06, 0x00, 0xb5, 0x42,
+	0x5e, 0x0f, 0x0e, 0x05, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -156,6 +388,12 @@ const _ = grpc.SupportPackageIsVersion4
 type QueryClient interface {
 	// Params queries the parameters of the module.
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// BlobBaseFee queries the fee per blob share that is currently required to
+	// pay for blobs.
+	BlobBaseFee(ctx context.Context, in *QueryBlobBaseFeeRequest, opts ...grpc.CallOption) (*QueryBlobBaseFeeResponse, error)
+	// EstimateGasForBlobs estimates the gas limit and fee of a transaction
+	// containing a MsgPayForBlobs that pays for blobs of the provided sizes.
+	EstimateGasForBlobs(ctx context.Context, in *QueryEstimateGasForBlobsRequest, opts ...grpc.CallOption) (*QueryEstimateGasForBlobsResponse, error)
 }
 
 type queryClient struct {
@@ -175,10 +413,34 @@ func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts .
 	return out, nil
 }
 
+func (c *queryClient) BlobBaseFee(ctx context.Context, in *QueryBlobBaseFeeRequest, opts ...grpc.CallOption) (*QueryBlobBaseFeeResponse, error) {
+	out := new(QueryBlobBaseFeeResponse)
+	err := c.cc.Invoke(ctx, "/celestia.blob.v1.Query/BlobBaseFee", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EstimateGasForBlobs(ctx context.Context, in *QueryEstimateGasForBlobsRequest, opts ...grpc.CallOption) (*QueryEstimateGasForBlobsResponse, error) {
+	out := new(QueryEstimateGasForBlobsResponse)
+	err := c.cc.Invoke(ctx, "/celestia.blob.v1.Query/EstimateGasForBlobs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params queries the parameters of the module.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// BlobBaseFee queries the fee per blob share that is currently required to
+	// pay for blobs.
+	BlobBaseFee(context.Context, *QueryBlobBaseFeeRequest) (*QueryBlobBaseFeeResponse, error)
+	// EstimateGasForBlobs estimates the gas limit and fee of a transaction
+	// containing a MsgPayForBlobs that pays for blobs of the provided sizes.
+	EstimateGasForBlobs(context.Context, *QueryEstimateGasForBlobsRequest) (*QueryEstimateGasForBlobsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -188,6 +450,12 @@ type UnimplementedQueryServer struct {
 func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
+func (*UnimplementedQueryServer) BlobBaseFee(ctx context.Context, req *QueryBlobBaseFeeRequest) (*QueryBlobBaseFeeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlobBaseFee not implemented")
+}
+func (*UnimplementedQueryServer) EstimateGasForBlobs(ctx context.Context, req *QueryEstimateGasForBlobsRequest) (*QueryEstimateGasForBlobsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EstimateGasForBlobs not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -211,6 +479,42 @@ func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_BlobBaseFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBlobBaseFeeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BlobBaseFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celestia.blob.v1.Query/BlobBaseFee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BlobBaseFee(ctx, req.(*QueryBlobBaseFeeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EstimateGasForBlobs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEstimateGasForBlobsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EstimateGasForBlobs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celestia.blob.v1.Query/EstimateGasForBlobs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EstimateGasForBlobs(ctx, req.(*QueryEstimateGasForBlobsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "celestia.blob.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -219,6 +523,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
 		},
+		{
+			MethodName: "BlobBaseFee",
+			Handler:    _Query_BlobBaseFee_Handler,
+		},
+		{
+			MethodName: "EstimateGasForBlobs",
+			Handler:    _Query_EstimateGasForBlobs_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "celestia/blob/v1/query.proto",
@@ -280,72 +592,302 @@ func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryBlobBaseFeeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *QueryBlobBaseFeeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBlobBaseFeeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryBlobBaseFeeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBlobBaseFeeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBlobBaseFeeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	{
+		size := m.BlobBaseFee.Size()
+		i -= size
+		if _, err := m.BlobBaseFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *QueryEstimateGasForBlobsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
 }
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *QueryEstimateGasForBlobsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+
+func (m *QueryEstimateGasForBlobsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ShareVersions) > 0 {
+		dAtA3 := make([]byte, len(m.ShareVersions)*10)
+		var j2 int
+		for _, num := range m.ShareVersions {
+			for num >= 1<<7 {
+				dAtA3[j2] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j2++
 			}
+			dAtA3[j2] = uint8(num)
+			j2++
 		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		i -= j2
+		copy(dAtA[i:], dAtA3[:j2])
+		i = encodeVarintQuery(dAtA, i, uint64(j2))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size := m.GasPrice.Size()
+		i -= size
+		if _, err := m.GasPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
-		switch fieldNum {
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.NumSignatures != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.NumSignatures))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.BlobSizes) > 0 {
+		dAtA5 := make([]byte, len(m.BlobSizes)*10)
+		var j4 int
+		for _, num := range m.BlobSizes {
+			for num >= 1<<7 {
+				dAtA5[j4] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j4++
+			}
+			dAtA5[j4] = uint8(num)
+			j4++
+		}
+		i -= j4
+		copy(dAtA[i:], dAtA5[:j4])
+		i = encodeVarintQuery(dAtA, i, uint64(j4))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEstimateGasForBlobsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEstimateGasForBlobsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEstimateGasForBlobsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Fee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.GasLimit != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.GasLimit))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryBlobBaseFeeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryBlobBaseFeeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.BlobBaseFee.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryEstimateGasForBlobsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.BlobSizes) > 0 {
+		l = 0
+		for _, e := range m.BlobSizes {
+			l += sovQuery(uint64(e))
+		}
+		n += 1 + sovQuery(uint64(l)) + l
+	}
+	if m.NumSignatures != 0 {
+		n += 1 + sovQuery(uint64(m.NumSignatures))
+	}
+	l = m.GasPrice.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.ShareVersions) > 0 {
+		l = 0
+		for _, e := range m.ShareVersions {
+			l += sovQuery(uint64(e))
+		}
+		n += 1 + sovQuery(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *QueryEstimateGasForBlobsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GasLimit != 0 {
+		n += 1 + sovQuery(uint64(m.GasLimit))
+	}
+	l = m.Fee.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -450,6 +992,496 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryBlobBaseFeeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBlobBaseFeeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBlobBaseFeeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBlobBaseFeeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBlobBaseFeeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBlobBaseFeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlobBaseFee", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BlobBaseFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEstimateGasForBlobsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEstimateGasForBlobsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEstimateGasForBlobsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.BlobSizes = append(m.BlobSizes, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthQuery
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthQuery
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.BlobSizes) == 0 {
+					m.BlobSizes = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.BlobSizes = append(m.BlobSizes, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlobSizes", wireType)
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumSignatures", wireType)
+			}
+			m.NumSignatures = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumSignatures |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GasPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.ShareVersions = append(m.ShareVersions, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthQuery
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthQuery
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.ShareVersions) == 0 {
+					m.ShareVersions = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.ShareVersions = append(m.ShareVersions, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShareVersions", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEstimateGasForBlobsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEstimateGasForBlobsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEstimateGasForBlobsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
+			}
+			m.GasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
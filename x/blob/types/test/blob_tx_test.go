@@ -7,11 +7,14 @@ import (
 	"github.com/celestiaorg/celestia-app/app"
 	"github.com/celestiaorg/celestia-app/app/encoding"
 	"github.com/celestiaorg/celestia-app/pkg/appconsts"
+	v1 "github.com/celestiaorg/celestia-app/pkg/appconsts/v1"
+	v2 "github.com/celestiaorg/celestia-app/pkg/appconsts/v2"
 	"github.com/celestiaorg/celestia-app/pkg/namespace"
 	appns "github.com/celestiaorg/celestia-app/pkg/namespace"
 	"github.com/celestiaorg/celestia-app/test/util/blobfactory"
 	"github.com/celestiaorg/celestia-app/x/blob/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -241,10 +244,72 @@ func TestValidateBlobTx(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			err := types.ValidateBlobTx(encCfg.TxConfig, tt.getTx())
+			err := types.ValidateBlobTx(encCfg.TxConfig, tt.getTx(), appconsts.LatestVersion)
 			if tt.expectedErr != nil {
 				assert.ErrorIs(t, err, tt.expectedErr, tt.name)
 			}
 		})
 	}
 }
+
+func TestValidateBlobTxShareVersionOne(t *testing.T) {
+	encCfg := encoding.MakeConfig(app.ModuleEncodingRegisters...)
+	signer := types.GenerateKeyringSigner(t, "test")
+	addr, err := signer.GetSignerInfo().GetAddress()
+	require.NoError(t, err)
+	bech32Addr, err := sdk.Bech32ifyAddressBytes(sdk.GetConfig().GetBech32AccountAddrPrefix(), addr)
+	require.NoError(t, err)
+
+	blob, err := types.NewBlob(namespace.RandomBlobNamespace(), rand.Bytes(1000), appconsts.ShareVersionOne)
+	require.NoError(t, err)
+	msg, err := types.NewMsgPayForBlobs(bech32Addr, blob)
+	require.NoError(t, err)
+	stx, err := signer.BuildSignedTx(signer.NewTxBuilder(), msg)
+	require.NoError(t, err)
+	rawTx, err := encCfg.TxConfig.TxEncoder()(stx)
+	require.NoError(t, err)
+	btx := tmproto.BlobTx{Tx: rawTx, Blobs: []*tmproto.Blob{blob}}
+
+	// share version one is only enabled from app version 2 onwards
+	err = types.ValidateBlobTx(encCfg.TxConfig, btx, v1.Version)
+	assert.ErrorIs(t, err, types.ErrUnsupportedShareVersion)
+	require.NoError(t, types.ValidateBlobTx(encCfg.TxConfig, btx, v2.Version))
+
+	// the share commitment covers the signer so a PFB of a different signer
+	// can't pay for the same blob
+	otherAddr := bytes.Repeat([]byte{0x01}, appconsts.SignerSize)
+	otherCommitment, err := types.CreateCommitmentWithSigner(blob, otherAddr)
+	require.NoError(t, err)
+	assert.NotEqual(t, msg.ShareCommitments[0], otherCommitment)
+	msg.ShareCommitments[0] = otherCommitment
+	stx, err = signer.BuildSignedTx(signer.NewTxBuilder(), msg)
+	require.NoError(t, err)
+	btx.Tx, err = encCfg.TxConfig.TxEncoder()(stx)
+	require.NoError(t, err)
+	err = types.ValidateBlobTx(encCfg.TxConfig, btx, v2.Version)
+	assert.ErrorIs(t, err, types.ErrInvalidShareCommitment)
+}
+
+func TestValidateBlobTxMsgExec(t *testing.T) {
+	encCfg := encoding.MakeConfig(app.ModuleEncodingRegisters...)
+	signer := types.GenerateKeyringSigner(t, "test")
+	addr, err := signer.GetSignerInfo().GetAddress()
+	require.NoError(t, err)
+
+	blob, err := types.NewBlob(namespace.RandomBlobNamespace(), rand.Bytes(1000), appconsts.ShareVersionZero)
+	require.NoError(t, err)
+	msg, err := types.NewMsgPayForBlobs(sdk.AccAddress(bytes.Repeat([]byte{0x01}, 20)).String(), blob)
+	require.NoError(t, err)
+	exec := authz.NewMsgExec(addr, []sdk.Msg{msg})
+	stx, err := signer.BuildSignedTx(signer.NewTxBuilder(), &exec)
+	require.NoError(t, err)
+	rawTx, err := encCfg.TxConfig.TxEncoder()(stx)
+	require.NoError(t, err)
+	btx := tmproto.BlobTx{Tx: rawTx, Blobs: []*tmproto.Blob{blob}}
+
+	// PFBs executed through an authz MsgExec are only valid from app version
+	// 2 onwards
+	err = types.ValidateBlobTx(encCfg.TxConfig, btx, v1.Version)
+	assert.ErrorIs(t, err, types.ErrNoPFB)
+	require.NoError(t, types.ValidateBlobTx(encCfg.TxConfig, btx, v2.Version))
+}
@@ -0,0 +1,38 @@
+package ante
+
+import (
+	blobtypes "github.com/celestiaorg/celestia-app/x/blob/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NamespaceKeeper defines the namespace keeper methods used by the ante
+// decorator.
+type NamespaceKeeper interface {
+	CheckSigner(ctx sdk.Context, namespace []byte, signer string) error
+}
+
+// NamespaceOwnershipDecorator rejects transactions containing a
+// MsgPayForBlobs, either directly or through an authz MsgExec, whose signer
+// isn't allowed to pay for blobs in one of its namespaces.
+type NamespaceOwnershipDecorator struct {
+	namespaceKeeper NamespaceKeeper
+}
+
+func NewNamespaceOwnershipDecorator(namespaceKeeper NamespaceKeeper) NamespaceOwnershipDecorator {
+	return NamespaceOwnershipDecorator{namespaceKeeper: namespaceKeeper}
+}
+
+func (d NamespaceOwnershipDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	for _, msg := range tx.GetMsgs() {
+		pfb, ok := blobtypes.PFBFromMsg(msg)
+		if !ok {
+			continue
+		}
+		for _, namespace := range pfb.Namespaces {
+			if err := d.namespaceKeeper.CheckSigner(ctx, namespace, pfb.Signer); err != nil {
+				return ctx, err
+			}
+		}
+	}
+	return next(ctx, tx, simulate)
+}
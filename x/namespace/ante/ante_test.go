@@ -0,0 +1,83 @@
+package ante_test
+
+import (
+	"bytes"
+	"testing"
+
+	appns "github.com/celestiaorg/celestia-app/pkg/namespace"
+	blobtypes "github.com/celestiaorg/celestia-app/x/blob/types"
+	"github.com/celestiaorg/celestia-app/x/namespace/ante"
+	"github.com/celestiaorg/celestia-app/x/namespace/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNamespaceOwnershipDecorator(t *testing.T) {
+	owner := sdk.AccAddress(bytes.Repeat([]byte{1}, 20)).String()
+	other := sdk.AccAddress(bytes.Repeat([]byte{2}, 20)).String()
+	registered := appns.MustNewV0(bytes.Repeat([]byte{1}, appns.NamespaceVersionZeroIDSize))
+	unregistered := appns.MustNewV0(bytes.Repeat([]byte{2}, appns.NamespaceVersionZeroIDSize))
+	keeper := mockNamespaceKeeper{registrations: map[string]types.Registration{
+		string(registered.Bytes()): {Namespace: registered.Bytes(), Owner: owner},
+	}}
+
+	type test struct {
+		name        string
+		msgs        []sdk.Msg
+		expectedErr error
+	}
+	tests := []test{
+		{
+			name: "owner pays for blobs in the registered namespace",
+			msgs: []sdk.Msg{&blobtypes.MsgPayForBlobs{Signer: owner, Namespaces: [][]byte{registered.Bytes(), unregistered.Bytes()}}},
+		},
+		{
+			name: "anyone pays for blobs in an unregistered namespace",
+			msgs: []sdk.Msg{&blobtypes.MsgPayForBlobs{Signer: other, Namespaces: [][]byte{unregistered.Bytes()}}},
+		},
+		{
+			name:        "other account pays for blobs in the registered namespace",
+			msgs:        []sdk.Msg{&blobtypes.MsgPayForBlobs{Signer: other, Namespaces: [][]byte{unregistered.Bytes(), registered.Bytes()}}},
+			expectedErr: types.ErrUnauthorizedSigner,
+		},
+		{
+			name: "no MsgPayForBlobs",
+			msgs: []sdk.Msg{&banktypes.MsgSend{}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			decorator := ante.NewNamespaceOwnershipDecorator(keeper)
+			_, err := decorator.AnteHandle(sdk.Context{}, mockTx{msgs: tt.msgs}, false, nextAnteHandler)
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func nextAnteHandler(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) {
+	return ctx, nil
+}
+
+type mockNamespaceKeeper struct {
+	registrations map[string]types.Registration
+}
+
+func (k mockNamespaceKeeper) CheckSigner(_ sdk.Context, namespace []byte, signer string) error {
+	registration, ok := k.registrations[string(namespace)]
+	if ok && !registration.IsAuthorized(signer) {
+		return types.ErrUnauthorizedSigner
+	}
+	return nil
+}
+
+type mockTx struct {
+	msgs []sdk.Msg
+}
+
+func (tx mockTx) GetMsgs() []sdk.Msg   { return tx.msgs }
+func (tx mockTx) ValidateBasic() error { return nil }
@@ -0,0 +1,142 @@
+package cli
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+
+	appns "github.com/celestiaorg/celestia-app/pkg/namespace"
+	"github.com/celestiaorg/celestia-app/x/namespace/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+)
+
+// FlagOwner filters the registrations returned by the registrations query by
+// their owner.
+const FlagOwner = "owner"
+
+// GetQueryCmd returns the CLI query commands for this module
+func GetQueryCmd(_ string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      fmt.Sprintf("Querying commands for the %s module", types.ModuleName),
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(CmdQueryParams())
+	cmd.AddCommand(CmdQueryRegistration())
+	cmd.AddCommand(CmdQueryRegistrations())
+
+	return cmd
+}
+
+func CmdQueryParams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "params",
+		Short: "shows the parameters of the module",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Params(context.Background(), &types.QueryParamsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryRegistration() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "registration [hexNamespace]",
+		Short: "shows the owner and allowed signers of a registered namespace",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			namespace, err := parseNamespace(args[0])
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Registration(cmd.Context(), &types.QueryRegistrationRequest{Namespace: namespace.Bytes()})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryRegistrations() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "registrations",
+		Short: "lists the registered namespaces",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			owner, err := cmd.Flags().GetString(FlagOwner)
+			if err != nil {
+				return err
+			}
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Registrations(cmd.Context(), &types.QueryRegistrationsRequest{
+				Owner:      owner,
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(FlagOwner, "", "Only list the namespaces registered to this account")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "registrations")
+
+	return cmd
+}
+
+// parseNamespace parses either a hex encoded namespace ID of a version 0
+// namespace or a hex encoded namespace.
+func parseNamespace(hexNamespace string) (appns.Namespace, error) {
+	bz, err := hex.DecodeString(hexNamespace)
+	if err != nil {
+		return appns.Namespace{}, fmt.Errorf("failure to decode hex namespace: %w", err)
+	}
+	switch len(bz) {
+	case appns.NamespaceSize:
+		return appns.From(bz)
+	case appns.NamespaceVersionZeroIDSize:
+		return appns.MustNewV0(bz), nil
+	default:
+		return appns.Namespace{}, fmt.Errorf("namespace must be %d or %d bytes, got %d", appns.NamespaceVersionZeroIDSize, appns.NamespaceSize, len(bz))
+	}
+}
@@ -0,0 +1,106 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/celestiaorg/celestia-app/x/namespace/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdktx "github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cobra"
+)
+
+// GetTxCmd returns the transaction commands for this module
+func GetTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      fmt.Sprintf("%s transactions subcommands", types.ModuleName),
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(CmdRegisterNamespace())
+	cmd.AddCommand(CmdUpdateAllowedSigners())
+	cmd.AddCommand(CmdDeregisterNamespace())
+
+	return cmd
+}
+
+func CmdRegisterNamespace() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "register [hexNamespace] [allowedSigner]...",
+		Short: "Register a namespace so that only you and the allowed signers can pay for blobs in it",
+		Long: "Register a namespace so that only you and the allowed signers can pay for blobs in it. " +
+			"[hexNamespace] is either a 10 byte hex encoded namespace ID of a version 0 namespace or a 29 byte hex encoded namespace. " +
+			"The registration fee is burned from your account.",
+		Example: "celestia-appd tx namespace register 0102030405060708090a celestia1... --from mykey",
+		Args:    cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			namespace, err := parseNamespace(args[0])
+			if err != nil {
+				return err
+			}
+			msg := types.NewMsgRegisterNamespace(clientCtx.GetFromAddress().String(), namespace, args[1:]...)
+			return sdktx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdUpdateAllowedSigners() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-allowed-signers [hexNamespace] [allowedSigner]...",
+		Short: "Replace the allowed signers of a namespace registered to you",
+		Long: "Replace the allowed signers of a namespace registered to you. " +
+			"Passing no allowed signers leaves you as the only account that can pay for blobs in the namespace.",
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			namespace, err := parseNamespace(args[0])
+			if err != nil {
+				return err
+			}
+			msg := types.NewMsgUpdateAllowedSigners(clientCtx.GetFromAddress().String(), namespace, args[1:]...)
+			return sdktx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdDeregisterNamespace() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "deregister [hexNamespace]",
+		Short: "Remove the registration of a namespace registered to you so that anyone can pay for blobs in it",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			namespace, err := parseNamespace(args[0])
+			if err != nil {
+				return err
+			}
+			msg := types.NewMsgDeregisterNamespace(clientCtx.GetFromAddress().String(), namespace)
+			return sdktx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,4 @@
+// namespace is a Cosmos SDK module that lets users register a namespace so
+// that only they and the signers they allow can pay for blobs in it. Please
+// see ./README.md for the full specification of this module.
+package namespace
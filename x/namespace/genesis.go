@@ -0,0 +1,24 @@
+package namespace
+
+import (
+	"github.com/celestiaorg/celestia-app/x/namespace/keeper"
+	"github.com/celestiaorg/celestia-app/x/namespace/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// InitGenesis initializes the namespace module's state from a provided genesis
+// state.
+func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState) {
+	k.SetParams(ctx, genState.Params)
+	for _, registration := range genState.Registrations {
+		k.SetRegistration(ctx, registration)
+	}
+}
+
+// ExportGenesis returns the namespace module's exported genesis.
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
+	genesis := types.DefaultGenesis()
+	genesis.Params = k.GetParams(ctx)
+	genesis.Registrations = k.GetAllRegistrations(ctx)
+	return genesis
+}
@@ -0,0 +1,30 @@
+package namespace_test
+
+import (
+	"testing"
+
+	appns "github.com/celestiaorg/celestia-app/pkg/namespace"
+	keepertest "github.com/celestiaorg/celestia-app/test/util/keeper"
+	"github.com/celestiaorg/celestia-app/x/namespace"
+	"github.com/celestiaorg/celestia-app/x/namespace/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	tmrand "github.com/tendermint/tendermint/libs/rand"
+)
+
+func TestGenesis(t *testing.T) {
+	genesisState := types.GenesisState{
+		Params: types.NewParams(sdk.NewCoins(sdk.NewInt64Coin("utia", 5)), true),
+		Registrations: []types.Registration{{
+			Namespace:      appns.MustNewV0(tmrand.Bytes(appns.NamespaceVersionZeroIDSize)).Bytes(),
+			Owner:          sdk.AccAddress(tmrand.Bytes(20)).String(),
+			AllowedSigners: []string{sdk.AccAddress(tmrand.Bytes(20)).String()},
+		}},
+	}
+
+	k, ctx := keepertest.NamespaceKeeper(t, nil)
+	namespace.InitGenesis(ctx, *k, genesisState)
+	got := namespace.ExportGenesis(ctx, *k)
+	require.NotNil(t, got)
+	require.Equal(t, genesisState, *got)
+}
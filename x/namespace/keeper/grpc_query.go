@@ -0,0 +1,71 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/celestiaorg/celestia-app/x/namespace/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var _ types.QueryServer = Keeper{}
+
+func (k Keeper) Params(c context.Context, req *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryParamsResponse{Params: k.GetParams(ctx)}, nil
+}
+
+func (k Keeper) Registration(c context.Context, req *types.QueryRegistrationRequest) (*types.QueryRegistrationResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if err := types.ValidateNamespace(req.Namespace); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	registration, registered := k.GetRegistration(ctx, req.Namespace)
+	if !registered {
+		return nil, status.Errorf(codes.NotFound, "namespace %X is not registered", req.Namespace)
+	}
+	return &types.QueryRegistrationResponse{Registration: registration}, nil
+}
+
+func (k Keeper) Registrations(c context.Context, req *types.QueryRegistrationsRequest) (*types.QueryRegistrationsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if req.Owner != "" {
+		if _, err := sdk.AccAddressFromBech32(req.Owner); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var registrations []types.Registration
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.RegistrationKeyPrefix)
+	pageRes, err := query.FilteredPaginate(store, req.Pagination, func(_ []byte, value []byte, accumulate bool) (bool, error) {
+		var registration types.Registration
+		if err := k.cdc.Unmarshal(value, &registration); err != nil {
+			return false, err
+		}
+		if req.Owner != "" && registration.Owner != req.Owner {
+			return false, nil
+		}
+		if accumulate {
+			registrations = append(registrations, registration)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.QueryRegistrationsResponse{Registrations: registrations, Pagination: pageRes}, nil
+}
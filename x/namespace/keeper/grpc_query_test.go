@@ -0,0 +1,71 @@
+package keeper_test
+
+import (
+	"testing"
+
+	appns "github.com/celestiaorg/celestia-app/pkg/namespace"
+	testkeeper "github.com/celestiaorg/celestia-app/test/util/keeper"
+	"github.com/celestiaorg/celestia-app/x/namespace/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	tmrand "github.com/tendermint/tendermint/libs/rand"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestParamsQuery(t *testing.T) {
+	k, ctx := testkeeper.NamespaceKeeper(t, &mockBankKeeper{})
+	wctx := sdk.WrapSDKContext(ctx)
+
+	response, err := k.Params(wctx, &types.QueryParamsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, &types.QueryParamsResponse{Params: types.DefaultParams()}, response)
+}
+
+func TestRegistrationQueries(t *testing.T) {
+	k, ctx := testkeeper.NamespaceKeeper(t, &mockBankKeeper{})
+	wctx := sdk.WrapSDKContext(ctx)
+
+	owner, otherOwner := randAddress(), randAddress()
+	var owned []types.Registration
+	for i := 0; i < 5; i++ {
+		registration := types.Registration{
+			Namespace: appns.MustNewV0(tmrand.Bytes(appns.NamespaceVersionZeroIDSize)).Bytes(),
+			Owner:     owner,
+		}
+		if i%2 == 1 {
+			registration.Owner = otherOwner
+		} else {
+			owned = append(owned, registration)
+		}
+		k.SetRegistration(ctx, registration)
+	}
+
+	res, err := k.Registration(wctx, &types.QueryRegistrationRequest{Namespace: owned[0].Namespace})
+	require.NoError(t, err)
+	assert.Equal(t, owned[0], res.Registration)
+
+	_, err = k.Registration(wctx, &types.QueryRegistrationRequest{Namespace: appns.MustNewV0(tmrand.Bytes(appns.NamespaceVersionZeroIDSize)).Bytes()})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	_, err = k.Registration(wctx, &types.QueryRegistrationRequest{Namespace: []byte{1}})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	all, err := k.Registrations(wctx, &types.QueryRegistrationsRequest{})
+	require.NoError(t, err)
+	assert.Len(t, all.Registrations, 5)
+
+	byOwner, err := k.Registrations(wctx, &types.QueryRegistrationsRequest{Owner: owner})
+	require.NoError(t, err)
+	assert.ElementsMatch(t, owned, byOwner.Registrations)
+
+	page, err := k.Registrations(wctx, &types.QueryRegistrationsRequest{
+		Owner:      owner,
+		Pagination: &query.PageRequest{Limit: 2, CountTotal: true},
+	})
+	require.NoError(t, err)
+	assert.Len(t, page.Registrations, 2)
+	assert.Equal(t, uint64(len(owned)), page.Pagination.Total)
+}
@@ -0,0 +1,110 @@
+package keeper
+
+import (
+	"fmt"
+
+	"cosmossdk.io/errors"
+	"github.com/celestiaorg/celestia-app/x/namespace/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/tendermint/tendermint/libs/log"
+)
+
+// Keeper handles all the state changes for the namespace module.
+type Keeper struct {
+	cdc        codec.BinaryCodec
+	storeKey   storetypes.StoreKey
+	paramStore paramtypes.Subspace
+	bankKeeper types.BankKeeper
+}
+
+func NewKeeper(
+	cdc codec.BinaryCodec,
+	storeKey storetypes.StoreKey,
+	ps paramtypes.Subspace,
+	bankKeeper types.BankKeeper,
+) *Keeper {
+	if !ps.HasKeyTable() {
+		ps = ps.WithKeyTable(types.ParamKeyTable())
+	}
+
+	return &Keeper{
+		cdc:        cdc,
+		storeKey:   storeKey,
+		paramStore: ps,
+		bankKeeper: bankKeeper,
+	}
+}
+
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
+// GetRegistration returns the registration of namespace and whether it is
+// registered.
+func (k Keeper) GetRegistration(ctx sdk.Context, namespace []byte) (types.Registration, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.RegistrationKey(namespace))
+	if bz == nil {
+		return types.Registration{}, false
+	}
+	var registration types.Registration
+	k.cdc.MustUnmarshal(bz, &registration)
+	return registration, true
+}
+
+// SetRegistration stores the registration, replacing any previous
+// registration of the same namespace.
+func (k Keeper) SetRegistration(ctx sdk.Context, registration types.Registration) {
+	ctx.KVStore(k.storeKey).Set(types.RegistrationKey(registration.Namespace), k.cdc.MustMarshal(&registration))
+}
+
+// DeleteRegistration removes the registration of namespace.
+func (k Keeper) DeleteRegistration(ctx sdk.Context, namespace []byte) {
+	ctx.KVStore(k.storeKey).Delete(types.RegistrationKey(namespace))
+}
+
+// IterateRegistrations calls cb for every registration in the order of their
+// namespaces until cb returns true.
+func (k Keeper) IterateRegistrations(ctx sdk.Context, cb func(types.Registration) (stop bool)) {
+	iterator := prefix.NewStore(ctx.KVStore(k.storeKey), types.RegistrationKeyPrefix).Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var registration types.Registration
+		k.cdc.MustUnmarshal(iterator.Value(), &registration)
+		if cb(registration) {
+			return
+		}
+	}
+}
+
+// GetAllRegistrations returns all registrations in the order of their
+// namespaces.
+func (k Keeper) GetAllRegistrations(ctx sdk.Context) []types.Registration {
+	var registrations []types.Registration
+	k.IterateRegistrations(ctx, func(registration types.Registration) bool {
+		registrations = append(registrations, registration)
+		return false
+	})
+	return registrations
+}
+
+// CheckSigner returns an error if signer isn't allowed to pay for blobs in
+// namespace. Anyone can pay for blobs in a namespace that isn't registered
+// unless the RequireRegistration param is set.
+func (k Keeper) CheckSigner(ctx sdk.Context, namespace []byte, signer string) error {
+	registration, registered := k.GetRegistration(ctx, namespace)
+	if !registered {
+		if k.RequireRegistration(ctx) {
+			return errors.Wrapf(types.ErrNamespaceNotRegistered, "namespace %X", namespace)
+		}
+		return nil
+	}
+	if !registration.IsAuthorized(signer) {
+		return errors.Wrapf(types.ErrUnauthorizedSigner, "signer %s, namespace %X", signer, namespace)
+	}
+	return nil
+}
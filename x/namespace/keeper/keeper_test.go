@@ -0,0 +1,148 @@
+package keeper_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/celestiaorg/celestia-app/pkg/appconsts"
+	appns "github.com/celestiaorg/celestia-app/pkg/namespace"
+	testkeeper "github.com/celestiaorg/celestia-app/test/util/keeper"
+	"github.com/celestiaorg/celestia-app/x/namespace/keeper"
+	"github.com/celestiaorg/celestia-app/x/namespace/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	tmrand "github.com/tendermint/tendermint/libs/rand"
+)
+
+func TestRegisterNamespace(t *testing.T) {
+	owner, signer, other := randAddress(), randAddress(), randAddress()
+	namespace := appns.MustNewV0(tmrand.Bytes(appns.NamespaceVersionZeroIDSize))
+
+	bank := &mockBankKeeper{balances: map[string]sdk.Coins{
+		owner: sdk.NewCoins(sdk.NewInt64Coin(appconsts.BondDenom, 1_500_000)),
+	}}
+	k, ctx := testkeeper.NamespaceKeeper(t, bank)
+	msgServer := keeper.NewMsgServerImpl(*k)
+	wctx := sdk.WrapSDKContext(ctx)
+
+	// anyone can pay for blobs in an unregistered namespace
+	require.NoError(t, k.CheckSigner(ctx, namespace.Bytes(), other))
+
+	_, err := msgServer.RegisterNamespace(wctx, types.NewMsgRegisterNamespace(owner, namespace, signer))
+	require.NoError(t, err)
+	assert.Equal(t, types.DefaultRegistrationFee, bank.burned)
+
+	registration, registered := k.GetRegistration(ctx, namespace.Bytes())
+	require.True(t, registered)
+	assert.Equal(t, owner, registration.Owner)
+	assert.Equal(t, []string{signer}, registration.AllowedSigners)
+
+	assert.NoError(t, k.CheckSigner(ctx, namespace.Bytes(), owner))
+	assert.NoError(t, k.CheckSigner(ctx, namespace.Bytes(), signer))
+	assert.ErrorIs(t, k.CheckSigner(ctx, namespace.Bytes(), other), types.ErrUnauthorizedSigner)
+
+	// a namespace can only be registered once
+	_, err = msgServer.RegisterNamespace(wctx, types.NewMsgRegisterNamespace(other, namespace))
+	assert.ErrorIs(t, err, types.ErrNamespaceRegistered)
+
+	// the owner can't afford registering a second namespace
+	otherNamespace := appns.MustNewV0(tmrand.Bytes(appns.NamespaceVersionZeroIDSize))
+	_, err = msgServer.RegisterNamespace(wctx, types.NewMsgRegisterNamespace(owner, otherNamespace))
+	assert.ErrorIs(t, err, sdkerrors.ErrInsufficientFunds)
+	_, registered = k.GetRegistration(ctx, otherNamespace.Bytes())
+	assert.False(t, registered)
+}
+
+func TestUpdateAllowedSigners(t *testing.T) {
+	owner, signer, other := randAddress(), randAddress(), randAddress()
+	namespace := appns.MustNewV0(tmrand.Bytes(appns.NamespaceVersionZeroIDSize))
+
+	k, ctx := testkeeper.NamespaceKeeper(t, &mockBankKeeper{})
+	k.SetParams(ctx, types.NewParams(sdk.NewCoins(), false))
+	msgServer := keeper.NewMsgServerImpl(*k)
+	wctx := sdk.WrapSDKContext(ctx)
+
+	_, err := msgServer.UpdateAllowedSigners(wctx, types.NewMsgUpdateAllowedSigners(owner, namespace, signer))
+	assert.ErrorIs(t, err, types.ErrNamespaceNotRegistered)
+
+	_, err = msgServer.RegisterNamespace(wctx, types.NewMsgRegisterNamespace(owner, namespace, signer))
+	require.NoError(t, err)
+
+	_, err = msgServer.UpdateAllowedSigners(wctx, types.NewMsgUpdateAllowedSigners(other, namespace, other))
+	assert.ErrorIs(t, err, types.ErrNotOwner)
+
+	_, err = msgServer.UpdateAllowedSigners(wctx, types.NewMsgUpdateAllowedSigners(owner, namespace, other))
+	require.NoError(t, err)
+	assert.ErrorIs(t, k.CheckSigner(ctx, namespace.Bytes(), signer), types.ErrUnauthorizedSigner)
+	assert.NoError(t, k.CheckSigner(ctx, namespace.Bytes(), other))
+
+	// the owner is recognized by its address rather than its encoding
+	_, err = msgServer.UpdateAllowedSigners(wctx, types.NewMsgUpdateAllowedSigners(strings.ToUpper(owner), namespace, signer))
+	require.NoError(t, err)
+	assert.NoError(t, k.CheckSigner(ctx, namespace.Bytes(), signer))
+}
+
+func TestDeregisterNamespace(t *testing.T) {
+	owner, other := randAddress(), randAddress()
+	namespace := appns.MustNewV0(tmrand.Bytes(appns.NamespaceVersionZeroIDSize))
+
+	k, ctx := testkeeper.NamespaceKeeper(t, &mockBankKeeper{})
+	k.SetParams(ctx, types.NewParams(sdk.NewCoins(), false))
+	msgServer := keeper.NewMsgServerImpl(*k)
+	wctx := sdk.WrapSDKContext(ctx)
+
+	_, err := msgServer.RegisterNamespace(wctx, types.NewMsgRegisterNamespace(owner, namespace))
+	require.NoError(t, err)
+
+	_, err = msgServer.DeregisterNamespace(wctx, types.NewMsgDeregisterNamespace(other, namespace))
+	assert.ErrorIs(t, err, types.ErrNotOwner)
+
+	_, err = msgServer.DeregisterNamespace(wctx, types.NewMsgDeregisterNamespace(owner, namespace))
+	require.NoError(t, err)
+	_, registered := k.GetRegistration(ctx, namespace.Bytes())
+	assert.False(t, registered)
+	assert.NoError(t, k.CheckSigner(ctx, namespace.Bytes(), other))
+}
+
+func TestCheckSignerRequireRegistration(t *testing.T) {
+	owner := randAddress()
+	namespace := appns.MustNewV0(tmrand.Bytes(appns.NamespaceVersionZeroIDSize))
+
+	k, ctx := testkeeper.NamespaceKeeper(t, &mockBankKeeper{})
+	k.SetParams(ctx, types.NewParams(sdk.NewCoins(), true))
+
+	assert.ErrorIs(t, k.CheckSigner(ctx, namespace.Bytes(), owner), types.ErrNamespaceNotRegistered)
+
+	k.SetRegistration(ctx, types.Registration{Namespace: namespace.Bytes(), Owner: owner})
+	assert.NoError(t, k.CheckSigner(ctx, namespace.Bytes(), owner))
+}
+
+func randAddress() string {
+	return sdk.AccAddress(tmrand.Bytes(20)).String()
+}
+
+// mockBankKeeper tracks the balances of accounts and the coins burned by the
+// namespace module.
+type mockBankKeeper struct {
+	balances map[string]sdk.Coins
+	burned   sdk.Coins
+	module   sdk.Coins
+}
+
+func (k *mockBankKeeper) SendCoinsFromAccountToModule(_ sdk.Context, senderAddr sdk.AccAddress, _ string, amt sdk.Coins) error {
+	balance, ok := k.balances[senderAddr.String()].SafeSub(amt...)
+	if ok {
+		return sdkerrors.ErrInsufficientFunds
+	}
+	k.balances[senderAddr.String()] = balance
+	k.module = k.module.Add(amt...)
+	return nil
+}
+
+func (k *mockBankKeeper) BurnCoins(_ sdk.Context, _ string, amt sdk.Coins) error {
+	k.module = k.module.Sub(amt...)
+	k.burned = k.burned.Add(amt...)
+	return nil
+}
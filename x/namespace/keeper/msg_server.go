@@ -0,0 +1,113 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/errors"
+	"github.com/celestiaorg/celestia-app/x/namespace/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ types.MsgServer = msgServer{}
+
+type msgServer struct {
+	Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the namespace MsgServer
+// interface for the provided Keeper.
+func NewMsgServerImpl(keeper Keeper) types.MsgServer {
+	return &msgServer{Keeper: keeper}
+}
+
+// RegisterNamespace burns the registration fee from the owner and registers
+// the namespace if it isn't registered yet.
+func (k msgServer) RegisterNamespace(goCtx context.Context, msg *types.MsgRegisterNamespace) (*types.MsgRegisterNamespaceResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, registered := k.GetRegistration(ctx, msg.Namespace); registered {
+		return nil, errors.Wrapf(types.ErrNamespaceRegistered, "namespace %X", msg.Namespace)
+	}
+
+	if fee := k.RegistrationFee(ctx); !fee.IsZero() {
+		owner, err := sdk.AccAddressFromBech32(msg.Owner)
+		if err != nil {
+			return nil, err
+		}
+		if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, owner, types.ModuleName, fee); err != nil {
+			return nil, err
+		}
+		if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, fee); err != nil {
+			return nil, err
+		}
+	}
+
+	k.SetRegistration(ctx, types.Registration{
+		Namespace:      msg.Namespace,
+		Owner:          msg.Owner,
+		AllowedSigners: msg.AllowedSigners,
+	})
+
+	err := ctx.EventManager().EmitTypedEvent(&types.EventRegisterNamespace{
+		Namespace:      msg.Namespace,
+		Owner:          msg.Owner,
+		AllowedSigners: msg.AllowedSigners,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &types.MsgRegisterNamespaceResponse{}, nil
+}
+
+// UpdateAllowedSigners replaces the allowed signers of a namespace registered
+// to the owner of the message.
+func (k msgServer) UpdateAllowedSigners(goCtx context.Context, msg *types.MsgUpdateAllowedSigners) (*types.MsgUpdateAllowedSignersResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	registration, err := k.getOwnedRegistration(ctx, msg.Namespace, msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+	registration.AllowedSigners = msg.AllowedSigners
+	k.SetRegistration(ctx, registration)
+
+	err = ctx.EventManager().EmitTypedEvent(&types.EventUpdateAllowedSigners{
+		Namespace:      msg.Namespace,
+		AllowedSigners: msg.AllowedSigners,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &types.MsgUpdateAllowedSignersResponse{}, nil
+}
+
+// DeregisterNamespace removes the registration of a namespace registered to
+// the owner of the message.
+func (k msgServer) DeregisterNamespace(goCtx context.Context, msg *types.MsgDeregisterNamespace) (*types.MsgDeregisterNamespaceResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, err := k.getOwnedRegistration(ctx, msg.Namespace, msg.Owner); err != nil {
+		return nil, err
+	}
+	k.DeleteRegistration(ctx, msg.Namespace)
+
+	err := ctx.EventManager().EmitTypedEvent(&types.EventDeregisterNamespace{
+		Namespace: msg.Namespace,
+		Owner:     msg.Owner,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &types.MsgDeregisterNamespaceResponse{}, nil
+}
+
+func (k msgServer) getOwnedRegistration(ctx sdk.Context, namespace []byte, owner string) (types.Registration, error) {
+	registration, registered := k.GetRegistration(ctx, namespace)
+	if !registered {
+		return types.Registration{}, errors.Wrapf(types.ErrNamespaceNotRegistered, "namespace %X", namespace)
+	}
+	if !registration.IsOwner(owner) {
+		return types.Registration{}, errors.Wrapf(types.ErrNotOwner, "namespace %X is owned by %s", namespace, registration.Owner)
+	}
+	return registration, nil
+}
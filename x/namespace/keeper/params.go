@@ -0,0 +1,31 @@
+package keeper
+
+import (
+	"github.com/celestiaorg/celestia-app/x/namespace/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GetParams gets all parameters as types.Params
+func (k Keeper) GetParams(ctx sdk.Context) types.Params {
+	return types.NewParams(
+		k.RegistrationFee(ctx),
+		k.RequireRegistration(ctx),
+	)
+}
+
+// SetParams sets the params
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramStore.SetParamSet(ctx, &params)
+}
+
+// RegistrationFee returns the RegistrationFee param
+func (k Keeper) RegistrationFee(ctx sdk.Context) (res sdk.Coins) {
+	k.paramStore.Get(ctx, types.KeyRegistrationFee, &res)
+	return
+}
+
+// RequireRegistration returns the RequireRegistration param
+func (k Keeper) RequireRegistration(ctx sdk.Context) (res bool) {
+	k.paramStore.Get(ctx, types.KeyRequireRegistration, &res)
+	return
+}
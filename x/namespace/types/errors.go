@@ -0,0 +1,17 @@
+package types
+
+// DONTCOVER
+
+import (
+	"cosmossdk.io/errors"
+)
+
+var (
+	ErrNamespaceRegistered    = errors.Register(ModuleName, 2, "namespace is already registered")
+	ErrNamespaceNotRegistered = errors.Register(ModuleName, 3, "namespace is not registered")
+	ErrNotOwner               = errors.Register(ModuleName, 4, "signer is not the owner of the namespace")
+	ErrUnauthorizedSigner     = errors.Register(ModuleName, 5, "signer is not allowed to pay for blobs in the namespace")
+	ErrDuplicateSigner        = errors.Register(ModuleName, 6, "duplicate signer")
+	ErrTooManySigners         = errors.Register(ModuleName, 7, "too many signers")
+	ErrInvalidNamespace       = errors.Register(ModuleName, 8, "invalid blob namespace")
+)
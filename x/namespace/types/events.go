@@ -0,0 +1,11 @@
+package types
+
+import (
+	"github.com/cosmos/gogoproto/proto"
+)
+
+var (
+	EventTypeRegisterNamespace    = proto.MessageName(&EventRegisterNamespace{})
+	EventTypeUpdateAllowedSigners = proto.MessageName(&EventUpdateAllowedSigners{})
+	EventTypeDeregisterNamespace  = proto.MessageName(&EventDeregisterNamespace{})
+)
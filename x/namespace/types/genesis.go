@@ -0,0 +1,28 @@
+package types
+
+import (
+	"fmt"
+)
+
+// DefaultGenesis returns the default namespace genesis state
+func DefaultGenesis() *GenesisState {
+	return &GenesisState{
+		Params: DefaultParams(),
+	}
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	registered := make(map[string]bool, len(gs.Registrations))
+	for _, registration := range gs.Registrations {
+		if err := registration.Validate(); err != nil {
+			return err
+		}
+		if registered[string(registration.Namespace)] {
+			return fmt.Errorf("duplicate registration of namespace %X", registration.Namespace)
+		}
+		registered[string(registration.Namespace)] = true
+	}
+	return gs.Params.Validate()
+}
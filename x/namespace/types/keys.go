@@ -0,0 +1,24 @@
+package types
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "namespace"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+
+	// RouterKey is the message route for the namespace module
+	RouterKey = ModuleName
+
+	// QuerierRoute defines the module's query routing key
+	QuerierRoute = ModuleName
+)
+
+// RegistrationKeyPrefix is the prefix of the keys under which the
+// registrations are stored.
+var RegistrationKeyPrefix = []byte{0x01}
+
+// RegistrationKey returns the key of the registration of a namespace.
+func RegistrationKey(namespace []byte) []byte {
+	return append(append([]byte{}, RegistrationKeyPrefix...), namespace...)
+}
@@ -0,0 +1,165 @@
+package types
+
+import (
+	"cosmossdk.io/errors"
+	appns "github.com/celestiaorg/celestia-app/pkg/namespace"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
+)
+
+const (
+	URLMsgRegisterNamespace    = "/celestia.namespace.v1.MsgRegisterNamespace"
+	URLMsgUpdateAllowedSigners = "/celestia.namespace.v1.MsgUpdateAllowedSigners"
+	URLMsgDeregisterNamespace  = "/celestia.namespace.v1.MsgDeregisterNamespace"
+
+	// MaxAllowedSigners is the maximum number of allowed signers of a
+	// namespace in addition to its owner. It bounds the cost of checking
+	// whether an account is allowed to pay for blobs in the namespace.
+	MaxAllowedSigners = 32
+)
+
+var (
+	_ legacytx.LegacyMsg = &MsgRegisterNamespace{}
+	_ legacytx.LegacyMsg = &MsgUpdateAllowedSigners{}
+	_ legacytx.LegacyMsg = &MsgDeregisterNamespace{}
+)
+
+// NewMsgRegisterNamespace returns a message that registers namespace to owner
+// and allows allowedSigners to pay for blobs in it.
+func NewMsgRegisterNamespace(owner string, namespace appns.Namespace, allowedSigners ...string) *MsgRegisterNamespace {
+	return &MsgRegisterNamespace{
+		Owner:          owner,
+		Namespace:      namespace.Bytes(),
+		AllowedSigners: allowedSigners,
+	}
+}
+
+// Route fulfills the legacytx.LegacyMsg interface
+func (msg *MsgRegisterNamespace) Route() string { return RouterKey }
+
+// Type fulfills the legacytx.LegacyMsg interface
+func (msg *MsgRegisterNamespace) Type() string { return URLMsgRegisterNamespace }
+
+// ValidateBasic fulfills the sdk.Msg interface by performing stateless
+// validity checks on the msg.
+func (msg *MsgRegisterNamespace) ValidateBasic() error {
+	return validateRegistration(msg.Owner, msg.Namespace, msg.AllowedSigners)
+}
+
+// GetSignBytes fulfills the legacytx.LegacyMsg interface by returning a
+// deterministic set of bytes to sign over
+func (msg *MsgRegisterNamespace) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners fulfills the sdk.Msg interface by returning the owner's address
+func (msg *MsgRegisterNamespace) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.MustAccAddressFromBech32(msg.Owner)}
+}
+
+// NewMsgUpdateAllowedSigners returns a message that replaces the allowed
+// signers of a namespace registered to owner.
+func NewMsgUpdateAllowedSigners(owner string, namespace appns.Namespace, allowedSigners ...string) *MsgUpdateAllowedSigners {
+	return &MsgUpdateAllowedSigners{
+		Owner:          owner,
+		Namespace:      namespace.Bytes(),
+		AllowedSigners: allowedSigners,
+	}
+}
+
+// Route fulfills the legacytx.LegacyMsg interface
+func (msg *MsgUpdateAllowedSigners) Route() string { return RouterKey }
+
+// Type fulfills the legacytx.LegacyMsg interface
+func (msg *MsgUpdateAllowedSigners) Type() string { return URLMsgUpdateAllowedSigners }
+
+// ValidateBasic fulfills the sdk.Msg interface by performing stateless
+// validity checks on the msg.
+func (msg *MsgUpdateAllowedSigners) ValidateBasic() error {
+	return validateRegistration(msg.Owner, msg.Namespace, msg.AllowedSigners)
+}
+
+// GetSignBytes fulfills the legacytx.LegacyMsg interface by returning a
+// deterministic set of bytes to sign over
+func (msg *MsgUpdateAllowedSigners) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners fulfills the sdk.Msg interface by returning the owner's address
+func (msg *MsgUpdateAllowedSigners) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.MustAccAddressFromBech32(msg.Owner)}
+}
+
+// NewMsgDeregisterNamespace returns a message that removes the registration of
+// a namespace registered to owner.
+func NewMsgDeregisterNamespace(owner string, namespace appns.Namespace) *MsgDeregisterNamespace {
+	return &MsgDeregisterNamespace{
+		Owner:     owner,
+		Namespace: namespace.Bytes(),
+	}
+}
+
+// Route fulfills the legacytx.LegacyMsg interface
+func (msg *MsgDeregisterNamespace) Route() string { return RouterKey }
+
+// Type fulfills the legacytx.LegacyMsg interface
+func (msg *MsgDeregisterNamespace) Type() string { return URLMsgDeregisterNamespace }
+
+// ValidateBasic fulfills the sdk.Msg interface by performing stateless
+// validity checks on the msg.
+func (msg *MsgDeregisterNamespace) ValidateBasic() error {
+	return validateRegistration(msg.Owner, msg.Namespace, nil)
+}
+
+// GetSignBytes fulfills the legacytx.LegacyMsg interface by returning a
+// deterministic set of bytes to sign over
+func (msg *MsgDeregisterNamespace) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners fulfills the sdk.Msg interface by returning the owner's address
+func (msg *MsgDeregisterNamespace) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.MustAccAddressFromBech32(msg.Owner)}
+}
+
+// ValidateNamespace returns an error if namespace is not a namespace that
+// blobs can be paid for in.
+func ValidateNamespace(namespace []byte) error {
+	ns, err := appns.From(namespace)
+	if err != nil {
+		return errors.Wrap(ErrInvalidNamespace, err.Error())
+	}
+	if err := ns.ValidateBlobNamespace(); err != nil {
+		return errors.Wrap(ErrInvalidNamespace, err.Error())
+	}
+	return nil
+}
+
+func validateRegistration(owner string, namespace []byte, allowedSigners []string) error {
+	ownerAddr, err := sdk.AccAddressFromBech32(owner)
+	if err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid owner address: %s", err)
+	}
+	if err := ValidateNamespace(namespace); err != nil {
+		return err
+	}
+	if len(allowedSigners) > MaxAllowedSigners {
+		return errors.Wrapf(ErrTooManySigners, "got %d, max %d", len(allowedSigners), MaxAllowedSigners)
+	}
+	seen := make(map[string]bool, len(allowedSigners))
+	for _, signer := range allowedSigners {
+		signerAddr, err := sdk.AccAddressFromBech32(signer)
+		if err != nil {
+			return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid signer address: %s", err)
+		}
+		// compare the address bytes since the same address has more than
+		// one bech32 encoding
+		key := string(signerAddr)
+		if seen[key] || signerAddr.Equals(ownerAddr) {
+			return errors.Wrap(ErrDuplicateSigner, signer)
+		}
+		seen[key] = true
+	}
+	return nil
+}
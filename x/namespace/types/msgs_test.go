@@ -0,0 +1,123 @@
+package types
+
+import (
+	"strings"
+	"testing"
+
+	appns "github.com/celestiaorg/celestia-app/pkg/namespace"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/assert"
+	tmrand "github.com/tendermint/tendermint/libs/rand"
+)
+
+func TestMsgRegisterNamespaceValidateBasic(t *testing.T) {
+	owner, signer := randAddress(), randAddress()
+	namespace := appns.MustNewV0(tmrand.Bytes(appns.NamespaceVersionZeroIDSize))
+
+	tooManySigners := make([]string, MaxAllowedSigners+1)
+	for i := range tooManySigners {
+		tooManySigners[i] = randAddress()
+	}
+
+	type test struct {
+		name        string
+		msg         *MsgRegisterNamespace
+		expectedErr error
+	}
+	tests := []test{
+		{
+			name: "valid",
+			msg:  NewMsgRegisterNamespace(owner, namespace, signer),
+		},
+		{
+			name: "no allowed signers",
+			msg:  NewMsgRegisterNamespace(owner, namespace),
+		},
+		{
+			name:        "invalid owner",
+			msg:         NewMsgRegisterNamespace("invalid", namespace),
+			expectedErr: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name:        "invalid allowed signer",
+			msg:         NewMsgRegisterNamespace(owner, namespace, "invalid"),
+			expectedErr: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name:        "reserved namespace",
+			msg:         NewMsgRegisterNamespace(owner, appns.TxNamespace),
+			expectedErr: ErrInvalidNamespace,
+		},
+		{
+			name:        "parity shares namespace",
+			msg:         NewMsgRegisterNamespace(owner, appns.ParitySharesNamespace),
+			expectedErr: ErrInvalidNamespace,
+		},
+		{
+			name:        "invalid namespace length",
+			msg:         &MsgRegisterNamespace{Owner: owner, Namespace: namespace.Bytes()[1:]},
+			expectedErr: ErrInvalidNamespace,
+		},
+		{
+			name:        "duplicate allowed signer",
+			msg:         NewMsgRegisterNamespace(owner, namespace, signer, signer),
+			expectedErr: ErrDuplicateSigner,
+		},
+		{
+			name:        "owner as allowed signer",
+			msg:         NewMsgRegisterNamespace(owner, namespace, owner),
+			expectedErr: ErrDuplicateSigner,
+		},
+		{
+			name:        "duplicate allowed signer with a different encoding",
+			msg:         NewMsgRegisterNamespace(owner, namespace, signer, strings.ToUpper(signer)),
+			expectedErr: ErrDuplicateSigner,
+		},
+		{
+			name:        "too many allowed signers",
+			msg:         NewMsgRegisterNamespace(owner, namespace, tooManySigners...),
+			expectedErr: ErrTooManySigners,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestRegistrationIsAuthorized(t *testing.T) {
+	owner, signer, other := randAddress(), randAddress(), randAddress()
+	registration := Registration{Owner: owner, AllowedSigners: []string{signer}}
+
+	assert.True(t, registration.IsAuthorized(owner))
+	assert.True(t, registration.IsAuthorized(signer))
+	assert.False(t, registration.IsAuthorized(other))
+	// the bech32 encoding of an address isn't unique
+	assert.True(t, registration.IsAuthorized(strings.ToUpper(owner)))
+	assert.True(t, registration.IsAuthorized(strings.ToUpper(signer)))
+	assert.False(t, registration.IsAuthorized("invalid"))
+}
+
+func TestGenesisStateValidate(t *testing.T) {
+	registration := Registration{
+		Namespace: appns.MustNewV0(tmrand.Bytes(appns.NamespaceVersionZeroIDSize)).Bytes(),
+		Owner:     randAddress(),
+	}
+
+	assert.NoError(t, DefaultGenesis().Validate())
+	assert.NoError(t, GenesisState{Params: DefaultParams(), Registrations: []Registration{registration}}.Validate())
+	assert.Error(t, GenesisState{Params: DefaultParams(), Registrations: []Registration{registration, registration}}.Validate())
+	assert.Error(t, GenesisState{Params: DefaultParams(), Registrations: []Registration{{Owner: registration.Owner}}}.Validate())
+	assert.Error(t, GenesisState{Params: NewParams(sdk.Coins{sdk.Coin{Denom: "utia", Amount: sdk.NewInt(-1)}}, false)}.Validate())
+}
+
+func randAddress() string {
+	return sdk.AccAddress(tmrand.Bytes(20)).String()
+}
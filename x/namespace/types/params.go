@@ -0,0 +1,80 @@
+package types
+
+import (
+	"fmt"
+
+	"github.com/celestiaorg/celestia-app/pkg/appconsts"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"gopkg.in/yaml.v2"
+)
+
+var _ paramtypes.ParamSet = (*Params)(nil)
+
+var (
+	KeyRegistrationFee         = []byte("RegistrationFee")
+	DefaultRegistrationFee     = sdk.NewCoins(sdk.NewInt64Coin(appconsts.BondDenom, 1_000_000))
+	KeyRequireRegistration     = []byte("RequireRegistration")
+	DefaultRequireRegistration = false
+)
+
+// ParamKeyTable returns the param key table for the namespace module
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// NewParams creates a new Params instance
+func NewParams(registrationFee sdk.Coins, requireRegistration bool) Params {
+	return Params{
+		RegistrationFee:     registrationFee,
+		RequireRegistration: requireRegistration,
+	}
+}
+
+// DefaultParams returns a default set of parameters
+func DefaultParams() Params {
+	return NewParams(DefaultRegistrationFee, DefaultRequireRegistration)
+}
+
+// ParamSetPairs gets the list of param key-value pairs
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyRegistrationFee, &p.RegistrationFee, validateRegistrationFee),
+		paramtypes.NewParamSetPair(KeyRequireRegistration, &p.RequireRegistration, validateRequireRegistration),
+	}
+}
+
+// Validate validates the set of params
+func (p Params) Validate() error {
+	if err := validateRegistrationFee(p.RegistrationFee); err != nil {
+		return err
+	}
+	return validateRequireRegistration(p.RequireRegistration)
+}
+
+// String implements the Stringer interface.
+func (p Params) String() string {
+	out, _ := yaml.Marshal(p)
+	return string(out)
+}
+
+// validateRegistrationFee validates the RegistrationFee param
+func validateRegistrationFee(v interface{}) error {
+	fee, ok := v.(sdk.Coins)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+	// an empty fee allows registering namespaces for free
+	if !fee.IsValid() && !fee.Empty() {
+		return fmt.Errorf("invalid registration fee: %s", fee)
+	}
+	return nil
+}
+
+// validateRequireRegistration validates the RequireRegistration param
+func validateRequireRegistration(v interface{}) error {
+	if _, ok := v.(bool); !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+	return nil
+}
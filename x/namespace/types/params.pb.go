@@ -0,0 +1,385 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: celestia/namespace/v1/params.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Params defines the parameters for the module.
+type Params struct {
+	// RegistrationFee is the fee that is burned when a namespace is registered.
+	RegistrationFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=registration_fee,json=registrationFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"registration_fee" yaml:"registration_fee"`
+	// RequireRegistration rejects blobs in namespaces that aren't registered.
+	// It is false by default so that registering a namespace is opt-in and
+	// unregistered namespaces stay permissionless.
+	RequireRegistration bool `protobuf:"varint,2,opt,name=require_registration,json=requireRegistration,proto3" json:"require_registration,omitempty" yaml:"require_registration"`
+}
+
+func (m *Params) Reset()      { *m = Params{} }
+func (*Params) ProtoMessage() {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7761e7af7feb6f86, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetRegistrationFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.RegistrationFee
+	}
+	return nil
+}
+
+func (m *Params) GetRequireRegistration() bool {
+	if m != nil {
+		return m.RequireRegistration
+	}
+	return false
+}
+
+func init() {
+	proto.RegisterType((*Params)(nil), "celestia.namespace.v1.Params")
+}
+
+func init() {
+	proto.RegisterFile("celestia/namespace/v1/params.proto", fileDescriptor_7761e7af7feb6f86)
+}
+
+var fileDescriptor_7761e7af7feb6f86 = []byte{
+	// 316 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x91, 0xbf, 0x4e, 0xf3, 0x30,
+	0x10, 0xc0, 0xe3, 0xef, 0x43, 0x15, 0x0a, 0x03, 0xa8, 0x14, 0x51, 0x8a, 0x64, 0x57, 0x99, 0xba,
+	0xd4, 0x56, 0x41, 0x2c, 0x1d, 0x8b, 0xc4, 0xc2, 0x00, 0xca, 0xc8, 0x52, 0x39, 0xe1, 0x08, 0x16,
+	0x4d, 0x6c, 0x6c, 0xb7, 0xa2, 0x6f, 0x01, 0x1b, 0x23, 0x33, 0x4f, 0xd2, 0xb1, 0x23, 0x53, 0x41,
+	0xcd, 0x1b, 0x74, 0x60, 0x46, 0xf9, 0xd3, 0x2a, 0x82, 0xc9, 0xa7, 0xbb, 0x9f, 0x7f, 0x3e, 0xdf,
+	0xb9, 0x5e, 0x08, 0x23, 0x30, 0x56, 0x70, 0x96, 0xf0, 0x18, 0x8c, 0xe2, 0x21, 0xb0, 0x49, 0x8f,
+	0x29, 0xae, 0x79, 0x6c, 0xa8, 0xd2, 0xd2, 0xca, 0xfa, 0xc1, 0x9a, 0xa1, 0x1b, 0x86, 0x4e, 0x7a,
+	0xad, 0x46, 0x24, 0x23, 0x99, 0x13, 0x2c, 0x8b, 0x0a, 0xb8, 0x85, 0x43, 0x69, 0x62, 0x69, 0x58,
+	0xc0, 0x4d, 0x66, 0x0a, 0xc0, 0xf2, 0x1e, 0x0b, 0xa5, 0x48, 0x8a, 0xba, 0xf7, 0x8d, 0xdc, 0xda,
+	0x75, 0x6e, 0xaf, 0xbf, 0x20, 0x77, 0x4f, 0x43, 0x24, 0x8c, 0xd5, 0xdc, 0x0a, 0x99, 0x0c, 0xef,
+	0x00, 0x9a, 0xa8, 0xfd, 0xbf, 0xb3, 0x73, 0x72, 0x44, 0x0b, 0x0d, 0xcd, 0x34, 0xb4, 0xd4, 0xd0,
+	0x73, 0x29, 0x92, 0xc1, 0xe5, 0x6c, 0x41, 0x9c, 0xd5, 0x82, 0x1c, 0x4e, 0x79, 0x3c, 0xea, 0x7b,
+	0xbf, 0x05, 0xde, 0xfb, 0x27, 0xe9, 0x44, 0xc2, 0xde, 0x8f, 0x03, 0x1a, 0xca, 0x98, 0x95, 0xed,
+	0x14, 0x47, 0xd7, 0xdc, 0x3e, 0x30, 0x3b, 0x55, 0x60, 0x72, 0x97, 0xf1, 0x77, 0xab, 0xd7, 0x2f,
+	0x00, 0xea, 0xbe, 0xdb, 0xd0, 0xf0, 0x38, 0x16, 0x1a, 0x86, 0xd5, 0x52, 0xf3, 0x5f, 0x1b, 0x75,
+	0xb6, 0x07, 0x64, 0xb5, 0x20, 0xc7, 0xeb, 0x77, 0xff, 0x52, 0x9e, 0xbf, 0x5f, 0xa6, 0xfd, 0x4a,
+	0xb6, 0xbf, 0xf5, 0xfa, 0x46, 0x9c, 0xc1, 0xd5, 0x6c, 0x89, 0xd1, 0x7c, 0x89, 0xd1, 0xd7, 0x12,
+	0xa3, 0xe7, 0x14, 0x3b, 0xf3, 0x14, 0x3b, 0x1f, 0x29, 0x76, 0x6e, 0xce, 0xaa, 0xed, 0x96, 0xa3,
+	0x96, 0x3a, 0xda, 0xc4, 0x5d, 0xae, 0x14, 0x7b, 0xaa, 0x2c, 0x28, 0xff, 0x41, 0x50, 0xcb, 0x07,
+	0x7a, 0xfa, 0x33, 0x00, 0x68, 0x21, 0x33, 0xa3, 0xc3, 0x01, 0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RequireRegistration {
+		i--
+		if m.RequireRegistration {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.RegistrationFee) > 0 {
+		for iNdEx := len(m.RegistrationFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RegistrationFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
+	offset -= sovParams(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.RegistrationFee) > 0 {
+		for _, e := range m.RegistrationFee {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if m.RequireRegistration {
+		n += 2
+	}
+	return n
+}
+
+func sovParams(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozParams(x uint64) (n int) {
+	return sovParams(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RegistrationFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RegistrationFee = append(m.RegistrationFee, types.Coin{})
+			if err := m.RegistrationFee[len(m.RegistrationFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequireRegistration", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RequireRegistration = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipParams(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthParams
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupParams
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthParams
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthParams        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowParams          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupParams = fmt.Errorf("proto: unexpected end of group")
+)
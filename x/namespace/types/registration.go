@@ -0,0 +1,40 @@
+package types
+
+import sdk "github.com/cosmos/cosmos-sdk/types"
+
+// Validate performs stateless validation of the registration.
+func (r Registration) Validate() error {
+	return validateRegistration(r.Owner, r.Namespace, r.AllowedSigners)
+}
+
+// IsAuthorized returns true if signer is allowed to pay for blobs in the
+// registered namespace. Addresses are compared by their bytes rather than
+// their bech32 encoding, which isn't unique.
+func (r Registration) IsAuthorized(signer string) bool {
+	signerAddr, err := sdk.AccAddressFromBech32(signer)
+	if err != nil {
+		return false
+	}
+	if isAddress(r.Owner, signerAddr) {
+		return true
+	}
+	for _, s := range r.AllowedSigners {
+		if isAddress(s, signerAddr) {
+			return true
+		}
+	}
+	return false
+}
+
+// IsOwner returns true if owner is the owner of the registration. Like in
+// IsAuthorized, addresses are compared by their bytes.
+func (r Registration) IsOwner(owner string) bool {
+	ownerAddr, err := sdk.AccAddressFromBech32(owner)
+	return err == nil && isAddress(r.Owner, ownerAddr)
+}
+
+// isAddress returns true if the bech32 address s decodes to addr.
+func isAddress(s string, addr sdk.AccAddress) bool {
+	decoded, err := sdk.AccAddressFromBech32(s)
+	return err == nil && decoded.Equals(addr)
+}
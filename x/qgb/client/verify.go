@@ -3,6 +3,7 @@ package client
 import (
 	"context"
 	"encoding/hex"
+	"fmt"
 	"math/big"
 	"os"
 	"strconv"
@@ -10,13 +11,16 @@ import (
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/tendermint/tendermint/crypto/merkle"
 
+	"github.com/celestiaorg/celestia-app/pkg/proof"
 	"github.com/celestiaorg/celestia-app/pkg/square"
+	blobtypes "github.com/celestiaorg/celestia-app/x/blob/types"
 	"github.com/celestiaorg/celestia-app/x/qgb/types"
 	wrapper "github.com/celestiaorg/quantum-gravity-bridge/wrappers/QuantumGravityBridge.sol"
 	"github.com/ethereum/go-ethereum/ethclient"
 	"github.com/spf13/cobra"
 	tmlog "github.com/tendermint/tendermint/libs/log"
 	"github.com/tendermint/tendermint/rpc/client/http"
+	coretypes "github.com/tendermint/tendermint/types"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
 )
@@ -141,12 +145,32 @@ func blobCmd() *cobra.Command {
 				return err
 			}
 
-			blobShareRange, err := square.BlobShareRange(blockRes.Block.Txs.ToSliceOfBytes(), int(tx.Index), int(blobIndex), blockRes.Block.Header.Version.App)
+			blobTx, isBlobTx := coretypes.UnmarshalBlobTx(blockRes.Block.Txs[tx.Index])
+			if !isBlobTx {
+				return fmt.Errorf("transaction %s is not a blob transaction", args[0])
+			}
+			pfb, err := blobtypes.PFBFromTx(blobTx.Tx)
 			if err != nil {
 				return err
 			}
+			if int(blobIndex) >= len(pfb.ShareCommitments) {
+				return fmt.Errorf("blob index %d out of range: transaction has %d blobs", blobIndex, len(pfb.ShareCommitments))
+			}
+			// the shares are checked against the commitment signed in the
+			// PFB rather than one computed from the blob served by the node
+			shareCommitment := pfb.ShareCommitments[blobIndex]
+
+			blobProof, err := proof.NewBlobInclusionProof(blockRes.Block.Txs.ToSliceOfBytes(), txHash, int(blobIndex), blockRes.Block.Header.Version.App)
+			if err != nil {
+				return err
+			}
+
+			logger.Debug("verifying that the blob shares match the share commitment")
+			if err := blobProof.Validate(blockRes.Block.DataHash, shareCommitment); err != nil {
+				return err
+			}
 
-			_, err = VerifyShares(cmd.Context(), logger, config, uint64(tx.Height), uint64(blobShareRange.Start), uint64(blobShareRange.End))
+			_, err = VerifyShares(cmd.Context(), logger, config, uint64(tx.Height), uint64(blobProof.ShareRange.Start), uint64(blobProof.ShareRange.End))
 			return err
 		},
 	}